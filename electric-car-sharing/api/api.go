@@ -0,0 +1,24 @@
+// Package api embeds the OpenAPI documents of the three services, which each service serves
+// and validates requests against, and generates the typed clients in its subpackages from them
+package api
+
+import _ "embed"
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -config userclient/config.yaml user.yaml
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -config vehicleclient/config.yaml vehicle.yaml
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -config billingclient/config.yaml billing.yaml
+
+// User is the user service's OpenAPI document
+//
+//go:embed user.yaml
+var User []byte
+
+// Vehicle is the vehicle service's OpenAPI document
+//
+//go:embed vehicle.yaml
+var Vehicle []byte
+
+// Billing is the billing service's OpenAPI document
+//
+//go:embed billing.yaml
+var Billing []byte
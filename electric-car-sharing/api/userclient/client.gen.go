@@ -0,0 +1,10275 @@
+// Package userclient provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package userclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/nullable"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	AdminTokenScopes = "adminToken.Scopes"
+)
+
+// Defines values for AccountEventEvent.
+const (
+	AccountEventEventChargeback   AccountEventEvent = "chargeback"
+	AccountEventEventDamageReport AccountEventEvent = "damage_report"
+)
+
+// Defines values for AccountEventReportEvent.
+const (
+	AccountEventReportEventChargeback   AccountEventReportEvent = "chargeback"
+	AccountEventReportEventDamageReport AccountEventReportEvent = "damage_report"
+)
+
+// Defines values for AccountStatus.
+const (
+	AccountStatusActive    AccountStatus = "active"
+	AccountStatusBanned    AccountStatus = "banned"
+	AccountStatusClosed    AccountStatus = "closed"
+	AccountStatusSuspended AccountStatus = "suspended"
+)
+
+// Defines values for LicenceReviewDecision.
+const (
+	LicenceReviewDecisionApprove LicenceReviewDecision = "approve"
+	LicenceReviewDecisionReject  LicenceReviewDecision = "reject"
+)
+
+// Defines values for LicenceStatus.
+const (
+	Approved LicenceStatus = "approved"
+	Pending  LicenceStatus = "pending"
+	Rejected LicenceStatus = "rejected"
+)
+
+// Defines values for RentalStatus.
+const (
+	RentalStatusActive    RentalStatus = "active"
+	RentalStatusCancelled RentalStatus = "cancelled"
+	RentalStatusCompleted RentalStatus = "completed"
+)
+
+// Defines values for ReviewDecision.
+const (
+	ReviewDecisionApprove ReviewDecision = "approve"
+	ReviewDecisionReject  ReviewDecision = "reject"
+)
+
+// Defines values for ListRentalsParamsSort.
+const (
+	EndDate        ListRentalsParamsSort = "end_date"
+	Id             ListRentalsParamsSort = "id"
+	MinusEndDate   ListRentalsParamsSort = "-end_date"
+	MinusId        ListRentalsParamsSort = "-id"
+	MinusStartDate ListRentalsParamsSort = "-start_date"
+	StartDate      ListRentalsParamsSort = "start_date"
+)
+
+// Defines values for ListRentalsParamsStatus.
+const (
+	Active    ListRentalsParamsStatus = "active"
+	Cancelled ListRentalsParamsStatus = "cancelled"
+	Completed ListRentalsParamsStatus = "completed"
+)
+
+// AccountEvent defines model for AccountEvent.
+type AccountEvent struct {
+	Detail *string           `json:"detail,omitempty"`
+	Event  AccountEventEvent `json:"event"`
+	UserId int               `json:"user_id"`
+}
+
+// AccountEventEvent defines model for AccountEvent.Event.
+type AccountEventEvent string
+
+// AccountEventRecorded defines model for AccountEventRecorded.
+type AccountEventRecorded struct {
+	Event     string `json:"event"`
+	Message   string `json:"message"`
+	Suspended bool   `json:"suspended"`
+	UserId    int    `json:"user_id"`
+}
+
+// AccountEventReport defines model for AccountEventReport.
+type AccountEventReport struct {
+	Detail *string                 `json:"detail,omitempty"`
+	Event  AccountEventReportEvent `json:"event"`
+}
+
+// AccountEventReportEvent defines model for AccountEventReport.Event.
+type AccountEventReportEvent string
+
+// AccountStatus defines model for AccountStatus.
+type AccountStatus string
+
+// Address A Singapore address
+type Address struct {
+	// PostalCode Six digits
+	PostalCode *string `json:"postal_code,omitempty"`
+	Street     *string `json:"street,omitempty"`
+
+	// Unit e.g. #05-123
+	Unit *string `json:"unit,omitempty"`
+}
+
+// CodeRequest defines model for CodeRequest.
+type CodeRequest struct {
+	Code string `json:"code"`
+}
+
+// CreatedUser defines model for CreatedUser.
+type CreatedUser struct {
+	Message string `json:"message"`
+	User    struct {
+		Email string `json:"email"`
+		Id    int    `json:"id"`
+		Name  string `json:"name"`
+	} `json:"user"`
+}
+
+// Credentials defines model for Credentials.
+type Credentials struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// Details defines model for Details.
+type Details struct {
+	// Address The address on one line
+	Address        string                     `json:"address"`
+	AddressDetails nullable.Nullable[Address] `json:"address_details"`
+	Email          string                     `json:"email"`
+	Gender         string                     `json:"gender"`
+	Name           string                     `json:"name"`
+
+	// PhoneNumber E.164, e.g. +6591234567
+	PhoneNumber string `json:"phone_number"`
+
+	// Timezone IANA zone times are shown in; empty uses the service default
+	Timezone string `json:"timezone"`
+	UserId   int    `json:"user_id"`
+}
+
+// DetailsUpdate defines model for DetailsUpdate.
+type DetailsUpdate struct {
+	Address nullable.Nullable[Address] `json:"address,omitempty"`
+
+	// Gender Male, Female or Other
+	Gender      nullable.Nullable[string] `json:"gender,omitempty"`
+	PhoneNumber nullable.Nullable[string] `json:"phone_number,omitempty"`
+
+	// Timezone An IANA zone name such as Asia/Singapore
+	Timezone nullable.Nullable[string] `json:"timezone,omitempty"`
+}
+
+// DisableTwoFactorRequest defines model for DisableTwoFactorRequest.
+type DisableTwoFactorRequest struct {
+	// Code A code from the authenticator app or an unused recovery code
+	Code     string `json:"code"`
+	Password string `json:"password"`
+}
+
+// EmailChange defines model for EmailChange.
+type EmailChange struct {
+	NewEmail string `json:"new_email"`
+	Password string `json:"password"`
+}
+
+// EmailChanged defines model for EmailChanged.
+type EmailChanged struct {
+	Email   string `json:"email"`
+	Message string `json:"message"`
+	UserId  int    `json:"user_id"`
+}
+
+// EmailRequest defines model for EmailRequest.
+type EmailRequest struct {
+	Email string `json:"email"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	// Code Machine-readable reason, such as invalid_credentials or email_not_verified
+	Code string `json:"code"`
+
+	// Fields Why each rejected field was rejected
+	Fields    *map[string]string `json:"fields,omitempty"`
+	Message   string             `json:"message"`
+	RequestId *string            `json:"request_id,omitempty"`
+}
+
+// ErrorEnvelope defines model for ErrorEnvelope.
+type ErrorEnvelope struct {
+	Error Error `json:"error"`
+}
+
+// Licence defines model for Licence.
+type Licence struct {
+	// ExpiryDate YYYY-MM-DD
+	ExpiryDate     string        `json:"expiry_date"`
+	Id             int           `json:"id"`
+	IssuingCountry string        `json:"issuing_country"`
+	LicenceNumber  string        `json:"licence_number"`
+	ReviewNote     *string       `json:"review_note,omitempty"`
+	ReviewedAt     *string       `json:"reviewed_at,omitempty"`
+	Status         LicenceStatus `json:"status"`
+	SubmittedAt    string        `json:"submitted_at"`
+	UserId         int           `json:"user_id"`
+}
+
+// LicenceDecision defines model for LicenceDecision.
+type LicenceDecision struct {
+	LicenceId int           `json:"licence_id"`
+	Message   string        `json:"message"`
+	Status    LicenceStatus `json:"status"`
+}
+
+// LicenceReview defines model for LicenceReview.
+type LicenceReview struct {
+	Decision  LicenceReviewDecision `json:"decision"`
+	LicenceId int                   `json:"licence_id"`
+
+	// Note Required when rejecting, and emailed to the user
+	Note *string `json:"note,omitempty"`
+}
+
+// LicenceReviewDecision defines model for LicenceReview.Decision.
+type LicenceReviewDecision string
+
+// LicenceStatus defines model for LicenceStatus.
+type LicenceStatus string
+
+// LicenceSubmission defines model for LicenceSubmission.
+type LicenceSubmission struct {
+	// ExpiryDate YYYY-MM-DD, in the future
+	ExpiryDate string `json:"expiry_date"`
+
+	// Image A JPEG, PNG or PDF of at most 5 MB
+	Image openapi_types.File `json:"image"`
+
+	// IssuingCountry Two-letter ISO country code
+	IssuingCountry string `json:"issuing_country"`
+
+	// LicenceNumber 4 to 32 letters, digits or dashes
+	LicenceNumber string `json:"licence_number"`
+}
+
+// LoginResult Either user_id, or two_factor_required with challenge_token
+type LoginResult struct {
+	ChallengeToken    *string `json:"challenge_token,omitempty"`
+	TwoFactorRequired *bool   `json:"two_factor_required,omitempty"`
+	UserId            *int    `json:"user_id,omitempty"`
+}
+
+// MembershipChange defines model for MembershipChange.
+type MembershipChange struct {
+	// MembershipId 1 for Basic, 2 for Premium, 3 for VIP
+	MembershipId int `json:"membership_id"`
+}
+
+// Message defines model for Message.
+type Message struct {
+	Message string `json:"message"`
+}
+
+// NewUser defines model for NewUser.
+type NewUser struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// Page defines model for Page.
+type Page struct {
+	Limit int `json:"limit"`
+
+	// NextCursor Fetches the next page; left out on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+	Sort       string  `json:"sort"`
+}
+
+// PasswordChange defines model for PasswordChange.
+type PasswordChange struct {
+	NewPassword string `json:"new_password"`
+	OldPassword string `json:"old_password"`
+}
+
+// PasswordRequest defines model for PasswordRequest.
+type PasswordRequest struct {
+	Password string `json:"password"`
+}
+
+// PendingEmailChange defines model for PendingEmailChange.
+type PendingEmailChange struct {
+	Message      string `json:"message"`
+	PendingEmail string `json:"pending_email"`
+}
+
+// RecoveryCodes defines model for RecoveryCodes.
+type RecoveryCodes struct {
+	Message       string   `json:"message"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// RegenerateRecoveryCodesRequest defines model for RegenerateRecoveryCodesRequest.
+type RegenerateRecoveryCodesRequest struct {
+	// Code A code from the authenticator app or an unused recovery code
+	Code     string `json:"code"`
+	Password string `json:"password"`
+}
+
+// Rental defines model for Rental.
+type Rental struct {
+	EndDate       time.Time    `json:"end_date"`
+	Id            int          `json:"id"`
+	OvertimeHours int          `json:"overtime_hours"`
+	StartDate     time.Time    `json:"start_date"`
+	Status        RentalStatus `json:"status"`
+	VehicleId     int          `json:"vehicle_id"`
+}
+
+// RentalStatus defines model for Rental.Status.
+type RentalStatus string
+
+// RentalList defines model for RentalList.
+type RentalList struct {
+	// Message Set instead of rentals when the user has none
+	Message *string   `json:"message,omitempty"`
+	Rentals *[]Rental `json:"rentals,omitempty"`
+}
+
+// RentalPage defines model for RentalPage.
+type RentalPage struct {
+	Data []Rental `json:"data"`
+	Page Page     `json:"page"`
+}
+
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	NewPassword string `json:"new_password"`
+	Token       string `json:"token"`
+}
+
+// Review defines model for Review.
+type Review struct {
+	Decision ReviewDecision `json:"decision"`
+
+	// Note Required when rejecting, and emailed to the user
+	Note *string `json:"note,omitempty"`
+}
+
+// ReviewDecision defines model for Review.Decision.
+type ReviewDecision string
+
+// SignedIn defines model for SignedIn.
+type SignedIn struct {
+	UserId int `json:"user_id"`
+}
+
+// StatusChange defines model for StatusChange.
+type StatusChange struct {
+	// Reason Required unless the status is active
+	Reason *string       `json:"reason,omitempty"`
+	Status AccountStatus `json:"status"`
+	UserId int           `json:"user_id"`
+}
+
+// StatusChanged defines model for StatusChanged.
+type StatusChanged struct {
+	Message        string        `json:"message"`
+	PreviousStatus AccountStatus `json:"previous_status"`
+	Status         AccountStatus `json:"status"`
+	UserId         int           `json:"user_id"`
+}
+
+// StatusHistory defines model for StatusHistory.
+type StatusHistory struct {
+	History []struct {
+		Actor     string `json:"actor"`
+		CreatedAt string `json:"created_at"`
+		NewStatus string `json:"new_status"`
+		OldStatus string `json:"old_status"`
+		Reason    string `json:"reason"`
+	} `json:"history"`
+	UserId int `json:"user_id"`
+}
+
+// StatusUpdate defines model for StatusUpdate.
+type StatusUpdate struct {
+	// Reason Required unless the status is active
+	Reason *string       `json:"reason,omitempty"`
+	Status AccountStatus `json:"status"`
+}
+
+// TokenRequest defines model for TokenRequest.
+type TokenRequest struct {
+	Token *string `json:"token,omitempty"`
+}
+
+// TwoFactorEnrolment defines model for TwoFactorEnrolment.
+type TwoFactorEnrolment struct {
+	Message         string `json:"message"`
+	ProvisioningUri string `json:"provisioning_uri"`
+	Secret          string `json:"secret"`
+}
+
+// TwoFactorLoginRequest defines model for TwoFactorLoginRequest.
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token"`
+
+	// Code A code from the authenticator app or an unused recovery code
+	Code string `json:"code"`
+}
+
+// UserConfirmed defines model for UserConfirmed.
+type UserConfirmed struct {
+	Message string `json:"message"`
+	UserId  int    `json:"user_id"`
+}
+
+// UserMembership defines model for UserMembership.
+type UserMembership struct {
+	MembershipId   *int    `json:"membership_id,omitempty"`
+	MembershipName *string `json:"membership_name,omitempty"`
+	Name           string  `json:"name"`
+	UserId         int     `json:"user_id"`
+}
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// ID defines model for ID.
+type ID = int
+
+// LicenceID defines model for LicenceID.
+type LicenceID = int
+
+// Limit defines model for Limit.
+type Limit = int
+
+// RequiredToken defines model for RequiredToken.
+type RequiredToken = string
+
+// Token defines model for Token.
+type Token = string
+
+// UserID defines model for UserID.
+type UserID = int
+
+// Failed defines model for Failed.
+type Failed = ErrorEnvelope
+
+// ConfirmTwoFactorV1Params defines parameters for ConfirmTwoFactorV1.
+type ConfirmTwoFactorV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// DisableTwoFactorV1Params defines parameters for DisableTwoFactorV1.
+type DisableTwoFactorV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// EnrollTwoFactorV1Params defines parameters for EnrollTwoFactorV1.
+type EnrollTwoFactorV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// RegenerateRecoveryCodesV1Params defines parameters for RegenerateRecoveryCodesV1.
+type RegenerateRecoveryCodesV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// ListLicencesV1Params defines parameters for ListLicencesV1.
+type ListLicencesV1Params struct {
+	// Status Defaults to pending
+	Status *LicenceStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// LicenceImageV1Params defines parameters for LicenceImageV1.
+type LicenceImageV1Params struct {
+	LicenceId LicenceID `form:"licence_id" json:"licence_id"`
+}
+
+// SetAccountStatusV1Params defines parameters for SetAccountStatusV1.
+type SetAccountStatusV1Params struct {
+	// XAdminActor The operator, recorded in the audit trail. Defaults to admin.
+	XAdminActor *string `json:"X-Admin-Actor,omitempty"`
+}
+
+// AccountStatusHistoryV1Params defines parameters for AccountStatusHistoryV1.
+type AccountStatusHistoryV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// ListLicencesParams defines parameters for ListLicences.
+type ListLicencesParams struct {
+	// Status Defaults to pending
+	Status *LicenceStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// SetAccountStatusParams defines parameters for SetAccountStatus.
+type SetAccountStatusParams struct {
+	// XAdminActor The operator, recorded in the audit trail. Defaults to admin.
+	XAdminActor *string `json:"X-Admin-Actor,omitempty"`
+}
+
+// ListRentalsParams defines parameters for ListRentals.
+type ListRentalsParams struct {
+	// Limit The most items to return, 20 if left out
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor The `next_cursor` of the previous page, to fetch the one after it
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Field to sort by, `id` if left out; prefix it with `-` for descending order
+	Sort   *ListRentalsParamsSort   `form:"sort,omitempty" json:"sort,omitempty"`
+	Status *ListRentalsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// From Only list rentals that started at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only list rentals that started before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListRentalsParamsSort defines parameters for ListRentals.
+type ListRentalsParamsSort string
+
+// ListRentalsParamsStatus defines parameters for ListRentals.
+type ListRentalsParamsStatus string
+
+// RequestEmailChangeV1Params defines parameters for RequestEmailChangeV1.
+type RequestEmailChangeV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// ConfirmEmailChangeLinkParams defines parameters for ConfirmEmailChangeLink.
+type ConfirmEmailChangeLinkParams struct {
+	Token RequiredToken `form:"token" json:"token"`
+}
+
+// ConfirmEmailChangeV1Params defines parameters for ConfirmEmailChangeV1.
+type ConfirmEmailChangeV1Params struct {
+	Token *Token `form:"token,omitempty" json:"token,omitempty"`
+}
+
+// DeleteAccountV1Params defines parameters for DeleteAccountV1.
+type DeleteAccountV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// ExportDataV1Params defines parameters for ExportDataV1.
+type ExportDataV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// ViewLicenceV1Params defines parameters for ViewLicenceV1.
+type ViewLicenceV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// SubmitLicenceV1Params defines parameters for SubmitLicenceV1.
+type SubmitLicenceV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// UnlockAccountLinkParams defines parameters for UnlockAccountLink.
+type UnlockAccountLinkParams struct {
+	Token RequiredToken `form:"token" json:"token"`
+}
+
+// UnlockAccountV1Params defines parameters for UnlockAccountV1.
+type UnlockAccountV1Params struct {
+	Token *Token `form:"token,omitempty" json:"token,omitempty"`
+}
+
+// UpdateDetailsV1Params defines parameters for UpdateDetailsV1.
+type UpdateDetailsV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// UpdateDetailsPostV1Params defines parameters for UpdateDetailsPostV1.
+type UpdateDetailsPostV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// UpdateMembershipV1Params defines parameters for UpdateMembershipV1.
+type UpdateMembershipV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// UpdatePasswordV1Params defines parameters for UpdatePasswordV1.
+type UpdatePasswordV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// VerifyEmailLinkParams defines parameters for VerifyEmailLink.
+type VerifyEmailLinkParams struct {
+	Token RequiredToken `form:"token" json:"token"`
+}
+
+// VerifyEmailV1Params defines parameters for VerifyEmailV1.
+type VerifyEmailV1Params struct {
+	Token *Token `form:"token,omitempty" json:"token,omitempty"`
+}
+
+// ViewDetailsV1Params defines parameters for ViewDetailsV1.
+type ViewDetailsV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// ViewMembershipV1Params defines parameters for ViewMembershipV1.
+type ViewMembershipV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// ViewRentalsV1Params defines parameters for ViewRentalsV1.
+type ViewRentalsV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// ConfirmTwoFactorV1JSONRequestBody defines body for ConfirmTwoFactorV1 for application/json ContentType.
+type ConfirmTwoFactorV1JSONRequestBody = CodeRequest
+
+// DisableTwoFactorV1JSONRequestBody defines body for DisableTwoFactorV1 for application/json ContentType.
+type DisableTwoFactorV1JSONRequestBody = DisableTwoFactorRequest
+
+// EnrollTwoFactorV1JSONRequestBody defines body for EnrollTwoFactorV1 for application/json ContentType.
+type EnrollTwoFactorV1JSONRequestBody = PasswordRequest
+
+// RegenerateRecoveryCodesV1JSONRequestBody defines body for RegenerateRecoveryCodesV1 for application/json ContentType.
+type RegenerateRecoveryCodesV1JSONRequestBody = RegenerateRecoveryCodesRequest
+
+// ReviewLicenceV1JSONRequestBody defines body for ReviewLicenceV1 for application/json ContentType.
+type ReviewLicenceV1JSONRequestBody = LicenceReview
+
+// ReportAccountEventV1JSONRequestBody defines body for ReportAccountEventV1 for application/json ContentType.
+type ReportAccountEventV1JSONRequestBody = AccountEvent
+
+// SetAccountStatusV1JSONRequestBody defines body for SetAccountStatusV1 for application/json ContentType.
+type SetAccountStatusV1JSONRequestBody = StatusChange
+
+// UnlockAccountJSONRequestBody defines body for UnlockAccount for application/json ContentType.
+type UnlockAccountJSONRequestBody = TokenRequest
+
+// ReviewLicenceJSONRequestBody defines body for ReviewLicence for application/json ContentType.
+type ReviewLicenceJSONRequestBody = Review
+
+// ReportAccountEventJSONRequestBody defines body for ReportAccountEvent for application/json ContentType.
+type ReportAccountEventJSONRequestBody = AccountEventReport
+
+// SetAccountStatusJSONRequestBody defines body for SetAccountStatus for application/json ContentType.
+type SetAccountStatusJSONRequestBody = StatusUpdate
+
+// ConfirmEmailChangeJSONRequestBody defines body for ConfirmEmailChange for application/json ContentType.
+type ConfirmEmailChangeJSONRequestBody = TokenRequest
+
+// VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
+type VerifyEmailJSONRequestBody = TokenRequest
+
+// RequestPasswordResetJSONRequestBody defines body for RequestPasswordReset for application/json ContentType.
+type RequestPasswordResetJSONRequestBody = EmailRequest
+
+// ResetPasswordJSONRequestBody defines body for ResetPassword for application/json ContentType.
+type ResetPasswordJSONRequestBody = ResetPasswordRequest
+
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = Credentials
+
+// LoginTwoFactorJSONRequestBody defines body for LoginTwoFactor for application/json ContentType.
+type LoginTwoFactorJSONRequestBody = TwoFactorLoginRequest
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = NewUser
+
+// DeleteUserJSONRequestBody defines body for DeleteUser for application/json ContentType.
+type DeleteUserJSONRequestBody = PasswordRequest
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody = DetailsUpdate
+
+// RequestEmailChangeJSONRequestBody defines body for RequestEmailChange for application/json ContentType.
+type RequestEmailChangeJSONRequestBody = EmailChange
+
+// SubmitLicenceMultipartRequestBody defines body for SubmitLicence for multipart/form-data ContentType.
+type SubmitLicenceMultipartRequestBody = LicenceSubmission
+
+// UpdateMembershipJSONRequestBody defines body for UpdateMembership for application/json ContentType.
+type UpdateMembershipJSONRequestBody = MembershipChange
+
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = PasswordChange
+
+// DisableTwoFactorJSONRequestBody defines body for DisableTwoFactor for application/json ContentType.
+type DisableTwoFactorJSONRequestBody = DisableTwoFactorRequest
+
+// EnrollTwoFactorJSONRequestBody defines body for EnrollTwoFactor for application/json ContentType.
+type EnrollTwoFactorJSONRequestBody = PasswordRequest
+
+// ConfirmTwoFactorJSONRequestBody defines body for ConfirmTwoFactor for application/json ContentType.
+type ConfirmTwoFactorJSONRequestBody = CodeRequest
+
+// RegenerateRecoveryCodesJSONRequestBody defines body for RegenerateRecoveryCodes for application/json ContentType.
+type RegenerateRecoveryCodesJSONRequestBody = RegenerateRecoveryCodesRequest
+
+// ResendVerificationJSONRequestBody defines body for ResendVerification for application/json ContentType.
+type ResendVerificationJSONRequestBody = EmailRequest
+
+// RequestEmailChangeV1JSONRequestBody defines body for RequestEmailChangeV1 for application/json ContentType.
+type RequestEmailChangeV1JSONRequestBody = EmailChange
+
+// ConfirmEmailChangeV1JSONRequestBody defines body for ConfirmEmailChangeV1 for application/json ContentType.
+type ConfirmEmailChangeV1JSONRequestBody = TokenRequest
+
+// CreateUserV1JSONRequestBody defines body for CreateUserV1 for application/json ContentType.
+type CreateUserV1JSONRequestBody = NewUser
+
+// DeleteAccountV1JSONRequestBody defines body for DeleteAccountV1 for application/json ContentType.
+type DeleteAccountV1JSONRequestBody = PasswordRequest
+
+// SubmitLicenceV1MultipartRequestBody defines body for SubmitLicenceV1 for multipart/form-data ContentType.
+type SubmitLicenceV1MultipartRequestBody = LicenceSubmission
+
+// LoginV1JSONRequestBody defines body for LoginV1 for application/json ContentType.
+type LoginV1JSONRequestBody = Credentials
+
+// LoginTwoFactorV1JSONRequestBody defines body for LoginTwoFactorV1 for application/json ContentType.
+type LoginTwoFactorV1JSONRequestBody = TwoFactorLoginRequest
+
+// RequestPasswordResetV1JSONRequestBody defines body for RequestPasswordResetV1 for application/json ContentType.
+type RequestPasswordResetV1JSONRequestBody = EmailRequest
+
+// ResendVerificationV1JSONRequestBody defines body for ResendVerificationV1 for application/json ContentType.
+type ResendVerificationV1JSONRequestBody = EmailRequest
+
+// ResetPasswordV1JSONRequestBody defines body for ResetPasswordV1 for application/json ContentType.
+type ResetPasswordV1JSONRequestBody = ResetPasswordRequest
+
+// UnlockAccountV1JSONRequestBody defines body for UnlockAccountV1 for application/json ContentType.
+type UnlockAccountV1JSONRequestBody = TokenRequest
+
+// UpdateDetailsV1JSONRequestBody defines body for UpdateDetailsV1 for application/json ContentType.
+type UpdateDetailsV1JSONRequestBody = DetailsUpdate
+
+// UpdateDetailsPostV1JSONRequestBody defines body for UpdateDetailsPostV1 for application/json ContentType.
+type UpdateDetailsPostV1JSONRequestBody = DetailsUpdate
+
+// UpdateMembershipV1JSONRequestBody defines body for UpdateMembershipV1 for application/json ContentType.
+type UpdateMembershipV1JSONRequestBody = MembershipChange
+
+// UpdatePasswordV1JSONRequestBody defines body for UpdatePasswordV1 for application/json ContentType.
+type UpdatePasswordV1JSONRequestBody = PasswordChange
+
+// VerifyEmailV1JSONRequestBody defines body for VerifyEmailV1 for application/json ContentType.
+type VerifyEmailV1JSONRequestBody = TokenRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ConfirmTwoFactorV1WithBody request with any body
+	ConfirmTwoFactorV1WithBody(ctx context.Context, params *ConfirmTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmTwoFactorV1(ctx context.Context, params *ConfirmTwoFactorV1Params, body ConfirmTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisableTwoFactorV1WithBody request with any body
+	DisableTwoFactorV1WithBody(ctx context.Context, params *DisableTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DisableTwoFactorV1(ctx context.Context, params *DisableTwoFactorV1Params, body DisableTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnrollTwoFactorV1WithBody request with any body
+	EnrollTwoFactorV1WithBody(ctx context.Context, params *EnrollTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EnrollTwoFactorV1(ctx context.Context, params *EnrollTwoFactorV1Params, body EnrollTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegenerateRecoveryCodesV1WithBody request with any body
+	RegenerateRecoveryCodesV1WithBody(ctx context.Context, params *RegenerateRecoveryCodesV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegenerateRecoveryCodesV1(ctx context.Context, params *RegenerateRecoveryCodesV1Params, body RegenerateRecoveryCodesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLicencesV1 request
+	ListLicencesV1(ctx context.Context, params *ListLicencesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LicenceImageV1 request
+	LicenceImageV1(ctx context.Context, params *LicenceImageV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReviewLicenceV1WithBody request with any body
+	ReviewLicenceV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReviewLicenceV1(ctx context.Context, body ReviewLicenceV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReportAccountEventV1WithBody request with any body
+	ReportAccountEventV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReportAccountEventV1(ctx context.Context, body ReportAccountEventV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetAccountStatusV1WithBody request with any body
+	SetAccountStatusV1WithBody(ctx context.Context, params *SetAccountStatusV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetAccountStatusV1(ctx context.Context, params *SetAccountStatusV1Params, body SetAccountStatusV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AccountStatusHistoryV1 request
+	AccountStatusHistoryV1(ctx context.Context, params *AccountStatusHistoryV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnlockAccountWithBody request with any body
+	UnlockAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UnlockAccount(ctx context.Context, body UnlockAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLicences request
+	ListLicences(ctx context.Context, params *ListLicencesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LicenceImage request
+	LicenceImage(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReviewLicenceWithBody request with any body
+	ReviewLicenceWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReviewLicence(ctx context.Context, id ID, body ReviewLicenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReportAccountEventWithBody request with any body
+	ReportAccountEventWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReportAccountEvent(ctx context.Context, id ID, body ReportAccountEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetAccountStatusWithBody request with any body
+	SetAccountStatusWithBody(ctx context.Context, id ID, params *SetAccountStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetAccountStatus(ctx context.Context, id ID, params *SetAccountStatusParams, body SetAccountStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AccountStatusHistory request
+	AccountStatusHistory(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmEmailChangeWithBody request with any body
+	ConfirmEmailChangeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmEmailChange(ctx context.Context, body ConfirmEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyEmailWithBody request with any body
+	VerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyEmail(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestPasswordResetWithBody request with any body
+	RequestPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RequestPasswordReset(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetPasswordWithBody request with any body
+	ResetPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResetPassword(ctx context.Context, body ResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginWithBody request with any body
+	LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginTwoFactorWithBody request with any body
+	LoginTwoFactorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LoginTwoFactor(ctx context.Context, body LoginTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserWithBody request with any body
+	CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUserWithBody request with any body
+	DeleteUserWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteUser(ctx context.Context, id ID, body DeleteUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUser request
+	GetUser(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateUserWithBody request with any body
+	UpdateUserWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateUser(ctx context.Context, id ID, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestEmailChangeWithBody request with any body
+	RequestEmailChangeWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RequestEmailChange(ctx context.Context, id ID, body RequestEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportData request
+	ExportData(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitLicenceWithBody request with any body
+	SubmitLicenceWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLatestLicence request
+	GetLatestLicence(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMembership request
+	GetMembership(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateMembershipWithBody request with any body
+	UpdateMembershipWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateMembership(ctx context.Context, id ID, body UpdateMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ChangePasswordWithBody request with any body
+	ChangePasswordWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ChangePassword(ctx context.Context, id ID, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRentals request
+	ListRentals(ctx context.Context, id ID, params *ListRentalsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisableTwoFactorWithBody request with any body
+	DisableTwoFactorWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DisableTwoFactor(ctx context.Context, id ID, body DisableTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnrollTwoFactorWithBody request with any body
+	EnrollTwoFactorWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EnrollTwoFactor(ctx context.Context, id ID, body EnrollTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmTwoFactorWithBody request with any body
+	ConfirmTwoFactorWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmTwoFactor(ctx context.Context, id ID, body ConfirmTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegenerateRecoveryCodesWithBody request with any body
+	RegenerateRecoveryCodesWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegenerateRecoveryCodes(ctx context.Context, id ID, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResendVerificationWithBody request with any body
+	ResendVerificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResendVerification(ctx context.Context, body ResendVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestEmailChangeV1WithBody request with any body
+	RequestEmailChangeV1WithBody(ctx context.Context, params *RequestEmailChangeV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RequestEmailChangeV1(ctx context.Context, params *RequestEmailChangeV1Params, body RequestEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmEmailChangeLink request
+	ConfirmEmailChangeLink(ctx context.Context, params *ConfirmEmailChangeLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmEmailChangeV1WithBody request with any body
+	ConfirmEmailChangeV1WithBody(ctx context.Context, params *ConfirmEmailChangeV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmEmailChangeV1(ctx context.Context, params *ConfirmEmailChangeV1Params, body ConfirmEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserV1WithBody request with any body
+	CreateUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUserV1(ctx context.Context, body CreateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAccountV1WithBody request with any body
+	DeleteAccountV1WithBody(ctx context.Context, params *DeleteAccountV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteAccountV1(ctx context.Context, params *DeleteAccountV1Params, body DeleteAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportDataV1 request
+	ExportDataV1(ctx context.Context, params *ExportDataV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ViewLicenceV1 request
+	ViewLicenceV1(ctx context.Context, params *ViewLicenceV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitLicenceV1WithBody request with any body
+	SubmitLicenceV1WithBody(ctx context.Context, params *SubmitLicenceV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginV1WithBody request with any body
+	LoginV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LoginV1(ctx context.Context, body LoginV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginTwoFactorV1WithBody request with any body
+	LoginTwoFactorV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LoginTwoFactorV1(ctx context.Context, body LoginTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestPasswordResetV1WithBody request with any body
+	RequestPasswordResetV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RequestPasswordResetV1(ctx context.Context, body RequestPasswordResetV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResendVerificationV1WithBody request with any body
+	ResendVerificationV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResendVerificationV1(ctx context.Context, body ResendVerificationV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetPasswordV1WithBody request with any body
+	ResetPasswordV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResetPasswordV1(ctx context.Context, body ResetPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnlockAccountLink request
+	UnlockAccountLink(ctx context.Context, params *UnlockAccountLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnlockAccountV1WithBody request with any body
+	UnlockAccountV1WithBody(ctx context.Context, params *UnlockAccountV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UnlockAccountV1(ctx context.Context, params *UnlockAccountV1Params, body UnlockAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDetailsV1WithBody request with any body
+	UpdateDetailsV1WithBody(ctx context.Context, params *UpdateDetailsV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDetailsV1(ctx context.Context, params *UpdateDetailsV1Params, body UpdateDetailsV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDetailsPostV1WithBody request with any body
+	UpdateDetailsPostV1WithBody(ctx context.Context, params *UpdateDetailsPostV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDetailsPostV1(ctx context.Context, params *UpdateDetailsPostV1Params, body UpdateDetailsPostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateMembershipV1WithBody request with any body
+	UpdateMembershipV1WithBody(ctx context.Context, params *UpdateMembershipV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateMembershipV1(ctx context.Context, params *UpdateMembershipV1Params, body UpdateMembershipV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePasswordV1WithBody request with any body
+	UpdatePasswordV1WithBody(ctx context.Context, params *UpdatePasswordV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePasswordV1(ctx context.Context, params *UpdatePasswordV1Params, body UpdatePasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyEmailLink request
+	VerifyEmailLink(ctx context.Context, params *VerifyEmailLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyEmailV1WithBody request with any body
+	VerifyEmailV1WithBody(ctx context.Context, params *VerifyEmailV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyEmailV1(ctx context.Context, params *VerifyEmailV1Params, body VerifyEmailV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ViewDetailsV1 request
+	ViewDetailsV1(ctx context.Context, params *ViewDetailsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ViewMembershipV1 request
+	ViewMembershipV1(ctx context.Context, params *ViewMembershipV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ViewRentalsV1 request
+	ViewRentalsV1(ctx context.Context, params *ViewRentalsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ConfirmTwoFactorV1WithBody(ctx context.Context, params *ConfirmTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTwoFactorV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmTwoFactorV1(ctx context.Context, params *ConfirmTwoFactorV1Params, body ConfirmTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTwoFactorV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisableTwoFactorV1WithBody(ctx context.Context, params *DisableTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTwoFactorV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisableTwoFactorV1(ctx context.Context, params *DisableTwoFactorV1Params, body DisableTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTwoFactorV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EnrollTwoFactorV1WithBody(ctx context.Context, params *EnrollTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollTwoFactorV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EnrollTwoFactorV1(ctx context.Context, params *EnrollTwoFactorV1Params, body EnrollTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollTwoFactorV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegenerateRecoveryCodesV1WithBody(ctx context.Context, params *RegenerateRecoveryCodesV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegenerateRecoveryCodesV1(ctx context.Context, params *RegenerateRecoveryCodesV1Params, body RegenerateRecoveryCodesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLicencesV1(ctx context.Context, params *ListLicencesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLicencesV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LicenceImageV1(ctx context.Context, params *LicenceImageV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLicenceImageV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReviewLicenceV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReviewLicenceV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReviewLicenceV1(ctx context.Context, body ReviewLicenceV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReviewLicenceV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReportAccountEventV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReportAccountEventV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReportAccountEventV1(ctx context.Context, body ReportAccountEventV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReportAccountEventV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetAccountStatusV1WithBody(ctx context.Context, params *SetAccountStatusV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAccountStatusV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetAccountStatusV1(ctx context.Context, params *SetAccountStatusV1Params, body SetAccountStatusV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAccountStatusV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AccountStatusHistoryV1(ctx context.Context, params *AccountStatusHistoryV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAccountStatusHistoryV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnlockAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlockAccountRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnlockAccount(ctx context.Context, body UnlockAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlockAccountRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLicences(ctx context.Context, params *ListLicencesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLicencesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LicenceImage(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLicenceImageRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReviewLicenceWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReviewLicenceRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReviewLicence(ctx context.Context, id ID, body ReviewLicenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReviewLicenceRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReportAccountEventWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReportAccountEventRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReportAccountEvent(ctx context.Context, id ID, body ReportAccountEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReportAccountEventRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetAccountStatusWithBody(ctx context.Context, id ID, params *SetAccountStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAccountStatusRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetAccountStatus(ctx context.Context, id ID, params *SetAccountStatusParams, body SetAccountStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAccountStatusRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AccountStatusHistory(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAccountStatusHistoryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmEmailChangeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmEmailChangeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmEmailChange(ctx context.Context, body ConfirmEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmEmailChangeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyEmail(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestPasswordResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestPasswordReset(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestPasswordResetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetPasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetPassword(ctx context.Context, body ResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetPasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginTwoFactorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginTwoFactorRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginTwoFactor(ctx context.Context, body LoginTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginTwoFactorRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUserWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUser(ctx context.Context, id ID, body DeleteUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUser(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUserWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUser(ctx context.Context, id ID, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestEmailChangeWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestEmailChangeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestEmailChange(ctx context.Context, id ID, body RequestEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestEmailChangeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportData(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportDataRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitLicenceWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitLicenceRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLatestLicence(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLatestLicenceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMembership(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMembershipRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateMembershipWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMembershipRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateMembership(ctx context.Context, id ID, body UpdateMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMembershipRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ChangePasswordWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ChangePassword(ctx context.Context, id ID, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRentals(ctx context.Context, id ID, params *ListRentalsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRentalsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisableTwoFactorWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTwoFactorRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisableTwoFactor(ctx context.Context, id ID, body DisableTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTwoFactorRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EnrollTwoFactorWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollTwoFactorRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EnrollTwoFactor(ctx context.Context, id ID, body EnrollTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollTwoFactorRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmTwoFactorWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTwoFactorRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmTwoFactor(ctx context.Context, id ID, body ConfirmTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTwoFactorRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegenerateRecoveryCodesWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegenerateRecoveryCodes(ctx context.Context, id ID, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateRecoveryCodesRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResendVerificationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResendVerificationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResendVerification(ctx context.Context, body ResendVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResendVerificationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestEmailChangeV1WithBody(ctx context.Context, params *RequestEmailChangeV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestEmailChangeV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestEmailChangeV1(ctx context.Context, params *RequestEmailChangeV1Params, body RequestEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestEmailChangeV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmEmailChangeLink(ctx context.Context, params *ConfirmEmailChangeLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmEmailChangeLinkRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmEmailChangeV1WithBody(ctx context.Context, params *ConfirmEmailChangeV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmEmailChangeV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmEmailChangeV1(ctx context.Context, params *ConfirmEmailChangeV1Params, body ConfirmEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmEmailChangeV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserV1(ctx context.Context, body CreateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAccountV1WithBody(ctx context.Context, params *DeleteAccountV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccountV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAccountV1(ctx context.Context, params *DeleteAccountV1Params, body DeleteAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccountV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportDataV1(ctx context.Context, params *ExportDataV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportDataV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ViewLicenceV1(ctx context.Context, params *ViewLicenceV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewViewLicenceV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitLicenceV1WithBody(ctx context.Context, params *SubmitLicenceV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitLicenceV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginV1(ctx context.Context, body LoginV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginTwoFactorV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginTwoFactorV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginTwoFactorV1(ctx context.Context, body LoginTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginTwoFactorV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestPasswordResetV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestPasswordResetV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestPasswordResetV1(ctx context.Context, body RequestPasswordResetV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestPasswordResetV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResendVerificationV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResendVerificationV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResendVerificationV1(ctx context.Context, body ResendVerificationV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResendVerificationV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetPasswordV1WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetPasswordV1RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetPasswordV1(ctx context.Context, body ResetPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetPasswordV1Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnlockAccountLink(ctx context.Context, params *UnlockAccountLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlockAccountLinkRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnlockAccountV1WithBody(ctx context.Context, params *UnlockAccountV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlockAccountV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnlockAccountV1(ctx context.Context, params *UnlockAccountV1Params, body UnlockAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlockAccountV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDetailsV1WithBody(ctx context.Context, params *UpdateDetailsV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDetailsV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDetailsV1(ctx context.Context, params *UpdateDetailsV1Params, body UpdateDetailsV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDetailsV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDetailsPostV1WithBody(ctx context.Context, params *UpdateDetailsPostV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDetailsPostV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDetailsPostV1(ctx context.Context, params *UpdateDetailsPostV1Params, body UpdateDetailsPostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDetailsPostV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateMembershipV1WithBody(ctx context.Context, params *UpdateMembershipV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMembershipV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateMembershipV1(ctx context.Context, params *UpdateMembershipV1Params, body UpdateMembershipV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMembershipV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePasswordV1WithBody(ctx context.Context, params *UpdatePasswordV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePasswordV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePasswordV1(ctx context.Context, params *UpdatePasswordV1Params, body UpdatePasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePasswordV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyEmailLink(ctx context.Context, params *VerifyEmailLinkParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailLinkRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyEmailV1WithBody(ctx context.Context, params *VerifyEmailV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyEmailV1(ctx context.Context, params *VerifyEmailV1Params, body VerifyEmailV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ViewDetailsV1(ctx context.Context, params *ViewDetailsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewViewDetailsV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ViewMembershipV1(ctx context.Context, params *ViewMembershipV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewViewMembershipV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ViewRentalsV1(ctx context.Context, params *ViewRentalsV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewViewRentalsV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewConfirmTwoFactorV1Request calls the generic ConfirmTwoFactorV1 builder with application/json body
+func NewConfirmTwoFactorV1Request(server string, params *ConfirmTwoFactorV1Params, body ConfirmTwoFactorV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmTwoFactorV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewConfirmTwoFactorV1RequestWithBody generates requests for ConfirmTwoFactorV1 with any type of body
+func NewConfirmTwoFactorV1RequestWithBody(server string, params *ConfirmTwoFactorV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/2fa/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDisableTwoFactorV1Request calls the generic DisableTwoFactorV1 builder with application/json body
+func NewDisableTwoFactorV1Request(server string, params *DisableTwoFactorV1Params, body DisableTwoFactorV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDisableTwoFactorV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewDisableTwoFactorV1RequestWithBody generates requests for DisableTwoFactorV1 with any type of body
+func NewDisableTwoFactorV1RequestWithBody(server string, params *DisableTwoFactorV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/2fa/disable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEnrollTwoFactorV1Request calls the generic EnrollTwoFactorV1 builder with application/json body
+func NewEnrollTwoFactorV1Request(server string, params *EnrollTwoFactorV1Params, body EnrollTwoFactorV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEnrollTwoFactorV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewEnrollTwoFactorV1RequestWithBody generates requests for EnrollTwoFactorV1 with any type of body
+func NewEnrollTwoFactorV1RequestWithBody(server string, params *EnrollTwoFactorV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/2fa/enroll")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRegenerateRecoveryCodesV1Request calls the generic RegenerateRecoveryCodesV1 builder with application/json body
+func NewRegenerateRecoveryCodesV1Request(server string, params *RegenerateRecoveryCodesV1Params, body RegenerateRecoveryCodesV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegenerateRecoveryCodesV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewRegenerateRecoveryCodesV1RequestWithBody generates requests for RegenerateRecoveryCodesV1 with any type of body
+func NewRegenerateRecoveryCodesV1RequestWithBody(server string, params *RegenerateRecoveryCodesV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/2fa/recovery-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListLicencesV1Request generates requests for ListLicencesV1
+func NewListLicencesV1Request(server string, params *ListLicencesV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/licences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLicenceImageV1Request generates requests for LicenceImageV1
+func NewLicenceImageV1Request(server string, params *LicenceImageV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/licences/image")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "licence_id", runtime.ParamLocationQuery, params.LicenceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReviewLicenceV1Request calls the generic ReviewLicenceV1 builder with application/json body
+func NewReviewLicenceV1Request(server string, body ReviewLicenceV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReviewLicenceV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReviewLicenceV1RequestWithBody generates requests for ReviewLicenceV1 with any type of body
+func NewReviewLicenceV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/licences/review")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReportAccountEventV1Request calls the generic ReportAccountEventV1 builder with application/json body
+func NewReportAccountEventV1Request(server string, body ReportAccountEventV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReportAccountEventV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReportAccountEventV1RequestWithBody generates requests for ReportAccountEventV1 with any type of body
+func NewReportAccountEventV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetAccountStatusV1Request calls the generic SetAccountStatusV1 builder with application/json body
+func NewSetAccountStatusV1Request(server string, params *SetAccountStatusV1Params, body SetAccountStatusV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetAccountStatusV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewSetAccountStatusV1RequestWithBody generates requests for SetAccountStatusV1 with any type of body
+func NewSetAccountStatusV1RequestWithBody(server string, params *SetAccountStatusV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XAdminActor != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Actor", runtime.ParamLocationHeader, *params.XAdminActor)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Admin-Actor", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAccountStatusHistoryV1Request generates requests for AccountStatusHistoryV1
+func NewAccountStatusHistoryV1Request(server string, params *AccountStatusHistoryV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/status-history")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnlockAccountRequest calls the generic UnlockAccount builder with application/json body
+func NewUnlockAccountRequest(server string, body UnlockAccountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUnlockAccountRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUnlockAccountRequestWithBody generates requests for UnlockAccount with any type of body
+func NewUnlockAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/account-unlocks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListLicencesRequest generates requests for ListLicences
+func NewListLicencesRequest(server string, params *ListLicencesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/admin/licences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLicenceImageRequest generates requests for LicenceImage
+func NewLicenceImageRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/admin/licences/%s/image", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReviewLicenceRequest calls the generic ReviewLicence builder with application/json body
+func NewReviewLicenceRequest(server string, id ID, body ReviewLicenceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReviewLicenceRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewReviewLicenceRequestWithBody generates requests for ReviewLicence with any type of body
+func NewReviewLicenceRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/admin/licences/%s/review", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReportAccountEventRequest calls the generic ReportAccountEvent builder with application/json body
+func NewReportAccountEventRequest(server string, id ID, body ReportAccountEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReportAccountEventRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewReportAccountEventRequestWithBody generates requests for ReportAccountEvent with any type of body
+func NewReportAccountEventRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetAccountStatusRequest calls the generic SetAccountStatus builder with application/json body
+func NewSetAccountStatusRequest(server string, id ID, params *SetAccountStatusParams, body SetAccountStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetAccountStatusRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewSetAccountStatusRequestWithBody generates requests for SetAccountStatus with any type of body
+func NewSetAccountStatusRequestWithBody(server string, id ID, params *SetAccountStatusParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XAdminActor != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Actor", runtime.ParamLocationHeader, *params.XAdminActor)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Admin-Actor", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAccountStatusHistoryRequest generates requests for AccountStatusHistory
+func NewAccountStatusHistoryRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/status-history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmEmailChangeRequest calls the generic ConfirmEmailChange builder with application/json body
+func NewConfirmEmailChangeRequest(server string, body ConfirmEmailChangeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmEmailChangeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConfirmEmailChangeRequestWithBody generates requests for ConfirmEmailChange with any type of body
+func NewConfirmEmailChangeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/email-changes/confirmation")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyEmailRequest calls the generic VerifyEmail builder with application/json body
+func NewVerifyEmailRequest(server string, body VerifyEmailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyEmailRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyEmailRequestWithBody generates requests for VerifyEmail with any type of body
+func NewVerifyEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/email-verifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRequestPasswordResetRequest calls the generic RequestPasswordReset builder with application/json body
+func NewRequestPasswordResetRequest(server string, body RequestPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRequestPasswordResetRequestWithBody generates requests for RequestPasswordReset with any type of body
+func NewRequestPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/password-resets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewResetPasswordRequest calls the generic ResetPassword builder with application/json body
+func NewResetPasswordRequest(server string, body ResetPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResetPasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewResetPasswordRequestWithBody generates requests for ResetPassword with any type of body
+func NewResetPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/password-resets/confirmation")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLoginTwoFactorRequest calls the generic LoginTwoFactor builder with application/json body
+func NewLoginTwoFactorRequest(server string, body LoginTwoFactorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginTwoFactorRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginTwoFactorRequestWithBody generates requests for LoginTwoFactor with any type of body
+func NewLoginTwoFactorRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/sessions/two-factor")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
+func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUserRequest calls the generic DeleteUser builder with application/json body
+func NewDeleteUserRequest(server string, id ID, body DeleteUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteUserRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewDeleteUserRequestWithBody generates requests for DeleteUser with any type of body
+func NewDeleteUserRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserRequest generates requests for GetUser
+func NewGetUserRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserRequest calls the generic UpdateUser builder with application/json body
+func NewUpdateUserRequest(server string, id ID, body UpdateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateUserRequestWithBody generates requests for UpdateUser with any type of body
+func NewUpdateUserRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRequestEmailChangeRequest calls the generic RequestEmailChange builder with application/json body
+func NewRequestEmailChangeRequest(server string, id ID, body RequestEmailChangeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestEmailChangeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRequestEmailChangeRequestWithBody generates requests for RequestEmailChange with any type of body
+func NewRequestEmailChangeRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/email-change", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExportDataRequest generates requests for ExportData
+func NewExportDataRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitLicenceRequestWithBody generates requests for SubmitLicence with any type of body
+func NewSubmitLicenceRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/licences", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetLatestLicenceRequest generates requests for GetLatestLicence
+func NewGetLatestLicenceRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/licences/latest", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMembershipRequest generates requests for GetMembership
+func NewGetMembershipRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/membership", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateMembershipRequest calls the generic UpdateMembership builder with application/json body
+func NewUpdateMembershipRequest(server string, id ID, body UpdateMembershipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMembershipRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateMembershipRequestWithBody generates requests for UpdateMembership with any type of body
+func NewUpdateMembershipRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/membership", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewChangePasswordRequest calls the generic ChangePassword builder with application/json body
+func NewChangePasswordRequest(server string, id ID, body ChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewChangePasswordRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewChangePasswordRequestWithBody generates requests for ChangePassword with any type of body
+func NewChangePasswordRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/password", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListRentalsRequest generates requests for ListRentals
+func NewListRentalsRequest(server string, id ID, params *ListRentalsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/rentals", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDisableTwoFactorRequest calls the generic DisableTwoFactor builder with application/json body
+func NewDisableTwoFactorRequest(server string, id ID, body DisableTwoFactorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDisableTwoFactorRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewDisableTwoFactorRequestWithBody generates requests for DisableTwoFactor with any type of body
+func NewDisableTwoFactorRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/two-factor", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEnrollTwoFactorRequest calls the generic EnrollTwoFactor builder with application/json body
+func NewEnrollTwoFactorRequest(server string, id ID, body EnrollTwoFactorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEnrollTwoFactorRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewEnrollTwoFactorRequestWithBody generates requests for EnrollTwoFactor with any type of body
+func NewEnrollTwoFactorRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/two-factor", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConfirmTwoFactorRequest calls the generic ConfirmTwoFactor builder with application/json body
+func NewConfirmTwoFactorRequest(server string, id ID, body ConfirmTwoFactorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmTwoFactorRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewConfirmTwoFactorRequestWithBody generates requests for ConfirmTwoFactor with any type of body
+func NewConfirmTwoFactorRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/two-factor/confirmation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRegenerateRecoveryCodesRequest calls the generic RegenerateRecoveryCodes builder with application/json body
+func NewRegenerateRecoveryCodesRequest(server string, id ID, body RegenerateRecoveryCodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegenerateRecoveryCodesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRegenerateRecoveryCodesRequestWithBody generates requests for RegenerateRecoveryCodes with any type of body
+func NewRegenerateRecoveryCodesRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/%s/two-factor/recovery-codes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewResendVerificationRequest calls the generic ResendVerification builder with application/json body
+func NewResendVerificationRequest(server string, body ResendVerificationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResendVerificationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewResendVerificationRequestWithBody generates requests for ResendVerification with any type of body
+func NewResendVerificationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/verification-emails")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRequestEmailChangeV1Request calls the generic RequestEmailChangeV1 builder with application/json body
+func NewRequestEmailChangeV1Request(server string, params *RequestEmailChangeV1Params, body RequestEmailChangeV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestEmailChangeV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewRequestEmailChangeV1RequestWithBody generates requests for RequestEmailChangeV1 with any type of body
+func NewRequestEmailChangeV1RequestWithBody(server string, params *RequestEmailChangeV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/change-email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConfirmEmailChangeLinkRequest generates requests for ConfirmEmailChangeLink
+func NewConfirmEmailChangeLinkRequest(server string, params *ConfirmEmailChangeLinkParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/confirm-email-change")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmEmailChangeV1Request calls the generic ConfirmEmailChangeV1 builder with application/json body
+func NewConfirmEmailChangeV1Request(server string, params *ConfirmEmailChangeV1Params, body ConfirmEmailChangeV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmEmailChangeV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewConfirmEmailChangeV1RequestWithBody generates requests for ConfirmEmailChangeV1 with any type of body
+func NewConfirmEmailChangeV1RequestWithBody(server string, params *ConfirmEmailChangeV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/confirm-email-change")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Token != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, *params.Token); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateUserV1Request calls the generic CreateUserV1 builder with application/json body
+func NewCreateUserV1Request(server string, body CreateUserV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateUserV1RequestWithBody generates requests for CreateUserV1 with any type of body
+func NewCreateUserV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/create-user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAccountV1Request calls the generic DeleteAccountV1 builder with application/json body
+func NewDeleteAccountV1Request(server string, params *DeleteAccountV1Params, body DeleteAccountV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteAccountV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewDeleteAccountV1RequestWithBody generates requests for DeleteAccountV1 with any type of body
+func NewDeleteAccountV1RequestWithBody(server string, params *DeleteAccountV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/delete-account")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExportDataV1Request generates requests for ExportDataV1
+func NewExportDataV1Request(server string, params *ExportDataV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/export-data")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewViewLicenceV1Request generates requests for ViewLicenceV1
+func NewViewLicenceV1Request(server string, params *ViewLicenceV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/licence")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitLicenceV1RequestWithBody generates requests for SubmitLicenceV1 with any type of body
+func NewSubmitLicenceV1RequestWithBody(server string, params *SubmitLicenceV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/licence")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLoginV1Request calls the generic LoginV1 builder with application/json body
+func NewLoginV1Request(server string, body LoginV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginV1RequestWithBody generates requests for LoginV1 with any type of body
+func NewLoginV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLoginTwoFactorV1Request calls the generic LoginTwoFactorV1 builder with application/json body
+func NewLoginTwoFactorV1Request(server string, body LoginTwoFactorV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginTwoFactorV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginTwoFactorV1RequestWithBody generates requests for LoginTwoFactorV1 with any type of body
+func NewLoginTwoFactorV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login/2fa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRequestPasswordResetV1Request calls the generic RequestPasswordResetV1 builder with application/json body
+func NewRequestPasswordResetV1Request(server string, body RequestPasswordResetV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestPasswordResetV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRequestPasswordResetV1RequestWithBody generates requests for RequestPasswordResetV1 with any type of body
+func NewRequestPasswordResetV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/request-password-reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewResendVerificationV1Request calls the generic ResendVerificationV1 builder with application/json body
+func NewResendVerificationV1Request(server string, body ResendVerificationV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResendVerificationV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewResendVerificationV1RequestWithBody generates requests for ResendVerificationV1 with any type of body
+func NewResendVerificationV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/resend-verification")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewResetPasswordV1Request calls the generic ResetPasswordV1 builder with application/json body
+func NewResetPasswordV1Request(server string, body ResetPasswordV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResetPasswordV1RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewResetPasswordV1RequestWithBody generates requests for ResetPasswordV1 with any type of body
+func NewResetPasswordV1RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reset-password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUnlockAccountLinkRequest generates requests for UnlockAccountLink
+func NewUnlockAccountLinkRequest(server string, params *UnlockAccountLinkParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/unlock-account")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnlockAccountV1Request calls the generic UnlockAccountV1 builder with application/json body
+func NewUnlockAccountV1Request(server string, params *UnlockAccountV1Params, body UnlockAccountV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUnlockAccountV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewUnlockAccountV1RequestWithBody generates requests for UnlockAccountV1 with any type of body
+func NewUnlockAccountV1RequestWithBody(server string, params *UnlockAccountV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/unlock-account")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Token != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, *params.Token); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateDetailsV1Request calls the generic UpdateDetailsV1 builder with application/json body
+func NewUpdateDetailsV1Request(server string, params *UpdateDetailsV1Params, body UpdateDetailsV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDetailsV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewUpdateDetailsV1RequestWithBody generates requests for UpdateDetailsV1 with any type of body
+func NewUpdateDetailsV1RequestWithBody(server string, params *UpdateDetailsV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/update-details")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateDetailsPostV1Request calls the generic UpdateDetailsPostV1 builder with application/json body
+func NewUpdateDetailsPostV1Request(server string, params *UpdateDetailsPostV1Params, body UpdateDetailsPostV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDetailsPostV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewUpdateDetailsPostV1RequestWithBody generates requests for UpdateDetailsPostV1 with any type of body
+func NewUpdateDetailsPostV1RequestWithBody(server string, params *UpdateDetailsPostV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/update-details")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateMembershipV1Request calls the generic UpdateMembershipV1 builder with application/json body
+func NewUpdateMembershipV1Request(server string, params *UpdateMembershipV1Params, body UpdateMembershipV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMembershipV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewUpdateMembershipV1RequestWithBody generates requests for UpdateMembershipV1 with any type of body
+func NewUpdateMembershipV1RequestWithBody(server string, params *UpdateMembershipV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/update-membership")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdatePasswordV1Request calls the generic UpdatePasswordV1 builder with application/json body
+func NewUpdatePasswordV1Request(server string, params *UpdatePasswordV1Params, body UpdatePasswordV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePasswordV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewUpdatePasswordV1RequestWithBody generates requests for UpdatePasswordV1 with any type of body
+func NewUpdatePasswordV1RequestWithBody(server string, params *UpdatePasswordV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/update-password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyEmailLinkRequest generates requests for VerifyEmailLink
+func NewVerifyEmailLinkRequest(server string, params *VerifyEmailLinkParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/verify-email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVerifyEmailV1Request calls the generic VerifyEmailV1 builder with application/json body
+func NewVerifyEmailV1Request(server string, params *VerifyEmailV1Params, body VerifyEmailV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyEmailV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewVerifyEmailV1RequestWithBody generates requests for VerifyEmailV1 with any type of body
+func NewVerifyEmailV1RequestWithBody(server string, params *VerifyEmailV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/verify-email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Token != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, *params.Token); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewViewDetailsV1Request generates requests for ViewDetailsV1
+func NewViewDetailsV1Request(server string, params *ViewDetailsV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/view-details")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewViewMembershipV1Request generates requests for ViewMembershipV1
+func NewViewMembershipV1Request(server string, params *ViewMembershipV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/view-membership")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewViewRentalsV1Request generates requests for ViewRentalsV1
+func NewViewRentalsV1Request(server string, params *ViewRentalsV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/view-rentals")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ConfirmTwoFactorV1WithBodyWithResponse request with any body
+	ConfirmTwoFactorV1WithBodyWithResponse(ctx context.Context, params *ConfirmTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorV1Response, error)
+
+	ConfirmTwoFactorV1WithResponse(ctx context.Context, params *ConfirmTwoFactorV1Params, body ConfirmTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorV1Response, error)
+
+	// DisableTwoFactorV1WithBodyWithResponse request with any body
+	DisableTwoFactorV1WithBodyWithResponse(ctx context.Context, params *DisableTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTwoFactorV1Response, error)
+
+	DisableTwoFactorV1WithResponse(ctx context.Context, params *DisableTwoFactorV1Params, body DisableTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTwoFactorV1Response, error)
+
+	// EnrollTwoFactorV1WithBodyWithResponse request with any body
+	EnrollTwoFactorV1WithBodyWithResponse(ctx context.Context, params *EnrollTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnrollTwoFactorV1Response, error)
+
+	EnrollTwoFactorV1WithResponse(ctx context.Context, params *EnrollTwoFactorV1Params, body EnrollTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*EnrollTwoFactorV1Response, error)
+
+	// RegenerateRecoveryCodesV1WithBodyWithResponse request with any body
+	RegenerateRecoveryCodesV1WithBodyWithResponse(ctx context.Context, params *RegenerateRecoveryCodesV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesV1Response, error)
+
+	RegenerateRecoveryCodesV1WithResponse(ctx context.Context, params *RegenerateRecoveryCodesV1Params, body RegenerateRecoveryCodesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesV1Response, error)
+
+	// ListLicencesV1WithResponse request
+	ListLicencesV1WithResponse(ctx context.Context, params *ListLicencesV1Params, reqEditors ...RequestEditorFn) (*ListLicencesV1Response, error)
+
+	// LicenceImageV1WithResponse request
+	LicenceImageV1WithResponse(ctx context.Context, params *LicenceImageV1Params, reqEditors ...RequestEditorFn) (*LicenceImageV1Response, error)
+
+	// ReviewLicenceV1WithBodyWithResponse request with any body
+	ReviewLicenceV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReviewLicenceV1Response, error)
+
+	ReviewLicenceV1WithResponse(ctx context.Context, body ReviewLicenceV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ReviewLicenceV1Response, error)
+
+	// ReportAccountEventV1WithBodyWithResponse request with any body
+	ReportAccountEventV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReportAccountEventV1Response, error)
+
+	ReportAccountEventV1WithResponse(ctx context.Context, body ReportAccountEventV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ReportAccountEventV1Response, error)
+
+	// SetAccountStatusV1WithBodyWithResponse request with any body
+	SetAccountStatusV1WithBodyWithResponse(ctx context.Context, params *SetAccountStatusV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAccountStatusV1Response, error)
+
+	SetAccountStatusV1WithResponse(ctx context.Context, params *SetAccountStatusV1Params, body SetAccountStatusV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SetAccountStatusV1Response, error)
+
+	// AccountStatusHistoryV1WithResponse request
+	AccountStatusHistoryV1WithResponse(ctx context.Context, params *AccountStatusHistoryV1Params, reqEditors ...RequestEditorFn) (*AccountStatusHistoryV1Response, error)
+
+	// UnlockAccountWithBodyWithResponse request with any body
+	UnlockAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnlockAccountResponse, error)
+
+	UnlockAccountWithResponse(ctx context.Context, body UnlockAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*UnlockAccountResponse, error)
+
+	// ListLicencesWithResponse request
+	ListLicencesWithResponse(ctx context.Context, params *ListLicencesParams, reqEditors ...RequestEditorFn) (*ListLicencesResponse, error)
+
+	// LicenceImageWithResponse request
+	LicenceImageWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*LicenceImageResponse, error)
+
+	// ReviewLicenceWithBodyWithResponse request with any body
+	ReviewLicenceWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReviewLicenceResponse, error)
+
+	ReviewLicenceWithResponse(ctx context.Context, id ID, body ReviewLicenceJSONRequestBody, reqEditors ...RequestEditorFn) (*ReviewLicenceResponse, error)
+
+	// ReportAccountEventWithBodyWithResponse request with any body
+	ReportAccountEventWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReportAccountEventResponse, error)
+
+	ReportAccountEventWithResponse(ctx context.Context, id ID, body ReportAccountEventJSONRequestBody, reqEditors ...RequestEditorFn) (*ReportAccountEventResponse, error)
+
+	// SetAccountStatusWithBodyWithResponse request with any body
+	SetAccountStatusWithBodyWithResponse(ctx context.Context, id ID, params *SetAccountStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAccountStatusResponse, error)
+
+	SetAccountStatusWithResponse(ctx context.Context, id ID, params *SetAccountStatusParams, body SetAccountStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAccountStatusResponse, error)
+
+	// AccountStatusHistoryWithResponse request
+	AccountStatusHistoryWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*AccountStatusHistoryResponse, error)
+
+	// ConfirmEmailChangeWithBodyWithResponse request with any body
+	ConfirmEmailChangeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeResponse, error)
+
+	ConfirmEmailChangeWithResponse(ctx context.Context, body ConfirmEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeResponse, error)
+
+	// VerifyEmailWithBodyWithResponse request with any body
+	VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
+
+	VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
+
+	// RequestPasswordResetWithBodyWithResponse request with any body
+	RequestPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error)
+
+	RequestPasswordResetWithResponse(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error)
+
+	// ResetPasswordWithBodyWithResponse request with any body
+	ResetPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetPasswordResponse, error)
+
+	ResetPasswordWithResponse(ctx context.Context, body ResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetPasswordResponse, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	// LoginTwoFactorWithBodyWithResponse request with any body
+	LoginTwoFactorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginTwoFactorResponse, error)
+
+	LoginTwoFactorWithResponse(ctx context.Context, body LoginTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginTwoFactorResponse, error)
+
+	// CreateUserWithBodyWithResponse request with any body
+	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	// DeleteUserWithBodyWithResponse request with any body
+	DeleteUserWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error)
+
+	DeleteUserWithResponse(ctx context.Context, id ID, body DeleteUserJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error)
+
+	// GetUserWithResponse request
+	GetUserWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
+
+	// UpdateUserWithBodyWithResponse request with any body
+	UpdateUserWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error)
+
+	UpdateUserWithResponse(ctx context.Context, id ID, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error)
+
+	// RequestEmailChangeWithBodyWithResponse request with any body
+	RequestEmailChangeWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestEmailChangeResponse, error)
+
+	RequestEmailChangeWithResponse(ctx context.Context, id ID, body RequestEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestEmailChangeResponse, error)
+
+	// ExportDataWithResponse request
+	ExportDataWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ExportDataResponse, error)
+
+	// SubmitLicenceWithBodyWithResponse request with any body
+	SubmitLicenceWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitLicenceResponse, error)
+
+	// GetLatestLicenceWithResponse request
+	GetLatestLicenceWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetLatestLicenceResponse, error)
+
+	// GetMembershipWithResponse request
+	GetMembershipWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetMembershipResponse, error)
+
+	// UpdateMembershipWithBodyWithResponse request with any body
+	UpdateMembershipWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMembershipResponse, error)
+
+	UpdateMembershipWithResponse(ctx context.Context, id ID, body UpdateMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMembershipResponse, error)
+
+	// ChangePasswordWithBodyWithResponse request with any body
+	ChangePasswordWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error)
+
+	ChangePasswordWithResponse(ctx context.Context, id ID, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error)
+
+	// ListRentalsWithResponse request
+	ListRentalsWithResponse(ctx context.Context, id ID, params *ListRentalsParams, reqEditors ...RequestEditorFn) (*ListRentalsResponse, error)
+
+	// DisableTwoFactorWithBodyWithResponse request with any body
+	DisableTwoFactorWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTwoFactorResponse, error)
+
+	DisableTwoFactorWithResponse(ctx context.Context, id ID, body DisableTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTwoFactorResponse, error)
+
+	// EnrollTwoFactorWithBodyWithResponse request with any body
+	EnrollTwoFactorWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnrollTwoFactorResponse, error)
+
+	EnrollTwoFactorWithResponse(ctx context.Context, id ID, body EnrollTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*EnrollTwoFactorResponse, error)
+
+	// ConfirmTwoFactorWithBodyWithResponse request with any body
+	ConfirmTwoFactorWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorResponse, error)
+
+	ConfirmTwoFactorWithResponse(ctx context.Context, id ID, body ConfirmTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorResponse, error)
+
+	// RegenerateRecoveryCodesWithBodyWithResponse request with any body
+	RegenerateRecoveryCodesWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error)
+
+	RegenerateRecoveryCodesWithResponse(ctx context.Context, id ID, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error)
+
+	// ResendVerificationWithBodyWithResponse request with any body
+	ResendVerificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResendVerificationResponse, error)
+
+	ResendVerificationWithResponse(ctx context.Context, body ResendVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*ResendVerificationResponse, error)
+
+	// RequestEmailChangeV1WithBodyWithResponse request with any body
+	RequestEmailChangeV1WithBodyWithResponse(ctx context.Context, params *RequestEmailChangeV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestEmailChangeV1Response, error)
+
+	RequestEmailChangeV1WithResponse(ctx context.Context, params *RequestEmailChangeV1Params, body RequestEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RequestEmailChangeV1Response, error)
+
+	// ConfirmEmailChangeLinkWithResponse request
+	ConfirmEmailChangeLinkWithResponse(ctx context.Context, params *ConfirmEmailChangeLinkParams, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeLinkResponse, error)
+
+	// ConfirmEmailChangeV1WithBodyWithResponse request with any body
+	ConfirmEmailChangeV1WithBodyWithResponse(ctx context.Context, params *ConfirmEmailChangeV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeV1Response, error)
+
+	ConfirmEmailChangeV1WithResponse(ctx context.Context, params *ConfirmEmailChangeV1Params, body ConfirmEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeV1Response, error)
+
+	// CreateUserV1WithBodyWithResponse request with any body
+	CreateUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserV1Response, error)
+
+	CreateUserV1WithResponse(ctx context.Context, body CreateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserV1Response, error)
+
+	// DeleteAccountV1WithBodyWithResponse request with any body
+	DeleteAccountV1WithBodyWithResponse(ctx context.Context, params *DeleteAccountV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAccountV1Response, error)
+
+	DeleteAccountV1WithResponse(ctx context.Context, params *DeleteAccountV1Params, body DeleteAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAccountV1Response, error)
+
+	// ExportDataV1WithResponse request
+	ExportDataV1WithResponse(ctx context.Context, params *ExportDataV1Params, reqEditors ...RequestEditorFn) (*ExportDataV1Response, error)
+
+	// ViewLicenceV1WithResponse request
+	ViewLicenceV1WithResponse(ctx context.Context, params *ViewLicenceV1Params, reqEditors ...RequestEditorFn) (*ViewLicenceV1Response, error)
+
+	// SubmitLicenceV1WithBodyWithResponse request with any body
+	SubmitLicenceV1WithBodyWithResponse(ctx context.Context, params *SubmitLicenceV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitLicenceV1Response, error)
+
+	// LoginV1WithBodyWithResponse request with any body
+	LoginV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginV1Response, error)
+
+	LoginV1WithResponse(ctx context.Context, body LoginV1JSONRequestBody, reqEditors ...RequestEditorFn) (*LoginV1Response, error)
+
+	// LoginTwoFactorV1WithBodyWithResponse request with any body
+	LoginTwoFactorV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginTwoFactorV1Response, error)
+
+	LoginTwoFactorV1WithResponse(ctx context.Context, body LoginTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*LoginTwoFactorV1Response, error)
+
+	// RequestPasswordResetV1WithBodyWithResponse request with any body
+	RequestPasswordResetV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestPasswordResetV1Response, error)
+
+	RequestPasswordResetV1WithResponse(ctx context.Context, body RequestPasswordResetV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RequestPasswordResetV1Response, error)
+
+	// ResendVerificationV1WithBodyWithResponse request with any body
+	ResendVerificationV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResendVerificationV1Response, error)
+
+	ResendVerificationV1WithResponse(ctx context.Context, body ResendVerificationV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ResendVerificationV1Response, error)
+
+	// ResetPasswordV1WithBodyWithResponse request with any body
+	ResetPasswordV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetPasswordV1Response, error)
+
+	ResetPasswordV1WithResponse(ctx context.Context, body ResetPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ResetPasswordV1Response, error)
+
+	// UnlockAccountLinkWithResponse request
+	UnlockAccountLinkWithResponse(ctx context.Context, params *UnlockAccountLinkParams, reqEditors ...RequestEditorFn) (*UnlockAccountLinkResponse, error)
+
+	// UnlockAccountV1WithBodyWithResponse request with any body
+	UnlockAccountV1WithBodyWithResponse(ctx context.Context, params *UnlockAccountV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnlockAccountV1Response, error)
+
+	UnlockAccountV1WithResponse(ctx context.Context, params *UnlockAccountV1Params, body UnlockAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UnlockAccountV1Response, error)
+
+	// UpdateDetailsV1WithBodyWithResponse request with any body
+	UpdateDetailsV1WithBodyWithResponse(ctx context.Context, params *UpdateDetailsV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDetailsV1Response, error)
+
+	UpdateDetailsV1WithResponse(ctx context.Context, params *UpdateDetailsV1Params, body UpdateDetailsV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDetailsV1Response, error)
+
+	// UpdateDetailsPostV1WithBodyWithResponse request with any body
+	UpdateDetailsPostV1WithBodyWithResponse(ctx context.Context, params *UpdateDetailsPostV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDetailsPostV1Response, error)
+
+	UpdateDetailsPostV1WithResponse(ctx context.Context, params *UpdateDetailsPostV1Params, body UpdateDetailsPostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDetailsPostV1Response, error)
+
+	// UpdateMembershipV1WithBodyWithResponse request with any body
+	UpdateMembershipV1WithBodyWithResponse(ctx context.Context, params *UpdateMembershipV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMembershipV1Response, error)
+
+	UpdateMembershipV1WithResponse(ctx context.Context, params *UpdateMembershipV1Params, body UpdateMembershipV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMembershipV1Response, error)
+
+	// UpdatePasswordV1WithBodyWithResponse request with any body
+	UpdatePasswordV1WithBodyWithResponse(ctx context.Context, params *UpdatePasswordV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePasswordV1Response, error)
+
+	UpdatePasswordV1WithResponse(ctx context.Context, params *UpdatePasswordV1Params, body UpdatePasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePasswordV1Response, error)
+
+	// VerifyEmailLinkWithResponse request
+	VerifyEmailLinkWithResponse(ctx context.Context, params *VerifyEmailLinkParams, reqEditors ...RequestEditorFn) (*VerifyEmailLinkResponse, error)
+
+	// VerifyEmailV1WithBodyWithResponse request with any body
+	VerifyEmailV1WithBodyWithResponse(ctx context.Context, params *VerifyEmailV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailV1Response, error)
+
+	VerifyEmailV1WithResponse(ctx context.Context, params *VerifyEmailV1Params, body VerifyEmailV1JSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailV1Response, error)
+
+	// ViewDetailsV1WithResponse request
+	ViewDetailsV1WithResponse(ctx context.Context, params *ViewDetailsV1Params, reqEditors ...RequestEditorFn) (*ViewDetailsV1Response, error)
+
+	// ViewMembershipV1WithResponse request
+	ViewMembershipV1WithResponse(ctx context.Context, params *ViewMembershipV1Params, reqEditors ...RequestEditorFn) (*ViewMembershipV1Response, error)
+
+	// ViewRentalsV1WithResponse request
+	ViewRentalsV1WithResponse(ctx context.Context, params *ViewRentalsV1Params, reqEditors ...RequestEditorFn) (*ViewRentalsV1Response, error)
+}
+
+type ConfirmTwoFactorV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodes
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmTwoFactorV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmTwoFactorV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisableTwoFactorV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableTwoFactorV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableTwoFactorV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnrollTwoFactorV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TwoFactorEnrolment
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r EnrollTwoFactorV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnrollTwoFactorV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegenerateRecoveryCodesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodes
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r RegenerateRecoveryCodesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegenerateRecoveryCodesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLicencesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Licence
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLicencesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLicencesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LicenceImageV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r LicenceImageV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LicenceImageV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReviewLicenceV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LicenceDecision
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ReviewLicenceV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReviewLicenceV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReportAccountEventV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AccountEventRecorded
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ReportAccountEventV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReportAccountEventV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetAccountStatusV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusChanged
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r SetAccountStatusV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetAccountStatusV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AccountStatusHistoryV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusHistory
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r AccountStatusHistoryV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AccountStatusHistoryV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnlockAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r UnlockAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnlockAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLicencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Licence
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLicencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLicencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LicenceImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r LicenceImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LicenceImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReviewLicenceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LicenceDecision
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ReviewLicenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReviewLicenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReportAccountEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AccountEventRecorded
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ReportAccountEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReportAccountEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetAccountStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusChanged
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r SetAccountStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetAccountStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AccountStatusHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatusHistory
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r AccountStatusHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AccountStatusHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmEmailChangeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EmailChanged
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmEmailChangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmEmailChangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyEmailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserConfirmed
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestPasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResult
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginTwoFactorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SignedIn
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginTwoFactorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginTwoFactorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedUser
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Details
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestEmailChangeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *PendingEmailChange
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestEmailChangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestEmailChangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitLicenceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *LicenceDecision
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitLicenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitLicenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLatestLicenceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Licence
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLatestLicenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLatestLicenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMembershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserMembership
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMembershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMembershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMembershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMembershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMembershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ChangePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ChangePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ChangePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRentalsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalPage
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRentalsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRentalsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisableTwoFactorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableTwoFactorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableTwoFactorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnrollTwoFactorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TwoFactorEnrolment
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r EnrollTwoFactorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnrollTwoFactorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmTwoFactorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodes
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmTwoFactorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmTwoFactorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegenerateRecoveryCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodes
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r RegenerateRecoveryCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegenerateRecoveryCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResendVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ResendVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResendVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestEmailChangeV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *PendingEmailChange
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestEmailChangeV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestEmailChangeV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmEmailChangeLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EmailChanged
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmEmailChangeLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmEmailChangeLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmEmailChangeV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EmailChanged
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmEmailChangeV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmEmailChangeV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedUser
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAccountV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAccountV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAccountV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportDataV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportDataV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportDataV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ViewLicenceV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Licence
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ViewLicenceV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ViewLicenceV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitLicenceV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *LicenceDecision
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitLicenceV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitLicenceV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResult
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginTwoFactorV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SignedIn
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginTwoFactorV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginTwoFactorV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestPasswordResetV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestPasswordResetV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestPasswordResetV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResendVerificationV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ResendVerificationV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResendVerificationV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetPasswordV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetPasswordV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetPasswordV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnlockAccountLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r UnlockAccountLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnlockAccountLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnlockAccountV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r UnlockAccountV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnlockAccountV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDetailsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDetailsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDetailsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDetailsPostV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDetailsPostV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDetailsPostV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMembershipV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMembershipV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMembershipV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePasswordV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Message
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePasswordV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePasswordV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyEmailLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserConfirmed
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyEmailLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyEmailLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyEmailV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserConfirmed
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyEmailV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyEmailV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ViewDetailsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Details
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ViewDetailsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ViewDetailsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ViewMembershipV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserMembership
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ViewMembershipV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ViewMembershipV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ViewRentalsV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalList
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ViewRentalsV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ViewRentalsV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ConfirmTwoFactorV1WithBodyWithResponse request with arbitrary body returning *ConfirmTwoFactorV1Response
+func (c *ClientWithResponses) ConfirmTwoFactorV1WithBodyWithResponse(ctx context.Context, params *ConfirmTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorV1Response, error) {
+	rsp, err := c.ConfirmTwoFactorV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTwoFactorV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmTwoFactorV1WithResponse(ctx context.Context, params *ConfirmTwoFactorV1Params, body ConfirmTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorV1Response, error) {
+	rsp, err := c.ConfirmTwoFactorV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTwoFactorV1Response(rsp)
+}
+
+// DisableTwoFactorV1WithBodyWithResponse request with arbitrary body returning *DisableTwoFactorV1Response
+func (c *ClientWithResponses) DisableTwoFactorV1WithBodyWithResponse(ctx context.Context, params *DisableTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTwoFactorV1Response, error) {
+	rsp, err := c.DisableTwoFactorV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTwoFactorV1Response(rsp)
+}
+
+func (c *ClientWithResponses) DisableTwoFactorV1WithResponse(ctx context.Context, params *DisableTwoFactorV1Params, body DisableTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTwoFactorV1Response, error) {
+	rsp, err := c.DisableTwoFactorV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTwoFactorV1Response(rsp)
+}
+
+// EnrollTwoFactorV1WithBodyWithResponse request with arbitrary body returning *EnrollTwoFactorV1Response
+func (c *ClientWithResponses) EnrollTwoFactorV1WithBodyWithResponse(ctx context.Context, params *EnrollTwoFactorV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnrollTwoFactorV1Response, error) {
+	rsp, err := c.EnrollTwoFactorV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollTwoFactorV1Response(rsp)
+}
+
+func (c *ClientWithResponses) EnrollTwoFactorV1WithResponse(ctx context.Context, params *EnrollTwoFactorV1Params, body EnrollTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*EnrollTwoFactorV1Response, error) {
+	rsp, err := c.EnrollTwoFactorV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollTwoFactorV1Response(rsp)
+}
+
+// RegenerateRecoveryCodesV1WithBodyWithResponse request with arbitrary body returning *RegenerateRecoveryCodesV1Response
+func (c *ClientWithResponses) RegenerateRecoveryCodesV1WithBodyWithResponse(ctx context.Context, params *RegenerateRecoveryCodesV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesV1Response, error) {
+	rsp, err := c.RegenerateRecoveryCodesV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesV1Response(rsp)
+}
+
+func (c *ClientWithResponses) RegenerateRecoveryCodesV1WithResponse(ctx context.Context, params *RegenerateRecoveryCodesV1Params, body RegenerateRecoveryCodesV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesV1Response, error) {
+	rsp, err := c.RegenerateRecoveryCodesV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesV1Response(rsp)
+}
+
+// ListLicencesV1WithResponse request returning *ListLicencesV1Response
+func (c *ClientWithResponses) ListLicencesV1WithResponse(ctx context.Context, params *ListLicencesV1Params, reqEditors ...RequestEditorFn) (*ListLicencesV1Response, error) {
+	rsp, err := c.ListLicencesV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLicencesV1Response(rsp)
+}
+
+// LicenceImageV1WithResponse request returning *LicenceImageV1Response
+func (c *ClientWithResponses) LicenceImageV1WithResponse(ctx context.Context, params *LicenceImageV1Params, reqEditors ...RequestEditorFn) (*LicenceImageV1Response, error) {
+	rsp, err := c.LicenceImageV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLicenceImageV1Response(rsp)
+}
+
+// ReviewLicenceV1WithBodyWithResponse request with arbitrary body returning *ReviewLicenceV1Response
+func (c *ClientWithResponses) ReviewLicenceV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReviewLicenceV1Response, error) {
+	rsp, err := c.ReviewLicenceV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReviewLicenceV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ReviewLicenceV1WithResponse(ctx context.Context, body ReviewLicenceV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ReviewLicenceV1Response, error) {
+	rsp, err := c.ReviewLicenceV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReviewLicenceV1Response(rsp)
+}
+
+// ReportAccountEventV1WithBodyWithResponse request with arbitrary body returning *ReportAccountEventV1Response
+func (c *ClientWithResponses) ReportAccountEventV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReportAccountEventV1Response, error) {
+	rsp, err := c.ReportAccountEventV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReportAccountEventV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ReportAccountEventV1WithResponse(ctx context.Context, body ReportAccountEventV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ReportAccountEventV1Response, error) {
+	rsp, err := c.ReportAccountEventV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReportAccountEventV1Response(rsp)
+}
+
+// SetAccountStatusV1WithBodyWithResponse request with arbitrary body returning *SetAccountStatusV1Response
+func (c *ClientWithResponses) SetAccountStatusV1WithBodyWithResponse(ctx context.Context, params *SetAccountStatusV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAccountStatusV1Response, error) {
+	rsp, err := c.SetAccountStatusV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAccountStatusV1Response(rsp)
+}
+
+func (c *ClientWithResponses) SetAccountStatusV1WithResponse(ctx context.Context, params *SetAccountStatusV1Params, body SetAccountStatusV1JSONRequestBody, reqEditors ...RequestEditorFn) (*SetAccountStatusV1Response, error) {
+	rsp, err := c.SetAccountStatusV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAccountStatusV1Response(rsp)
+}
+
+// AccountStatusHistoryV1WithResponse request returning *AccountStatusHistoryV1Response
+func (c *ClientWithResponses) AccountStatusHistoryV1WithResponse(ctx context.Context, params *AccountStatusHistoryV1Params, reqEditors ...RequestEditorFn) (*AccountStatusHistoryV1Response, error) {
+	rsp, err := c.AccountStatusHistoryV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAccountStatusHistoryV1Response(rsp)
+}
+
+// UnlockAccountWithBodyWithResponse request with arbitrary body returning *UnlockAccountResponse
+func (c *ClientWithResponses) UnlockAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnlockAccountResponse, error) {
+	rsp, err := c.UnlockAccountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlockAccountResponse(rsp)
+}
+
+func (c *ClientWithResponses) UnlockAccountWithResponse(ctx context.Context, body UnlockAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*UnlockAccountResponse, error) {
+	rsp, err := c.UnlockAccount(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlockAccountResponse(rsp)
+}
+
+// ListLicencesWithResponse request returning *ListLicencesResponse
+func (c *ClientWithResponses) ListLicencesWithResponse(ctx context.Context, params *ListLicencesParams, reqEditors ...RequestEditorFn) (*ListLicencesResponse, error) {
+	rsp, err := c.ListLicences(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLicencesResponse(rsp)
+}
+
+// LicenceImageWithResponse request returning *LicenceImageResponse
+func (c *ClientWithResponses) LicenceImageWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*LicenceImageResponse, error) {
+	rsp, err := c.LicenceImage(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLicenceImageResponse(rsp)
+}
+
+// ReviewLicenceWithBodyWithResponse request with arbitrary body returning *ReviewLicenceResponse
+func (c *ClientWithResponses) ReviewLicenceWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReviewLicenceResponse, error) {
+	rsp, err := c.ReviewLicenceWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReviewLicenceResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReviewLicenceWithResponse(ctx context.Context, id ID, body ReviewLicenceJSONRequestBody, reqEditors ...RequestEditorFn) (*ReviewLicenceResponse, error) {
+	rsp, err := c.ReviewLicence(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReviewLicenceResponse(rsp)
+}
+
+// ReportAccountEventWithBodyWithResponse request with arbitrary body returning *ReportAccountEventResponse
+func (c *ClientWithResponses) ReportAccountEventWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReportAccountEventResponse, error) {
+	rsp, err := c.ReportAccountEventWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReportAccountEventResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReportAccountEventWithResponse(ctx context.Context, id ID, body ReportAccountEventJSONRequestBody, reqEditors ...RequestEditorFn) (*ReportAccountEventResponse, error) {
+	rsp, err := c.ReportAccountEvent(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReportAccountEventResponse(rsp)
+}
+
+// SetAccountStatusWithBodyWithResponse request with arbitrary body returning *SetAccountStatusResponse
+func (c *ClientWithResponses) SetAccountStatusWithBodyWithResponse(ctx context.Context, id ID, params *SetAccountStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAccountStatusResponse, error) {
+	rsp, err := c.SetAccountStatusWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAccountStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetAccountStatusWithResponse(ctx context.Context, id ID, params *SetAccountStatusParams, body SetAccountStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAccountStatusResponse, error) {
+	rsp, err := c.SetAccountStatus(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAccountStatusResponse(rsp)
+}
+
+// AccountStatusHistoryWithResponse request returning *AccountStatusHistoryResponse
+func (c *ClientWithResponses) AccountStatusHistoryWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*AccountStatusHistoryResponse, error) {
+	rsp, err := c.AccountStatusHistory(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAccountStatusHistoryResponse(rsp)
+}
+
+// ConfirmEmailChangeWithBodyWithResponse request with arbitrary body returning *ConfirmEmailChangeResponse
+func (c *ClientWithResponses) ConfirmEmailChangeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeResponse, error) {
+	rsp, err := c.ConfirmEmailChangeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmEmailChangeResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmEmailChangeWithResponse(ctx context.Context, body ConfirmEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeResponse, error) {
+	rsp, err := c.ConfirmEmailChange(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmEmailChangeResponse(rsp)
+}
+
+// VerifyEmailWithBodyWithResponse request with arbitrary body returning *VerifyEmailResponse
+func (c *ClientWithResponses) VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
+	rsp, err := c.VerifyEmailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailResponse(rsp)
+}
+
+func (c *ClientWithResponses) VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
+	rsp, err := c.VerifyEmail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailResponse(rsp)
+}
+
+// RequestPasswordResetWithBodyWithResponse request with arbitrary body returning *RequestPasswordResetResponse
+func (c *ClientWithResponses) RequestPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error) {
+	rsp, err := c.RequestPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) RequestPasswordResetWithResponse(ctx context.Context, body RequestPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestPasswordResetResponse, error) {
+	rsp, err := c.RequestPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestPasswordResetResponse(rsp)
+}
+
+// ResetPasswordWithBodyWithResponse request with arbitrary body returning *ResetPasswordResponse
+func (c *ClientWithResponses) ResetPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetPasswordResponse, error) {
+	rsp, err := c.ResetPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResetPasswordWithResponse(ctx context.Context, body ResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetPasswordResponse, error) {
+	rsp, err := c.ResetPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetPasswordResponse(rsp)
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.Login(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+// LoginTwoFactorWithBodyWithResponse request with arbitrary body returning *LoginTwoFactorResponse
+func (c *ClientWithResponses) LoginTwoFactorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginTwoFactorResponse, error) {
+	rsp, err := c.LoginTwoFactorWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginTwoFactorResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginTwoFactorWithResponse(ctx context.Context, body LoginTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginTwoFactorResponse, error) {
+	rsp, err := c.LoginTwoFactor(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginTwoFactorResponse(rsp)
+}
+
+// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+// DeleteUserWithBodyWithResponse request with arbitrary body returning *DeleteUserResponse
+func (c *ClientWithResponses) DeleteUserWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
+	rsp, err := c.DeleteUserWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteUserWithResponse(ctx context.Context, id ID, body DeleteUserJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
+	rsp, err := c.DeleteUser(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserResponse(rsp)
+}
+
+// GetUserWithResponse request returning *GetUserResponse
+func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
+	rsp, err := c.GetUser(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserResponse(rsp)
+}
+
+// UpdateUserWithBodyWithResponse request with arbitrary body returning *UpdateUserResponse
+func (c *ClientWithResponses) UpdateUserWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
+	rsp, err := c.UpdateUserWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserWithResponse(ctx context.Context, id ID, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
+	rsp, err := c.UpdateUser(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserResponse(rsp)
+}
+
+// RequestEmailChangeWithBodyWithResponse request with arbitrary body returning *RequestEmailChangeResponse
+func (c *ClientWithResponses) RequestEmailChangeWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestEmailChangeResponse, error) {
+	rsp, err := c.RequestEmailChangeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestEmailChangeResponse(rsp)
+}
+
+func (c *ClientWithResponses) RequestEmailChangeWithResponse(ctx context.Context, id ID, body RequestEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestEmailChangeResponse, error) {
+	rsp, err := c.RequestEmailChange(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestEmailChangeResponse(rsp)
+}
+
+// ExportDataWithResponse request returning *ExportDataResponse
+func (c *ClientWithResponses) ExportDataWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*ExportDataResponse, error) {
+	rsp, err := c.ExportData(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportDataResponse(rsp)
+}
+
+// SubmitLicenceWithBodyWithResponse request with arbitrary body returning *SubmitLicenceResponse
+func (c *ClientWithResponses) SubmitLicenceWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitLicenceResponse, error) {
+	rsp, err := c.SubmitLicenceWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitLicenceResponse(rsp)
+}
+
+// GetLatestLicenceWithResponse request returning *GetLatestLicenceResponse
+func (c *ClientWithResponses) GetLatestLicenceWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetLatestLicenceResponse, error) {
+	rsp, err := c.GetLatestLicence(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLatestLicenceResponse(rsp)
+}
+
+// GetMembershipWithResponse request returning *GetMembershipResponse
+func (c *ClientWithResponses) GetMembershipWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetMembershipResponse, error) {
+	rsp, err := c.GetMembership(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMembershipResponse(rsp)
+}
+
+// UpdateMembershipWithBodyWithResponse request with arbitrary body returning *UpdateMembershipResponse
+func (c *ClientWithResponses) UpdateMembershipWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMembershipResponse, error) {
+	rsp, err := c.UpdateMembershipWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMembershipResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMembershipWithResponse(ctx context.Context, id ID, body UpdateMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMembershipResponse, error) {
+	rsp, err := c.UpdateMembership(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMembershipResponse(rsp)
+}
+
+// ChangePasswordWithBodyWithResponse request with arbitrary body returning *ChangePasswordResponse
+func (c *ClientWithResponses) ChangePasswordWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error) {
+	rsp, err := c.ChangePasswordWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) ChangePasswordWithResponse(ctx context.Context, id ID, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error) {
+	rsp, err := c.ChangePassword(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResponse(rsp)
+}
+
+// ListRentalsWithResponse request returning *ListRentalsResponse
+func (c *ClientWithResponses) ListRentalsWithResponse(ctx context.Context, id ID, params *ListRentalsParams, reqEditors ...RequestEditorFn) (*ListRentalsResponse, error) {
+	rsp, err := c.ListRentals(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRentalsResponse(rsp)
+}
+
+// DisableTwoFactorWithBodyWithResponse request with arbitrary body returning *DisableTwoFactorResponse
+func (c *ClientWithResponses) DisableTwoFactorWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTwoFactorResponse, error) {
+	rsp, err := c.DisableTwoFactorWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTwoFactorResponse(rsp)
+}
+
+func (c *ClientWithResponses) DisableTwoFactorWithResponse(ctx context.Context, id ID, body DisableTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTwoFactorResponse, error) {
+	rsp, err := c.DisableTwoFactor(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTwoFactorResponse(rsp)
+}
+
+// EnrollTwoFactorWithBodyWithResponse request with arbitrary body returning *EnrollTwoFactorResponse
+func (c *ClientWithResponses) EnrollTwoFactorWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnrollTwoFactorResponse, error) {
+	rsp, err := c.EnrollTwoFactorWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollTwoFactorResponse(rsp)
+}
+
+func (c *ClientWithResponses) EnrollTwoFactorWithResponse(ctx context.Context, id ID, body EnrollTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*EnrollTwoFactorResponse, error) {
+	rsp, err := c.EnrollTwoFactor(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollTwoFactorResponse(rsp)
+}
+
+// ConfirmTwoFactorWithBodyWithResponse request with arbitrary body returning *ConfirmTwoFactorResponse
+func (c *ClientWithResponses) ConfirmTwoFactorWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorResponse, error) {
+	rsp, err := c.ConfirmTwoFactorWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTwoFactorResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmTwoFactorWithResponse(ctx context.Context, id ID, body ConfirmTwoFactorJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTwoFactorResponse, error) {
+	rsp, err := c.ConfirmTwoFactor(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTwoFactorResponse(rsp)
+}
+
+// RegenerateRecoveryCodesWithBodyWithResponse request with arbitrary body returning *RegenerateRecoveryCodesResponse
+func (c *ClientWithResponses) RegenerateRecoveryCodesWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error) {
+	rsp, err := c.RegenerateRecoveryCodesWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegenerateRecoveryCodesWithResponse(ctx context.Context, id ID, body RegenerateRecoveryCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateRecoveryCodesResponse, error) {
+	rsp, err := c.RegenerateRecoveryCodes(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateRecoveryCodesResponse(rsp)
+}
+
+// ResendVerificationWithBodyWithResponse request with arbitrary body returning *ResendVerificationResponse
+func (c *ClientWithResponses) ResendVerificationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResendVerificationResponse, error) {
+	rsp, err := c.ResendVerificationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResendVerificationResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResendVerificationWithResponse(ctx context.Context, body ResendVerificationJSONRequestBody, reqEditors ...RequestEditorFn) (*ResendVerificationResponse, error) {
+	rsp, err := c.ResendVerification(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResendVerificationResponse(rsp)
+}
+
+// RequestEmailChangeV1WithBodyWithResponse request with arbitrary body returning *RequestEmailChangeV1Response
+func (c *ClientWithResponses) RequestEmailChangeV1WithBodyWithResponse(ctx context.Context, params *RequestEmailChangeV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestEmailChangeV1Response, error) {
+	rsp, err := c.RequestEmailChangeV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestEmailChangeV1Response(rsp)
+}
+
+func (c *ClientWithResponses) RequestEmailChangeV1WithResponse(ctx context.Context, params *RequestEmailChangeV1Params, body RequestEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RequestEmailChangeV1Response, error) {
+	rsp, err := c.RequestEmailChangeV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestEmailChangeV1Response(rsp)
+}
+
+// ConfirmEmailChangeLinkWithResponse request returning *ConfirmEmailChangeLinkResponse
+func (c *ClientWithResponses) ConfirmEmailChangeLinkWithResponse(ctx context.Context, params *ConfirmEmailChangeLinkParams, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeLinkResponse, error) {
+	rsp, err := c.ConfirmEmailChangeLink(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmEmailChangeLinkResponse(rsp)
+}
+
+// ConfirmEmailChangeV1WithBodyWithResponse request with arbitrary body returning *ConfirmEmailChangeV1Response
+func (c *ClientWithResponses) ConfirmEmailChangeV1WithBodyWithResponse(ctx context.Context, params *ConfirmEmailChangeV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeV1Response, error) {
+	rsp, err := c.ConfirmEmailChangeV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmEmailChangeV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmEmailChangeV1WithResponse(ctx context.Context, params *ConfirmEmailChangeV1Params, body ConfirmEmailChangeV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeV1Response, error) {
+	rsp, err := c.ConfirmEmailChangeV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmEmailChangeV1Response(rsp)
+}
+
+// CreateUserV1WithBodyWithResponse request with arbitrary body returning *CreateUserV1Response
+func (c *ClientWithResponses) CreateUserV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserV1Response, error) {
+	rsp, err := c.CreateUserV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserV1WithResponse(ctx context.Context, body CreateUserV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserV1Response, error) {
+	rsp, err := c.CreateUserV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserV1Response(rsp)
+}
+
+// DeleteAccountV1WithBodyWithResponse request with arbitrary body returning *DeleteAccountV1Response
+func (c *ClientWithResponses) DeleteAccountV1WithBodyWithResponse(ctx context.Context, params *DeleteAccountV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAccountV1Response, error) {
+	rsp, err := c.DeleteAccountV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountV1Response(rsp)
+}
+
+func (c *ClientWithResponses) DeleteAccountV1WithResponse(ctx context.Context, params *DeleteAccountV1Params, body DeleteAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAccountV1Response, error) {
+	rsp, err := c.DeleteAccountV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountV1Response(rsp)
+}
+
+// ExportDataV1WithResponse request returning *ExportDataV1Response
+func (c *ClientWithResponses) ExportDataV1WithResponse(ctx context.Context, params *ExportDataV1Params, reqEditors ...RequestEditorFn) (*ExportDataV1Response, error) {
+	rsp, err := c.ExportDataV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportDataV1Response(rsp)
+}
+
+// ViewLicenceV1WithResponse request returning *ViewLicenceV1Response
+func (c *ClientWithResponses) ViewLicenceV1WithResponse(ctx context.Context, params *ViewLicenceV1Params, reqEditors ...RequestEditorFn) (*ViewLicenceV1Response, error) {
+	rsp, err := c.ViewLicenceV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseViewLicenceV1Response(rsp)
+}
+
+// SubmitLicenceV1WithBodyWithResponse request with arbitrary body returning *SubmitLicenceV1Response
+func (c *ClientWithResponses) SubmitLicenceV1WithBodyWithResponse(ctx context.Context, params *SubmitLicenceV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitLicenceV1Response, error) {
+	rsp, err := c.SubmitLicenceV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitLicenceV1Response(rsp)
+}
+
+// LoginV1WithBodyWithResponse request with arbitrary body returning *LoginV1Response
+func (c *ClientWithResponses) LoginV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginV1Response, error) {
+	rsp, err := c.LoginV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginV1Response(rsp)
+}
+
+func (c *ClientWithResponses) LoginV1WithResponse(ctx context.Context, body LoginV1JSONRequestBody, reqEditors ...RequestEditorFn) (*LoginV1Response, error) {
+	rsp, err := c.LoginV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginV1Response(rsp)
+}
+
+// LoginTwoFactorV1WithBodyWithResponse request with arbitrary body returning *LoginTwoFactorV1Response
+func (c *ClientWithResponses) LoginTwoFactorV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginTwoFactorV1Response, error) {
+	rsp, err := c.LoginTwoFactorV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginTwoFactorV1Response(rsp)
+}
+
+func (c *ClientWithResponses) LoginTwoFactorV1WithResponse(ctx context.Context, body LoginTwoFactorV1JSONRequestBody, reqEditors ...RequestEditorFn) (*LoginTwoFactorV1Response, error) {
+	rsp, err := c.LoginTwoFactorV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginTwoFactorV1Response(rsp)
+}
+
+// RequestPasswordResetV1WithBodyWithResponse request with arbitrary body returning *RequestPasswordResetV1Response
+func (c *ClientWithResponses) RequestPasswordResetV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestPasswordResetV1Response, error) {
+	rsp, err := c.RequestPasswordResetV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestPasswordResetV1Response(rsp)
+}
+
+func (c *ClientWithResponses) RequestPasswordResetV1WithResponse(ctx context.Context, body RequestPasswordResetV1JSONRequestBody, reqEditors ...RequestEditorFn) (*RequestPasswordResetV1Response, error) {
+	rsp, err := c.RequestPasswordResetV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestPasswordResetV1Response(rsp)
+}
+
+// ResendVerificationV1WithBodyWithResponse request with arbitrary body returning *ResendVerificationV1Response
+func (c *ClientWithResponses) ResendVerificationV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResendVerificationV1Response, error) {
+	rsp, err := c.ResendVerificationV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResendVerificationV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ResendVerificationV1WithResponse(ctx context.Context, body ResendVerificationV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ResendVerificationV1Response, error) {
+	rsp, err := c.ResendVerificationV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResendVerificationV1Response(rsp)
+}
+
+// ResetPasswordV1WithBodyWithResponse request with arbitrary body returning *ResetPasswordV1Response
+func (c *ClientWithResponses) ResetPasswordV1WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetPasswordV1Response, error) {
+	rsp, err := c.ResetPasswordV1WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetPasswordV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ResetPasswordV1WithResponse(ctx context.Context, body ResetPasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ResetPasswordV1Response, error) {
+	rsp, err := c.ResetPasswordV1(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetPasswordV1Response(rsp)
+}
+
+// UnlockAccountLinkWithResponse request returning *UnlockAccountLinkResponse
+func (c *ClientWithResponses) UnlockAccountLinkWithResponse(ctx context.Context, params *UnlockAccountLinkParams, reqEditors ...RequestEditorFn) (*UnlockAccountLinkResponse, error) {
+	rsp, err := c.UnlockAccountLink(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlockAccountLinkResponse(rsp)
+}
+
+// UnlockAccountV1WithBodyWithResponse request with arbitrary body returning *UnlockAccountV1Response
+func (c *ClientWithResponses) UnlockAccountV1WithBodyWithResponse(ctx context.Context, params *UnlockAccountV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnlockAccountV1Response, error) {
+	rsp, err := c.UnlockAccountV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlockAccountV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UnlockAccountV1WithResponse(ctx context.Context, params *UnlockAccountV1Params, body UnlockAccountV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UnlockAccountV1Response, error) {
+	rsp, err := c.UnlockAccountV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlockAccountV1Response(rsp)
+}
+
+// UpdateDetailsV1WithBodyWithResponse request with arbitrary body returning *UpdateDetailsV1Response
+func (c *ClientWithResponses) UpdateDetailsV1WithBodyWithResponse(ctx context.Context, params *UpdateDetailsV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDetailsV1Response, error) {
+	rsp, err := c.UpdateDetailsV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDetailsV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDetailsV1WithResponse(ctx context.Context, params *UpdateDetailsV1Params, body UpdateDetailsV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDetailsV1Response, error) {
+	rsp, err := c.UpdateDetailsV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDetailsV1Response(rsp)
+}
+
+// UpdateDetailsPostV1WithBodyWithResponse request with arbitrary body returning *UpdateDetailsPostV1Response
+func (c *ClientWithResponses) UpdateDetailsPostV1WithBodyWithResponse(ctx context.Context, params *UpdateDetailsPostV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDetailsPostV1Response, error) {
+	rsp, err := c.UpdateDetailsPostV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDetailsPostV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDetailsPostV1WithResponse(ctx context.Context, params *UpdateDetailsPostV1Params, body UpdateDetailsPostV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDetailsPostV1Response, error) {
+	rsp, err := c.UpdateDetailsPostV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDetailsPostV1Response(rsp)
+}
+
+// UpdateMembershipV1WithBodyWithResponse request with arbitrary body returning *UpdateMembershipV1Response
+func (c *ClientWithResponses) UpdateMembershipV1WithBodyWithResponse(ctx context.Context, params *UpdateMembershipV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMembershipV1Response, error) {
+	rsp, err := c.UpdateMembershipV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMembershipV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMembershipV1WithResponse(ctx context.Context, params *UpdateMembershipV1Params, body UpdateMembershipV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMembershipV1Response, error) {
+	rsp, err := c.UpdateMembershipV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMembershipV1Response(rsp)
+}
+
+// UpdatePasswordV1WithBodyWithResponse request with arbitrary body returning *UpdatePasswordV1Response
+func (c *ClientWithResponses) UpdatePasswordV1WithBodyWithResponse(ctx context.Context, params *UpdatePasswordV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePasswordV1Response, error) {
+	rsp, err := c.UpdatePasswordV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePasswordV1Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePasswordV1WithResponse(ctx context.Context, params *UpdatePasswordV1Params, body UpdatePasswordV1JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePasswordV1Response, error) {
+	rsp, err := c.UpdatePasswordV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePasswordV1Response(rsp)
+}
+
+// VerifyEmailLinkWithResponse request returning *VerifyEmailLinkResponse
+func (c *ClientWithResponses) VerifyEmailLinkWithResponse(ctx context.Context, params *VerifyEmailLinkParams, reqEditors ...RequestEditorFn) (*VerifyEmailLinkResponse, error) {
+	rsp, err := c.VerifyEmailLink(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailLinkResponse(rsp)
+}
+
+// VerifyEmailV1WithBodyWithResponse request with arbitrary body returning *VerifyEmailV1Response
+func (c *ClientWithResponses) VerifyEmailV1WithBodyWithResponse(ctx context.Context, params *VerifyEmailV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailV1Response, error) {
+	rsp, err := c.VerifyEmailV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailV1Response(rsp)
+}
+
+func (c *ClientWithResponses) VerifyEmailV1WithResponse(ctx context.Context, params *VerifyEmailV1Params, body VerifyEmailV1JSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailV1Response, error) {
+	rsp, err := c.VerifyEmailV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailV1Response(rsp)
+}
+
+// ViewDetailsV1WithResponse request returning *ViewDetailsV1Response
+func (c *ClientWithResponses) ViewDetailsV1WithResponse(ctx context.Context, params *ViewDetailsV1Params, reqEditors ...RequestEditorFn) (*ViewDetailsV1Response, error) {
+	rsp, err := c.ViewDetailsV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseViewDetailsV1Response(rsp)
+}
+
+// ViewMembershipV1WithResponse request returning *ViewMembershipV1Response
+func (c *ClientWithResponses) ViewMembershipV1WithResponse(ctx context.Context, params *ViewMembershipV1Params, reqEditors ...RequestEditorFn) (*ViewMembershipV1Response, error) {
+	rsp, err := c.ViewMembershipV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseViewMembershipV1Response(rsp)
+}
+
+// ViewRentalsV1WithResponse request returning *ViewRentalsV1Response
+func (c *ClientWithResponses) ViewRentalsV1WithResponse(ctx context.Context, params *ViewRentalsV1Params, reqEditors ...RequestEditorFn) (*ViewRentalsV1Response, error) {
+	rsp, err := c.ViewRentalsV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseViewRentalsV1Response(rsp)
+}
+
+// ParseConfirmTwoFactorV1Response parses an HTTP response from a ConfirmTwoFactorV1WithResponse call
+func ParseConfirmTwoFactorV1Response(rsp *http.Response) (*ConfirmTwoFactorV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmTwoFactorV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisableTwoFactorV1Response parses an HTTP response from a DisableTwoFactorV1WithResponse call
+func ParseDisableTwoFactorV1Response(rsp *http.Response) (*DisableTwoFactorV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisableTwoFactorV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEnrollTwoFactorV1Response parses an HTTP response from a EnrollTwoFactorV1WithResponse call
+func ParseEnrollTwoFactorV1Response(rsp *http.Response) (*EnrollTwoFactorV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnrollTwoFactorV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TwoFactorEnrolment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegenerateRecoveryCodesV1Response parses an HTTP response from a RegenerateRecoveryCodesV1WithResponse call
+func ParseRegenerateRecoveryCodesV1Response(rsp *http.Response) (*RegenerateRecoveryCodesV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegenerateRecoveryCodesV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLicencesV1Response parses an HTTP response from a ListLicencesV1WithResponse call
+func ParseListLicencesV1Response(rsp *http.Response) (*ListLicencesV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLicencesV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Licence
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLicenceImageV1Response parses an HTTP response from a LicenceImageV1WithResponse call
+func ParseLicenceImageV1Response(rsp *http.Response) (*LicenceImageV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LicenceImageV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReviewLicenceV1Response parses an HTTP response from a ReviewLicenceV1WithResponse call
+func ParseReviewLicenceV1Response(rsp *http.Response) (*ReviewLicenceV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReviewLicenceV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LicenceDecision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReportAccountEventV1Response parses an HTTP response from a ReportAccountEventV1WithResponse call
+func ParseReportAccountEventV1Response(rsp *http.Response) (*ReportAccountEventV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReportAccountEventV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AccountEventRecorded
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetAccountStatusV1Response parses an HTTP response from a SetAccountStatusV1WithResponse call
+func ParseSetAccountStatusV1Response(rsp *http.Response) (*SetAccountStatusV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetAccountStatusV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusChanged
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAccountStatusHistoryV1Response parses an HTTP response from a AccountStatusHistoryV1WithResponse call
+func ParseAccountStatusHistoryV1Response(rsp *http.Response) (*AccountStatusHistoryV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AccountStatusHistoryV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnlockAccountResponse parses an HTTP response from a UnlockAccountWithResponse call
+func ParseUnlockAccountResponse(rsp *http.Response) (*UnlockAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnlockAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLicencesResponse parses an HTTP response from a ListLicencesWithResponse call
+func ParseListLicencesResponse(rsp *http.Response) (*ListLicencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLicencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Licence
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLicenceImageResponse parses an HTTP response from a LicenceImageWithResponse call
+func ParseLicenceImageResponse(rsp *http.Response) (*LicenceImageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LicenceImageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReviewLicenceResponse parses an HTTP response from a ReviewLicenceWithResponse call
+func ParseReviewLicenceResponse(rsp *http.Response) (*ReviewLicenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReviewLicenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LicenceDecision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReportAccountEventResponse parses an HTTP response from a ReportAccountEventWithResponse call
+func ParseReportAccountEventResponse(rsp *http.Response) (*ReportAccountEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReportAccountEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AccountEventRecorded
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetAccountStatusResponse parses an HTTP response from a SetAccountStatusWithResponse call
+func ParseSetAccountStatusResponse(rsp *http.Response) (*SetAccountStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetAccountStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusChanged
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAccountStatusHistoryResponse parses an HTTP response from a AccountStatusHistoryWithResponse call
+func ParseAccountStatusHistoryResponse(rsp *http.Response) (*AccountStatusHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AccountStatusHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatusHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmEmailChangeResponse parses an HTTP response from a ConfirmEmailChangeWithResponse call
+func ParseConfirmEmailChangeResponse(rsp *http.Response) (*ConfirmEmailChangeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmEmailChangeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EmailChanged
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyEmailResponse parses an HTTP response from a VerifyEmailWithResponse call
+func ParseVerifyEmailResponse(rsp *http.Response) (*VerifyEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserConfirmed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestPasswordResetResponse parses an HTTP response from a RequestPasswordResetWithResponse call
+func ParseRequestPasswordResetResponse(rsp *http.Response) (*RequestPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetPasswordResponse parses an HTTP response from a ResetPasswordWithResponse call
+func ParseResetPasswordResponse(rsp *http.Response) (*ResetPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginTwoFactorResponse parses an HTTP response from a LoginTwoFactorWithResponse call
+func ParseLoginTwoFactorResponse(rsp *http.Response) (*LoginTwoFactorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginTwoFactorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SignedIn
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
+func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUserResponse parses an HTTP response from a DeleteUserWithResponse call
+func ParseDeleteUserResponse(rsp *http.Response) (*DeleteUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserResponse parses an HTTP response from a GetUserWithResponse call
+func ParseGetUserResponse(rsp *http.Response) (*GetUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Details
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateUserResponse parses an HTTP response from a UpdateUserWithResponse call
+func ParseUpdateUserResponse(rsp *http.Response) (*UpdateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestEmailChangeResponse parses an HTTP response from a RequestEmailChangeWithResponse call
+func ParseRequestEmailChangeResponse(rsp *http.Response) (*RequestEmailChangeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestEmailChangeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest PendingEmailChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportDataResponse parses an HTTP response from a ExportDataWithResponse call
+func ParseExportDataResponse(rsp *http.Response) (*ExportDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitLicenceResponse parses an HTTP response from a SubmitLicenceWithResponse call
+func ParseSubmitLicenceResponse(rsp *http.Response) (*SubmitLicenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitLicenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest LicenceDecision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLatestLicenceResponse parses an HTTP response from a GetLatestLicenceWithResponse call
+func ParseGetLatestLicenceResponse(rsp *http.Response) (*GetLatestLicenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLatestLicenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Licence
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMembershipResponse parses an HTTP response from a GetMembershipWithResponse call
+func ParseGetMembershipResponse(rsp *http.Response) (*GetMembershipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMembershipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserMembership
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateMembershipResponse parses an HTTP response from a UpdateMembershipWithResponse call
+func ParseUpdateMembershipResponse(rsp *http.Response) (*UpdateMembershipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateMembershipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseChangePasswordResponse parses an HTTP response from a ChangePasswordWithResponse call
+func ParseChangePasswordResponse(rsp *http.Response) (*ChangePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ChangePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRentalsResponse parses an HTTP response from a ListRentalsWithResponse call
+func ParseListRentalsResponse(rsp *http.Response) (*ListRentalsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRentalsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RentalPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisableTwoFactorResponse parses an HTTP response from a DisableTwoFactorWithResponse call
+func ParseDisableTwoFactorResponse(rsp *http.Response) (*DisableTwoFactorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisableTwoFactorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEnrollTwoFactorResponse parses an HTTP response from a EnrollTwoFactorWithResponse call
+func ParseEnrollTwoFactorResponse(rsp *http.Response) (*EnrollTwoFactorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnrollTwoFactorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TwoFactorEnrolment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmTwoFactorResponse parses an HTTP response from a ConfirmTwoFactorWithResponse call
+func ParseConfirmTwoFactorResponse(rsp *http.Response) (*ConfirmTwoFactorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmTwoFactorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegenerateRecoveryCodesResponse parses an HTTP response from a RegenerateRecoveryCodesWithResponse call
+func ParseRegenerateRecoveryCodesResponse(rsp *http.Response) (*RegenerateRecoveryCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegenerateRecoveryCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResendVerificationResponse parses an HTTP response from a ResendVerificationWithResponse call
+func ParseResendVerificationResponse(rsp *http.Response) (*ResendVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResendVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestEmailChangeV1Response parses an HTTP response from a RequestEmailChangeV1WithResponse call
+func ParseRequestEmailChangeV1Response(rsp *http.Response) (*RequestEmailChangeV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestEmailChangeV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest PendingEmailChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmEmailChangeLinkResponse parses an HTTP response from a ConfirmEmailChangeLinkWithResponse call
+func ParseConfirmEmailChangeLinkResponse(rsp *http.Response) (*ConfirmEmailChangeLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmEmailChangeLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EmailChanged
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmEmailChangeV1Response parses an HTTP response from a ConfirmEmailChangeV1WithResponse call
+func ParseConfirmEmailChangeV1Response(rsp *http.Response) (*ConfirmEmailChangeV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmEmailChangeV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EmailChanged
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserV1Response parses an HTTP response from a CreateUserV1WithResponse call
+func ParseCreateUserV1Response(rsp *http.Response) (*CreateUserV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAccountV1Response parses an HTTP response from a DeleteAccountV1WithResponse call
+func ParseDeleteAccountV1Response(rsp *http.Response) (*DeleteAccountV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAccountV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportDataV1Response parses an HTTP response from a ExportDataV1WithResponse call
+func ParseExportDataV1Response(rsp *http.Response) (*ExportDataV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportDataV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseViewLicenceV1Response parses an HTTP response from a ViewLicenceV1WithResponse call
+func ParseViewLicenceV1Response(rsp *http.Response) (*ViewLicenceV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ViewLicenceV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Licence
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitLicenceV1Response parses an HTTP response from a SubmitLicenceV1WithResponse call
+func ParseSubmitLicenceV1Response(rsp *http.Response) (*SubmitLicenceV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitLicenceV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest LicenceDecision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginV1Response parses an HTTP response from a LoginV1WithResponse call
+func ParseLoginV1Response(rsp *http.Response) (*LoginV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginTwoFactorV1Response parses an HTTP response from a LoginTwoFactorV1WithResponse call
+func ParseLoginTwoFactorV1Response(rsp *http.Response) (*LoginTwoFactorV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginTwoFactorV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SignedIn
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestPasswordResetV1Response parses an HTTP response from a RequestPasswordResetV1WithResponse call
+func ParseRequestPasswordResetV1Response(rsp *http.Response) (*RequestPasswordResetV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestPasswordResetV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResendVerificationV1Response parses an HTTP response from a ResendVerificationV1WithResponse call
+func ParseResendVerificationV1Response(rsp *http.Response) (*ResendVerificationV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResendVerificationV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetPasswordV1Response parses an HTTP response from a ResetPasswordV1WithResponse call
+func ParseResetPasswordV1Response(rsp *http.Response) (*ResetPasswordV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetPasswordV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnlockAccountLinkResponse parses an HTTP response from a UnlockAccountLinkWithResponse call
+func ParseUnlockAccountLinkResponse(rsp *http.Response) (*UnlockAccountLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnlockAccountLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnlockAccountV1Response parses an HTTP response from a UnlockAccountV1WithResponse call
+func ParseUnlockAccountV1Response(rsp *http.Response) (*UnlockAccountV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnlockAccountV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDetailsV1Response parses an HTTP response from a UpdateDetailsV1WithResponse call
+func ParseUpdateDetailsV1Response(rsp *http.Response) (*UpdateDetailsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDetailsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDetailsPostV1Response parses an HTTP response from a UpdateDetailsPostV1WithResponse call
+func ParseUpdateDetailsPostV1Response(rsp *http.Response) (*UpdateDetailsPostV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDetailsPostV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateMembershipV1Response parses an HTTP response from a UpdateMembershipV1WithResponse call
+func ParseUpdateMembershipV1Response(rsp *http.Response) (*UpdateMembershipV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateMembershipV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePasswordV1Response parses an HTTP response from a UpdatePasswordV1WithResponse call
+func ParseUpdatePasswordV1Response(rsp *http.Response) (*UpdatePasswordV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePasswordV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyEmailLinkResponse parses an HTTP response from a VerifyEmailLinkWithResponse call
+func ParseVerifyEmailLinkResponse(rsp *http.Response) (*VerifyEmailLinkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyEmailLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserConfirmed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyEmailV1Response parses an HTTP response from a VerifyEmailV1WithResponse call
+func ParseVerifyEmailV1Response(rsp *http.Response) (*VerifyEmailV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyEmailV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserConfirmed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseViewDetailsV1Response parses an HTTP response from a ViewDetailsV1WithResponse call
+func ParseViewDetailsV1Response(rsp *http.Response) (*ViewDetailsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ViewDetailsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Details
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseViewMembershipV1Response parses an HTTP response from a ViewMembershipV1WithResponse call
+func ParseViewMembershipV1Response(rsp *http.Response) (*ViewMembershipV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ViewMembershipV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserMembership
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseViewRentalsV1Response parses an HTTP response from a ViewRentalsV1WithResponse call
+func ParseViewRentalsV1Response(rsp *http.Response) (*ViewRentalsV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ViewRentalsV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RentalList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
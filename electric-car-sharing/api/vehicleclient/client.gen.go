@@ -0,0 +1,1997 @@
+// Package vehicleclient provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package vehicleclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for RentalStatus.
+const (
+	Active    RentalStatus = "active"
+	Cancelled RentalStatus = "cancelled"
+	Completed RentalStatus = "completed"
+)
+
+// Defines values for ListAvailableVehiclesParamsSort.
+const (
+	CostPerHour      ListAvailableVehiclesParamsSort = "cost_per_hour"
+	Id               ListAvailableVehiclesParamsSort = "id"
+	Make             ListAvailableVehiclesParamsSort = "make"
+	MinusCostPerHour ListAvailableVehiclesParamsSort = "-cost_per_hour"
+	MinusId          ListAvailableVehiclesParamsSort = "-id"
+	MinusMake        ListAvailableVehiclesParamsSort = "-make"
+	MinusYear        ListAvailableVehiclesParamsSort = "-year"
+	Year             ListAvailableVehiclesParamsSort = "year"
+)
+
+// CompletedRental defines model for CompletedRental.
+type CompletedRental struct {
+	Invoice   Invoice `json:"invoice"`
+	Message   string  `json:"message"`
+	RentalId  int     `json:"rental_id"`
+	VehicleId int     `json:"vehicle_id"`
+}
+
+// CreateRentalRequest defines model for CreateRentalRequest.
+type CreateRentalRequest struct {
+	Hours     int `json:"hours"`
+	VehicleId int `json:"vehicle_id"`
+}
+
+// CreatedRental defines model for CreatedRental.
+type CreatedRental struct {
+	EndDate       time.Time `json:"end_date"`
+	Id            int       `json:"id"`
+	Message       string    `json:"message"`
+	OvertimeHours int       `json:"overtime_hours"`
+	StartDate     time.Time `json:"start_date"`
+	Status        string    `json:"status"`
+	UserId        int       `json:"user_id"`
+	VehicleId     int       `json:"vehicle_id"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	// Code Machine-readable reason, such as not_found or rental_in_progress
+	Code string `json:"code"`
+
+	// Fields Why each rejected field was rejected
+	Fields    *map[string]string `json:"fields,omitempty"`
+	Message   string             `json:"message"`
+	RequestId *string            `json:"request_id,omitempty"`
+}
+
+// ErrorEnvelope defines model for ErrorEnvelope.
+type ErrorEnvelope struct {
+	Error Error `json:"error"`
+}
+
+// ExtendRentalRequest defines model for ExtendRentalRequest.
+type ExtendRentalRequest struct {
+	Hours int `json:"hours"`
+}
+
+// ExtendedRental defines model for ExtendedRental.
+type ExtendedRental struct {
+	Message    string    `json:"message"`
+	NewEndDate time.Time `json:"new_end_date"`
+	RentalId   int       `json:"rental_id"`
+	VehicleId  int       `json:"vehicle_id"`
+}
+
+// Invoice defines model for Invoice.
+type Invoice struct {
+	CreatedAt    time.Time `json:"created_at"`
+	FinalCost    float64   `json:"final_cost"`
+	Hours        int       `json:"hours"`
+	HoursOverdue int       `json:"hours_overdue"`
+	Id           int       `json:"id"`
+	PaidStatus   bool      `json:"paid_status"`
+	RentalId     int       `json:"rental_id"`
+	UserId       int       `json:"user_id"`
+}
+
+// NewRental defines model for NewRental.
+type NewRental struct {
+	Hours     int `json:"hours"`
+	UserId    int `json:"user_id"`
+	VehicleId int `json:"vehicle_id"`
+}
+
+// Page defines model for Page.
+type Page struct {
+	Limit int `json:"limit"`
+
+	// NextCursor Fetches the next page; left out on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+	Sort       string  `json:"sort"`
+}
+
+// Rental defines model for Rental.
+type Rental struct {
+	EndDate       time.Time    `json:"end_date"`
+	Id            int          `json:"id"`
+	OvertimeHours int          `json:"overtime_hours"`
+	StartDate     time.Time    `json:"start_date"`
+	Status        RentalStatus `json:"status"`
+	UserId        int          `json:"user_id"`
+	VehicleId     int          `json:"vehicle_id"`
+}
+
+// RentalStatus defines model for Rental.Status.
+type RentalStatus string
+
+// RentalChange defines model for RentalChange.
+type RentalChange struct {
+	Message   string `json:"message"`
+	RentalId  int    `json:"rental_id"`
+	VehicleId int    `json:"vehicle_id"`
+}
+
+// Vehicle defines model for Vehicle.
+type Vehicle struct {
+	Available   bool    `json:"available"`
+	CostPerHour float64 `json:"cost_per_hour"`
+	Id          int     `json:"id"`
+	Make        string  `json:"make"`
+	Model       string  `json:"model"`
+	VipAccess   bool    `json:"vip_access"`
+	Year        int     `json:"year"`
+}
+
+// VehiclePage defines model for VehiclePage.
+type VehiclePage struct {
+	Data []Vehicle `json:"data"`
+	Page Page      `json:"page"`
+}
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// Limit defines model for Limit.
+type Limit = int
+
+// RentalID defines model for RentalID.
+type RentalID = int
+
+// UserID defines model for UserID.
+type UserID = int
+
+// Failed defines model for Failed.
+type Failed = ErrorEnvelope
+
+// ListAvailableVehiclesParams defines parameters for ListAvailableVehicles.
+type ListAvailableVehiclesParams struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+
+	// Limit The most items to return, 20 if left out
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor The `next_cursor` of the previous page, to fetch the one after it
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Field to sort by, `id` if left out; prefix it with `-` for descending order
+	Sort *ListAvailableVehiclesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Make Only list vehicles of this make, ignoring case
+	Make    *string `form:"make,omitempty" json:"make,omitempty"`
+	MinYear *int    `form:"min_year,omitempty" json:"min_year,omitempty"`
+	MaxYear *int    `form:"max_year,omitempty" json:"max_year,omitempty"`
+
+	// MinCost Lowest hourly cost to list
+	MinCost *float64 `form:"min_cost,omitempty" json:"min_cost,omitempty"`
+
+	// MaxCost Highest hourly cost to list
+	MaxCost *float64 `form:"max_cost,omitempty" json:"max_cost,omitempty"`
+
+	// Vip Only list the VIP-only vehicles, or only the standard ones
+	Vip *bool `form:"vip,omitempty" json:"vip,omitempty"`
+}
+
+// ListAvailableVehiclesParamsSort defines parameters for ListAvailableVehicles.
+type ListAvailableVehiclesParamsSort string
+
+// ListAvailableVehiclesV1Params defines parameters for ListAvailableVehiclesV1.
+type ListAvailableVehiclesV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// CancelRentalV1Params defines parameters for CancelRentalV1.
+type CancelRentalV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// CompleteRentalV1Params defines parameters for CompleteRentalV1.
+type CompleteRentalV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// CreateRentalV1Params defines parameters for CreateRentalV1.
+type CreateRentalV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// ExtendRentalV1Params defines parameters for ExtendRentalV1.
+type ExtendRentalV1Params struct {
+	UserId UserID `form:"user_id" json:"user_id"`
+}
+
+// CreateRentalJSONRequestBody defines body for CreateRental for application/json ContentType.
+type CreateRentalJSONRequestBody = NewRental
+
+// ExtendRentalJSONRequestBody defines body for ExtendRental for application/json ContentType.
+type ExtendRentalJSONRequestBody = ExtendRentalRequest
+
+// CreateRentalV1JSONRequestBody defines body for CreateRentalV1 for application/json ContentType.
+type CreateRentalV1JSONRequestBody = CreateRentalRequest
+
+// ExtendRentalV1JSONRequestBody defines body for ExtendRentalV1 for application/json ContentType.
+type ExtendRentalV1JSONRequestBody = ExtendRentalRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// CreateRentalWithBody request with any body
+	CreateRentalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRental(ctx context.Context, body CreateRentalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRental request
+	GetRental(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelRental request
+	CancelRental(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteRental request
+	CompleteRental(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExtendRentalWithBody request with any body
+	ExtendRentalWithBody(ctx context.Context, id RentalID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExtendRental(ctx context.Context, id RentalID, body ExtendRentalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAvailableVehicles request
+	ListAvailableVehicles(ctx context.Context, params *ListAvailableVehiclesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAvailableVehiclesV1 request
+	ListAvailableVehiclesV1(ctx context.Context, params *ListAvailableVehiclesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelRentalV1 request
+	CancelRentalV1(ctx context.Context, params *CancelRentalV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteRentalV1 request
+	CompleteRentalV1(ctx context.Context, params *CompleteRentalV1Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRentalV1WithBody request with any body
+	CreateRentalV1WithBody(ctx context.Context, params *CreateRentalV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRentalV1(ctx context.Context, params *CreateRentalV1Params, body CreateRentalV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExtendRentalV1WithBody request with any body
+	ExtendRentalV1WithBody(ctx context.Context, params *ExtendRentalV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExtendRentalV1(ctx context.Context, params *ExtendRentalV1Params, body ExtendRentalV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) CreateRentalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRentalRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRental(ctx context.Context, body CreateRentalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRentalRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRental(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRentalRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelRental(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelRentalRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompleteRental(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteRentalRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExtendRentalWithBody(ctx context.Context, id RentalID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExtendRentalRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExtendRental(ctx context.Context, id RentalID, body ExtendRentalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExtendRentalRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAvailableVehicles(ctx context.Context, params *ListAvailableVehiclesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAvailableVehiclesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAvailableVehiclesV1(ctx context.Context, params *ListAvailableVehiclesV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAvailableVehiclesV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelRentalV1(ctx context.Context, params *CancelRentalV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelRentalV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompleteRentalV1(ctx context.Context, params *CompleteRentalV1Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteRentalV1Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRentalV1WithBody(ctx context.Context, params *CreateRentalV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRentalV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRentalV1(ctx context.Context, params *CreateRentalV1Params, body CreateRentalV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRentalV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExtendRentalV1WithBody(ctx context.Context, params *ExtendRentalV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExtendRentalV1RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExtendRentalV1(ctx context.Context, params *ExtendRentalV1Params, body ExtendRentalV1JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExtendRentalV1Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewCreateRentalRequest calls the generic CreateRental builder with application/json body
+func NewCreateRentalRequest(server string, body CreateRentalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRentalRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRentalRequestWithBody generates requests for CreateRental with any type of body
+func NewCreateRentalRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/rentals")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRentalRequest generates requests for GetRental
+func NewGetRentalRequest(server string, id RentalID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/rentals/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCancelRentalRequest generates requests for CancelRental
+func NewCancelRentalRequest(server string, id RentalID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/rentals/%s/cancellation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCompleteRentalRequest generates requests for CompleteRental
+func NewCompleteRentalRequest(server string, id RentalID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/rentals/%s/completion", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExtendRentalRequest calls the generic ExtendRental builder with application/json body
+func NewExtendRentalRequest(server string, id RentalID, body ExtendRentalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExtendRentalRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewExtendRentalRequestWithBody generates requests for ExtendRental with any type of body
+func NewExtendRentalRequestWithBody(server string, id RentalID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/rentals/%s/extension", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListAvailableVehiclesRequest generates requests for ListAvailableVehicles
+func NewListAvailableVehiclesRequest(server string, params *ListAvailableVehiclesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/vehicles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Make != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "make", runtime.ParamLocationQuery, *params.Make); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinYear != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "min_year", runtime.ParamLocationQuery, *params.MinYear); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxYear != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_year", runtime.ParamLocationQuery, *params.MaxYear); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinCost != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "min_cost", runtime.ParamLocationQuery, *params.MinCost); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxCost != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_cost", runtime.ParamLocationQuery, *params.MaxCost); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Vip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "vip", runtime.ParamLocationQuery, *params.Vip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAvailableVehiclesV1Request generates requests for ListAvailableVehiclesV1
+func NewListAvailableVehiclesV1Request(server string, params *ListAvailableVehiclesV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vehicles/available")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCancelRentalV1Request generates requests for CancelRentalV1
+func NewCancelRentalV1Request(server string, params *CancelRentalV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vehicles/cancel-rental")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCompleteRentalV1Request generates requests for CompleteRentalV1
+func NewCompleteRentalV1Request(server string, params *CompleteRentalV1Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vehicles/complete-rental")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRentalV1Request calls the generic CreateRentalV1 builder with application/json body
+func NewCreateRentalV1Request(server string, params *CreateRentalV1Params, body CreateRentalV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRentalV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateRentalV1RequestWithBody generates requests for CreateRentalV1 with any type of body
+func NewCreateRentalV1RequestWithBody(server string, params *CreateRentalV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vehicles/create-rental")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExtendRentalV1Request calls the generic ExtendRentalV1 builder with application/json body
+func NewExtendRentalV1Request(server string, params *ExtendRentalV1Params, body ExtendRentalV1JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExtendRentalV1RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewExtendRentalV1RequestWithBody generates requests for ExtendRentalV1 with any type of body
+func NewExtendRentalV1RequestWithBody(server string, params *ExtendRentalV1Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vehicles/extend-rental")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// CreateRentalWithBodyWithResponse request with any body
+	CreateRentalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRentalResponse, error)
+
+	CreateRentalWithResponse(ctx context.Context, body CreateRentalJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRentalResponse, error)
+
+	// GetRentalWithResponse request
+	GetRentalWithResponse(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*GetRentalResponse, error)
+
+	// CancelRentalWithResponse request
+	CancelRentalWithResponse(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*CancelRentalResponse, error)
+
+	// CompleteRentalWithResponse request
+	CompleteRentalWithResponse(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*CompleteRentalResponse, error)
+
+	// ExtendRentalWithBodyWithResponse request with any body
+	ExtendRentalWithBodyWithResponse(ctx context.Context, id RentalID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExtendRentalResponse, error)
+
+	ExtendRentalWithResponse(ctx context.Context, id RentalID, body ExtendRentalJSONRequestBody, reqEditors ...RequestEditorFn) (*ExtendRentalResponse, error)
+
+	// ListAvailableVehiclesWithResponse request
+	ListAvailableVehiclesWithResponse(ctx context.Context, params *ListAvailableVehiclesParams, reqEditors ...RequestEditorFn) (*ListAvailableVehiclesResponse, error)
+
+	// ListAvailableVehiclesV1WithResponse request
+	ListAvailableVehiclesV1WithResponse(ctx context.Context, params *ListAvailableVehiclesV1Params, reqEditors ...RequestEditorFn) (*ListAvailableVehiclesV1Response, error)
+
+	// CancelRentalV1WithResponse request
+	CancelRentalV1WithResponse(ctx context.Context, params *CancelRentalV1Params, reqEditors ...RequestEditorFn) (*CancelRentalV1Response, error)
+
+	// CompleteRentalV1WithResponse request
+	CompleteRentalV1WithResponse(ctx context.Context, params *CompleteRentalV1Params, reqEditors ...RequestEditorFn) (*CompleteRentalV1Response, error)
+
+	// CreateRentalV1WithBodyWithResponse request with any body
+	CreateRentalV1WithBodyWithResponse(ctx context.Context, params *CreateRentalV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRentalV1Response, error)
+
+	CreateRentalV1WithResponse(ctx context.Context, params *CreateRentalV1Params, body CreateRentalV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRentalV1Response, error)
+
+	// ExtendRentalV1WithBodyWithResponse request with any body
+	ExtendRentalV1WithBodyWithResponse(ctx context.Context, params *ExtendRentalV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExtendRentalV1Response, error)
+
+	ExtendRentalV1WithResponse(ctx context.Context, params *ExtendRentalV1Params, body ExtendRentalV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ExtendRentalV1Response, error)
+}
+
+type CreateRentalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedRental
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRentalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRentalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRentalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Rental
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRentalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRentalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelRentalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalChange
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelRentalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelRentalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteRentalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompletedRental
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteRentalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteRentalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExtendRentalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExtendedRental
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ExtendRentalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExtendRentalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAvailableVehiclesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VehiclePage
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAvailableVehiclesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAvailableVehiclesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAvailableVehiclesV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Vehicle
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAvailableVehiclesV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAvailableVehiclesV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelRentalV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalChange
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelRentalV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelRentalV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteRentalV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompletedRental
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteRentalV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteRentalV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRentalV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreatedRental
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRentalV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRentalV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExtendRentalV1Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExtendedRental
+	JSONDefault  *Failed
+}
+
+// Status returns HTTPResponse.Status
+func (r ExtendRentalV1Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExtendRentalV1Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CreateRentalWithBodyWithResponse request with arbitrary body returning *CreateRentalResponse
+func (c *ClientWithResponses) CreateRentalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRentalResponse, error) {
+	rsp, err := c.CreateRentalWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRentalResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRentalWithResponse(ctx context.Context, body CreateRentalJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRentalResponse, error) {
+	rsp, err := c.CreateRental(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRentalResponse(rsp)
+}
+
+// GetRentalWithResponse request returning *GetRentalResponse
+func (c *ClientWithResponses) GetRentalWithResponse(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*GetRentalResponse, error) {
+	rsp, err := c.GetRental(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRentalResponse(rsp)
+}
+
+// CancelRentalWithResponse request returning *CancelRentalResponse
+func (c *ClientWithResponses) CancelRentalWithResponse(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*CancelRentalResponse, error) {
+	rsp, err := c.CancelRental(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelRentalResponse(rsp)
+}
+
+// CompleteRentalWithResponse request returning *CompleteRentalResponse
+func (c *ClientWithResponses) CompleteRentalWithResponse(ctx context.Context, id RentalID, reqEditors ...RequestEditorFn) (*CompleteRentalResponse, error) {
+	rsp, err := c.CompleteRental(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteRentalResponse(rsp)
+}
+
+// ExtendRentalWithBodyWithResponse request with arbitrary body returning *ExtendRentalResponse
+func (c *ClientWithResponses) ExtendRentalWithBodyWithResponse(ctx context.Context, id RentalID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExtendRentalResponse, error) {
+	rsp, err := c.ExtendRentalWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExtendRentalResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExtendRentalWithResponse(ctx context.Context, id RentalID, body ExtendRentalJSONRequestBody, reqEditors ...RequestEditorFn) (*ExtendRentalResponse, error) {
+	rsp, err := c.ExtendRental(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExtendRentalResponse(rsp)
+}
+
+// ListAvailableVehiclesWithResponse request returning *ListAvailableVehiclesResponse
+func (c *ClientWithResponses) ListAvailableVehiclesWithResponse(ctx context.Context, params *ListAvailableVehiclesParams, reqEditors ...RequestEditorFn) (*ListAvailableVehiclesResponse, error) {
+	rsp, err := c.ListAvailableVehicles(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAvailableVehiclesResponse(rsp)
+}
+
+// ListAvailableVehiclesV1WithResponse request returning *ListAvailableVehiclesV1Response
+func (c *ClientWithResponses) ListAvailableVehiclesV1WithResponse(ctx context.Context, params *ListAvailableVehiclesV1Params, reqEditors ...RequestEditorFn) (*ListAvailableVehiclesV1Response, error) {
+	rsp, err := c.ListAvailableVehiclesV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAvailableVehiclesV1Response(rsp)
+}
+
+// CancelRentalV1WithResponse request returning *CancelRentalV1Response
+func (c *ClientWithResponses) CancelRentalV1WithResponse(ctx context.Context, params *CancelRentalV1Params, reqEditors ...RequestEditorFn) (*CancelRentalV1Response, error) {
+	rsp, err := c.CancelRentalV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelRentalV1Response(rsp)
+}
+
+// CompleteRentalV1WithResponse request returning *CompleteRentalV1Response
+func (c *ClientWithResponses) CompleteRentalV1WithResponse(ctx context.Context, params *CompleteRentalV1Params, reqEditors ...RequestEditorFn) (*CompleteRentalV1Response, error) {
+	rsp, err := c.CompleteRentalV1(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteRentalV1Response(rsp)
+}
+
+// CreateRentalV1WithBodyWithResponse request with arbitrary body returning *CreateRentalV1Response
+func (c *ClientWithResponses) CreateRentalV1WithBodyWithResponse(ctx context.Context, params *CreateRentalV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRentalV1Response, error) {
+	rsp, err := c.CreateRentalV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRentalV1Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateRentalV1WithResponse(ctx context.Context, params *CreateRentalV1Params, body CreateRentalV1JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRentalV1Response, error) {
+	rsp, err := c.CreateRentalV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRentalV1Response(rsp)
+}
+
+// ExtendRentalV1WithBodyWithResponse request with arbitrary body returning *ExtendRentalV1Response
+func (c *ClientWithResponses) ExtendRentalV1WithBodyWithResponse(ctx context.Context, params *ExtendRentalV1Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExtendRentalV1Response, error) {
+	rsp, err := c.ExtendRentalV1WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExtendRentalV1Response(rsp)
+}
+
+func (c *ClientWithResponses) ExtendRentalV1WithResponse(ctx context.Context, params *ExtendRentalV1Params, body ExtendRentalV1JSONRequestBody, reqEditors ...RequestEditorFn) (*ExtendRentalV1Response, error) {
+	rsp, err := c.ExtendRentalV1(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExtendRentalV1Response(rsp)
+}
+
+// ParseCreateRentalResponse parses an HTTP response from a CreateRentalWithResponse call
+func ParseCreateRentalResponse(rsp *http.Response) (*CreateRentalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRentalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedRental
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRentalResponse parses an HTTP response from a GetRentalWithResponse call
+func ParseGetRentalResponse(rsp *http.Response) (*GetRentalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRentalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Rental
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelRentalResponse parses an HTTP response from a CancelRentalWithResponse call
+func ParseCancelRentalResponse(rsp *http.Response) (*CancelRentalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelRentalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RentalChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompleteRentalResponse parses an HTTP response from a CompleteRentalWithResponse call
+func ParseCompleteRentalResponse(rsp *http.Response) (*CompleteRentalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteRentalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompletedRental
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExtendRentalResponse parses an HTTP response from a ExtendRentalWithResponse call
+func ParseExtendRentalResponse(rsp *http.Response) (*ExtendRentalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExtendRentalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExtendedRental
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAvailableVehiclesResponse parses an HTTP response from a ListAvailableVehiclesWithResponse call
+func ParseListAvailableVehiclesResponse(rsp *http.Response) (*ListAvailableVehiclesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAvailableVehiclesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VehiclePage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAvailableVehiclesV1Response parses an HTTP response from a ListAvailableVehiclesV1WithResponse call
+func ParseListAvailableVehiclesV1Response(rsp *http.Response) (*ListAvailableVehiclesV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAvailableVehiclesV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Vehicle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelRentalV1Response parses an HTTP response from a CancelRentalV1WithResponse call
+func ParseCancelRentalV1Response(rsp *http.Response) (*CancelRentalV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelRentalV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RentalChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompleteRentalV1Response parses an HTTP response from a CompleteRentalV1WithResponse call
+func ParseCompleteRentalV1Response(rsp *http.Response) (*CompleteRentalV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteRentalV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompletedRental
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRentalV1Response parses an HTTP response from a CreateRentalV1WithResponse call
+func ParseCreateRentalV1Response(rsp *http.Response) (*CreateRentalV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRentalV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreatedRental
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExtendRentalV1Response parses an HTTP response from a ExtendRentalV1WithResponse call
+func ParseExtendRentalV1Response(rsp *http.Response) (*ExtendRentalV1Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExtendRentalV1Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExtendedRental
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Failed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
@@ -3,45 +3,74 @@ package main
 import (
 	"bufio"
 	"bytes"
-	"electric-car-sharing/services/user-service/models" // Import the User model
-	"encoding/json"
+	"context"
+	"electric-car-sharing/api/billingclient"
+	"electric-car-sharing/api/userclient"
+	"electric-car-sharing/api/vehicleclient"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/config"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"log"
+	"mime/multipart"
 	"net/http"
 	"os"
-	"os/exec"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/oapi-codegen/nullable"
 )
-type Rental struct {
-	ID            int    `json:"id"`
-	VehicleID     int    `json:"vehicle_id"`
-	StartDate     string `json:"start_date"`
-	EndDate       string `json:"end_date"`
-	Status        string `json:"status"`
-	OvertimeHours int    `json:"overtime_hours"`
-	Paid          bool   `json:"paid"`
-}
-
-type RentalsResponse struct {
-	Rentals []Rental `json:"rentals"`
-}
-
-	const userServiceURL = "http://localhost:8080/create-user"
-	const loginServiceURL = "http://localhost:8080/login" // The login endpoint
-	const viewUserDetailsURL = "http://localhost:8080/view-details" // The view user details endpoint
-	const viewUserMembershipURL = "http://localhost:8080/view-membership"
-	const updateMembershipURL = "http://localhost:8080/update-membership"
-	const viewRentalsURL = "http://localhost:8080/view-rentals"
+	// cfg holds the service base URLs, loaded and validated before anything else
+	var cfg = loadConfig()
+
+	// Clients for the three services, generated from their OpenAPI documents in api/. They send
+	// through http.DefaultClient so every request carries the request ID.
+	var users = mustClient(userclient.NewClientWithResponses(cfg.Services.User.URL, userclient.WithHTTPClient(http.DefaultClient)))
+	var vehicles = mustClient(vehicleclient.NewClientWithResponses(cfg.Services.Vehicle.URL, vehicleclient.WithHTTPClient(http.DefaultClient)))
+	var billing = mustClient(billingclient.NewClientWithResponses(cfg.Services.Billing.URL, billingclient.WithHTTPClient(http.DefaultClient)))
 
 	var currentUserID int // Variable to store the current logged-in user's ID
 
+	// requestID is sent with every request made for the current menu option, so the services'
+	// logs for it can be found when a problem is reported
+	var requestID string
+
+	// requestIDTransport adds the current request ID to every request the console sends
+	type requestIDTransport struct {
+		base http.RoundTripper
+	}
+
+	func (t requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.Header.Set(apierror.RequestIDHeader, requestID)
+		return t.base.RoundTrip(req)
+	}
+
+
+	// loadConfig reads the configuration, exiting if it is invalid
+	func loadConfig() *config.Config {
+		cfg, err := config.Load(config.Console)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return cfg
+	}
+
+	// mustClient returns client, exiting if it could not be created for the configured URL
+	func mustClient[T any](client T, err error) T {
+		if err != nil {
+			log.Fatal(err)
+		}
+		return client
+	}
 
 	func main() {
+		http.DefaultClient.Transport = requestIDTransport{base: http.DefaultTransport}
 		for {
 			printMenu()
 			option := getUserInput("Enter an option: ")
+			requestID = apierror.NewRequestID()
 
 			switch option {
 			case "1":
@@ -119,6 +148,60 @@ type RentalsResponse struct {
 			} else {
 				payInvoice()
 			}
+		case "14":
+			verifyEmail()
+		case "15":
+			resendVerification()
+		case "16":
+			forgotPassword()
+		case "17":
+			resetPassword()
+		case "18":
+			unlockAccount()
+		case "19":
+			if currentUserID == 0 {
+				fmt.Println("User not Logged in")
+			} else {
+				enableTwoFactor()
+			}
+		case "20":
+			if currentUserID == 0 {
+				fmt.Println("User not Logged in")
+			} else {
+				disableTwoFactor()
+			}
+		case "21":
+			if currentUserID == 0 {
+				fmt.Println("User not Logged in")
+			} else {
+				submitLicence()
+			}
+		case "22":
+			if currentUserID == 0 {
+				fmt.Println("User not Logged in")
+			} else {
+				viewLicence()
+			}
+		case "23":
+			if currentUserID == 0 {
+				fmt.Println("User not Logged in")
+			} else {
+				exportData()
+			}
+		case "24":
+			if currentUserID == 0 {
+				fmt.Println("User not Logged in")
+			} else {
+				deleteAccount()
+			}
+		case "25":
+			if currentUserID == 0 {
+				fmt.Println("User not Logged in")
+			} else {
+				changeEmail()
+			}
+		case "26":
+			confirmEmailChange()
 		
 		
 			
@@ -138,9 +221,15 @@ type RentalsResponse struct {
 		fmt.Println("1. Create new user")
 		if currentUserID == 0 {
 			fmt.Println("2. Login") // Show Login option if not logged in
+			fmt.Println("14. Verify Email")
+			fmt.Println("15. Resend Verification Email")
+			fmt.Println("16. Forgot Password")
+			fmt.Println("17. Reset Password")
+			fmt.Println("18. Unlock Account")
 		} else {
 			fmt.Println("2. Logout") // Show Logout option if logged in
 		}
+		fmt.Println("26. Confirm Email Change")
 		if currentUserID != 0 {
 			fmt.Println("3. View User Details")
 			fmt.Println("4. Update User Details")
@@ -153,6 +242,13 @@ type RentalsResponse struct {
 			fmt.Println("11. Complete Rental")
 			fmt.Println("12. View invoices")
 			fmt.Println("13. Pay invoice")
+			fmt.Println("19. Enable Two-Factor Authentication")
+			fmt.Println("20. Disable Two-Factor Authentication")
+			fmt.Println("21. Submit Driver's Licence")
+			fmt.Println("22. View Driver's Licence Status")
+			fmt.Println("23. Export My Data")
+			fmt.Println("24. Delete My Account")
+			fmt.Println("25. Change Email")
 
 
 
@@ -179,48 +275,21 @@ type RentalsResponse struct {
 		email := getUserInput("Enter user email: ")
 		password := getUserInput("Enter user password: ")
 
-		// Reuse the User struct from the models package
-		newUser := models.User{
+		resp, err := users.CreateUserWithResponse(context.Background(), userclient.NewUser{
 			Name:     name,
 			Email:    email,
 			Password: password,
-		}
-
-		userJSON, err := json.Marshal(newUser)
-		if err != nil {
-			fmt.Println("Error creating JSON payload:", err)
-			return
-		}
-
-		req, err := http.NewRequest("POST", userServiceURL, bytes.NewBuffer(userJSON))
-		if err != nil {
-			fmt.Println("Error creating request:", err)
-			return
-		}
-
-		// Set content type explicitly
-		req.Header.Set("Content-Type", "application/json")
-
-		resp, err := http.DefaultClient.Do(req)
+		})
 		if err != nil {
 			fmt.Println("Error creating user:", err)
 			return
 		}
-		defer resp.Body.Close()
 
-		// Read response body
-		// body, err := ioutil.ReadAll(resp.Body)
-		// if err != nil {
-		//     fmt.Println("Error reading response body:", err)
-		//     return
-		// }
-
-		// fmt.Printf("Response body: %s\n", body)
-
-		if resp.StatusCode == http.StatusCreated {
+		if resp.StatusCode() == http.StatusCreated {
 			fmt.Println("User created successfully!")
+			fmt.Println("A verification link has been sent to your email. Verify your email (option 14) before logging in.")
 		} else {
-			fmt.Printf("Error creating user: %s\n", resp.Status)
+			fmt.Printf("Error creating user: %s\n", describeError(resp.StatusCode(), resp.Body))
 		}
 	}
 
@@ -229,56 +298,64 @@ type RentalsResponse struct {
 		email := getUserInput("Enter email: ")
 		password := getUserInput("Enter password: ")
 
-		// Prepare the login data
-		loginData := map[string]string{
-			"email":    email,
-			"password": password,
-		}
-
-		// Convert login data to JSON
-		loginJSON, err := json.Marshal(loginData)
-		if err != nil {
-			fmt.Println("Error creating JSON payload:", err)
-			return
-		}
-
-		// Send the login request to the correct URL
-		resp, err := http.Post(loginServiceURL, "application/json", bytes.NewBuffer(loginJSON))
+		resp, err := users.LoginWithResponse(context.Background(), userclient.Credentials{
+			Email:    email,
+			Password: password,
+		})
 		if err != nil {
 			fmt.Println("Error logging in:", err)
 			return
 		}
-		defer resp.Body.Close()
-
-		// Read the response body for debugging
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			fmt.Println("Error reading response body:", err)
-			return
-		}
 
 		// Print the response body for debugging
-		fmt.Println("Response body:", string(body))
+		fmt.Println("Response body:", string(resp.Body))
 
 		// Check the response
-		if resp.StatusCode == http.StatusOK {
-			// Use the already read response body for decoding
-			var userResponse struct {
-				UserID int `json:"user_id"`
+		if result := resp.JSON200; result != nil {
+			// Accounts with two-factor enabled need a code before the login completes
+			if result.TwoFactorRequired != nil && *result.TwoFactorRequired {
+				code := getUserInput("Enter the code from your authenticator app (or a recovery code): ")
+				challenge := ""
+				if result.ChallengeToken != nil {
+					challenge = *result.ChallengeToken
+				}
+				resp, err := users.LoginTwoFactorWithResponse(context.Background(), userclient.TwoFactorLoginRequest{
+					ChallengeToken: challenge,
+					Code:           code,
+				})
+				if err != nil {
+					fmt.Println("Error logging in:", err)
+					return
+				}
+				if resp.JSON200 == nil {
+					fmt.Printf("Two-factor verification failed: %s\n", describeError(resp.StatusCode(), resp.Body))
+					return
+				}
+				result.UserId = &resp.JSON200.UserId
 			}
-
-			// Decode the JSON from the already-read body
-			err := json.Unmarshal(body, &userResponse)
-			if err != nil {
-				fmt.Println("Error decoding response:", err)
+			if result.UserId == nil {
+				fmt.Println("Error decoding response: no user ID")
 				return
 			}
 
 			// Save the logged-in user's ID
-			currentUserID = userResponse.UserID
+			currentUserID = *result.UserId
 			fmt.Println("Login successful! User ID:", currentUserID)
 		} else {
-			fmt.Println("Invalid credentials. Please try again.")
+			// Branch on the error code rather than the wording of the message
+			apiErr := apierror.Parse(resp.StatusCode(), resp.Body)
+			switch apiErr.Code {
+			case apierror.CodeEmailNotVerified:
+				fmt.Println("Your email address has not been verified. Use option 14 to verify it or option 15 to get a new link.")
+			case apierror.CodeAccountInactive:
+				fmt.Printf("Login refused: %s\n", apiErr.Message)
+			case apierror.CodeAccountLocked:
+				fmt.Println("Your account is locked after too many failed attempts. Use the unlock code from your email (option 18) or reset your password.")
+			case apierror.CodeRateLimited:
+				fmt.Printf("Too many failed attempts. Please wait %s seconds before trying again.\n", resp.HTTPResponse.Header.Get("Retry-After"))
+			default:
+				fmt.Println("Login failed:", describeError(resp.StatusCode(), resp.Body))
+			}
 		}
 	}
 
@@ -293,70 +370,43 @@ type RentalsResponse struct {
 			return
 		}
 	
-		// Construct the URL for user details
-		url := fmt.Sprintf("%s?user_id=%d", viewUserDetailsURL, currentUserID)
-	
-		// Send the GET request to retrieve user details
-		resp, err := http.Get(url)
+		// Retrieve the user details
+		resp, err := users.GetUserWithResponse(context.Background(), currentUserID)
 		if err != nil {
 			fmt.Println("Error retrieving user details:", err)
 			return
 		}
-		defer resp.Body.Close()
-	
-		// Read the response body
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			fmt.Println("Error reading user details response body:", err)
+		userDetails := resp.JSON200
+		if userDetails == nil {
+			fmt.Printf("Error retrieving user details: %s\n", describeError(resp.StatusCode(), resp.Body))
 			return
 		}
 	
-		// Parse the JSON response for user details
-		var userDetails map[string]interface{}
-		err = json.Unmarshal(body, &userDetails)
-		if err != nil {
-			fmt.Println("Error parsing user details:", err)
-			return
-		}
-	
-		// Construct the URL for membership details
-		url2 := fmt.Sprintf("%s?user_id=%d", viewUserMembershipURL, currentUserID)
-	
-		// Send the GET request to retrieve membership details
-		resp2, err := http.Get(url2)
+		// Retrieve the membership details
+		resp2, err := users.GetMembershipWithResponse(context.Background(), currentUserID)
 		if err != nil {
 			fmt.Println("Error retrieving membership details:", err)
 			return
 		}
-		defer resp2.Body.Close()
-	
-		// Read the response body
-		body2, err := ioutil.ReadAll(resp2.Body)
-		if err != nil {
-			fmt.Println("Error reading membership details response body:", err)
-			return
-		}
-	
-		// Parse the JSON response for membership details
-		var membershipDetails map[string]interface{}
-		err = json.Unmarshal(body2, &membershipDetails)
-		if err != nil {
-			fmt.Println("Error parsing membership details:", err)
+		membershipDetails := resp2.JSON200
+		if membershipDetails == nil {
+			fmt.Printf("Error retrieving membership details: %s\n", describeError(resp2.StatusCode(), resp2.Body))
 			return
 		}
 	
 		// Display the formatted details
 		fmt.Println("User Details:")
-		fmt.Printf("  ID: %v\n", userDetails["user_id"])
-		fmt.Printf("  Name: %v\n", userDetails["name"])
-		fmt.Printf("  Email: %v\n", userDetails["email"])
-		fmt.Printf("  Address: %v\n", userDetails["address"])
-		fmt.Printf("  Phone Number: %v\n", userDetails["phone_number"])
-		fmt.Printf("  Gender: %v\n", userDetails["gender"])
+		fmt.Printf("  ID: %v\n", userDetails.UserId)
+		fmt.Printf("  Name: %v\n", userDetails.Name)
+		fmt.Printf("  Email: %v\n", userDetails.Email)
+		fmt.Printf("  Address: %v\n", userDetails.Address)
+		fmt.Printf("  Phone Number: %v\n", userDetails.PhoneNumber)
+		fmt.Printf("  Gender: %v\n", userDetails.Gender)
+		fmt.Printf("  Timezone: %v\n", userDetails.Timezone)
 	
 		fmt.Println("\nMembership Details:")
-		fmt.Printf("  Membership ID: %v\n", membershipDetails["membership_id"])
-		fmt.Printf("  Membership Name: %v\n", membershipDetails["membership_name"])
+		fmt.Printf("  Membership ID: %v\n", valueOr(membershipDetails.MembershipId, 0))
+		fmt.Printf("  Membership Name: %v\n", valueOr(membershipDetails.MembershipName, ""))
 	}
 		// Function to update user details
 	func updateUserDetails() {
@@ -365,74 +415,72 @@ type RentalsResponse struct {
 			return
 		}
 		reader := bufio.NewReader(os.Stdin)
+		readLine := func(prompt string) string {
+			fmt.Print(prompt)
+			line, _ := reader.ReadString('\n')
+			return strings.TrimSpace(line)
+		}
 
 		// Prompt for each detail
-		fmt.Println("Enter new details. Leave blank to skip updating a field.")
-		
-		fmt.Print("New Address: ")
-		address, _ := reader.ReadString('\n')
-		address = strings.TrimSpace(address)
-
-		fmt.Print("New Phone Number: ")
-		phoneNumber, _ := reader.ReadString('\n')
-		phoneNumber = strings.TrimSpace(phoneNumber)
-
-		fmt.Print("New Gender (Male/Female/Other): ")
-		gender, _ := reader.ReadString('\n')
-		gender = strings.TrimSpace(gender)
-
-
-		// Create a map to hold the update data
-		updateData := make(map[string]string)
-
-		// Add non-empty inputs to the updateData map
-		if address != "" {
-			updateData["address"] = address
-		}
-		if phoneNumber != "" {
-			updateData["phone_number"] = phoneNumber
-		}
-		if gender != "" {
-			updateData["gender"] = gender
-		}
+		fmt.Println("Enter new details. Leave blank to skip updating a field, or enter - to clear it.")
+
+		street := readLine("New Street Address (e.g. 10 Anson Road): ")
+		var unit, postalCode string
+		if street != "" && street != "-" {
+			unit = readLine("Unit Number (e.g. #05-123, optional): ")
+			postalCode = readLine("Postal Code: ")
+		}
+		phoneNumber := readLine("New Phone Number: ")
+		gender := readLine("New Gender (Male/Female/Other): ")
+		timezone := readLine("New Timezone (e.g. Asia/Singapore): ")
+
+		// Fields left unset are not sent; fields set to null are cleared
+		var update userclient.DetailsUpdate
+		if street == "-" {
+			update.Address.SetNull()
+		} else if street != "" {
+			update.Address.Set(userclient.Address{
+				Street:     &street,
+				Unit:       &unit,
+				PostalCode: &postalCode,
+			})
+		}
+		setOrClear(&update.PhoneNumber, phoneNumber)
+		setOrClear(&update.Gender, gender)
+		setOrClear(&update.Timezone, timezone)
 
 		// If no fields were provided, exit
-		if len(updateData) == 0 {
+		if !update.Address.IsSpecified() && !update.PhoneNumber.IsSpecified() && !update.Gender.IsSpecified() && !update.Timezone.IsSpecified() {
 			fmt.Println("No details to update.")
 			return
 		}
 
-		// Convert the updateData map to JSON
-		updateJSON, err := json.Marshal(updateData)
-		if err != nil {
-			fmt.Println("Error creating JSON payload:", err)
-			return
-		}
-
-		// Construct the URL with the user_id parameter
-		url := fmt.Sprintf("http://localhost:8080/update-details?user_id=%d", currentUserID)
-
-		// Send the POST request
-		req, err := http.NewRequest("POST", url, bytes.NewBuffer(updateJSON))
-		if err != nil {
-			fmt.Println("Error creating request:", err)
-			return
-		}
-
-		req.Header.Set("Content-Type", "application/json")
-
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := users.UpdateUserWithResponse(context.Background(), currentUserID, update)
 		if err != nil {
 			fmt.Println("Error updating details:", err)
 			return
 		}
-		defer resp.Body.Close()
 
 		// Handle the response
-		if resp.StatusCode == http.StatusOK {
+		if resp.StatusCode() == http.StatusOK {
 			fmt.Println("User details updated successfully!")
+		} else if resp.StatusCode() == http.StatusUnprocessableEntity {
+			// Show which fields were rejected and why
+			fmt.Println("Some details could not be saved:")
+			for field, reason := range apierror.Parse(resp.StatusCode(), resp.Body).Fields {
+				fmt.Printf("  %s: %s\n", field, reason)
+			}
 		} else {
-			fmt.Printf("Error updating details: %s\n", resp.Status)
+			fmt.Printf("Error updating details: %s\n", describeError(resp.StatusCode(), resp.Body))
+		}
+	}
+
+	// setOrClear sets field to value, or to null if value is "-"; an empty value leaves it unset
+	func setOrClear(field *nullable.Nullable[string], value string) {
+		if value == "-" {
+			field.SetNull()
+		} else if value != "" {
+			field.Set(value)
 		}
 	}
 
@@ -469,53 +517,19 @@ type RentalsResponse struct {
 		}
 
 
-		// Prepare the payload
-		updateData := map[string]int{
-			"membership_id": membershipID, // Convert string to integer
-		}
-
-		// Convert the payload to JSON
-		updateJSON, err := json.Marshal(updateData)
-		if err != nil {
-			fmt.Println("Error creating JSON payload:", err)
-			return
-		}
-
-		// Construct the URL with the user_id parameter
-		url := fmt.Sprintf("%s?user_id=%d", updateMembershipURL, currentUserID)
-
-		// Create a new PUT request
-		req, err := http.NewRequest("PUT", url, bytes.NewBuffer(updateJSON))
-		if err != nil {
-			fmt.Println("Error creating request:", err)
-			return
-		}
-
-		// Set the appropriate header
-		req.Header.Set("Content-Type", "application/json")
-
-		// Execute the request
-		client := &http.Client{}
-		resp, err := client.Do(req)
+		// Send the new membership
+		resp, err := users.UpdateMembershipWithResponse(context.Background(), currentUserID,
+			userclient.MembershipChange{MembershipId: membershipID})
 		if err != nil {
 			fmt.Println("Error updating membership:", err)
 			return
 		}
-		defer resp.Body.Close()
-
-		// Read the response body
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			fmt.Println("Error reading response body:", err)
-			return
-		}
 
 		// Check the response status
-		if resp.StatusCode == http.StatusOK {
+		if resp.StatusCode() == http.StatusOK {
 			fmt.Println("Membership updated successfully!")
 		} else {
-			fmt.Printf("Error updating membership: %s\n", resp.Status)
-			fmt.Println("Response body:", string(body))
+			fmt.Printf("Error updating membership: %s\n", describeError(resp.StatusCode(), resp.Body))
 		}
 	}
 
@@ -543,48 +557,19 @@ type RentalsResponse struct {
 			return
 		}
 
-		// Prepare the request body
-		requestBody := map[string]string{
-			"old_password": oldPassword,
-			"new_password": newPassword,
-		}
-		jsonData, err := json.Marshal(requestBody)
-		if err != nil {
-			fmt.Println("Error creating request body:", err)
-			return
-		}
-
-		// Send the POST request to update the password
-		url := fmt.Sprintf("http://localhost:8080/update-password?user_id=%d", currentUserID)
-		req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
-		if err != nil {
-			fmt.Println("Error creating request:", err)
-			return
-		}
-
-		req.Header.Set("Content-Type", "application/json")
-
-		// Execute the request
-		client := &http.Client{}
-		resp, err := client.Do(req)
+		// Send the request to update the password
+		resp, err := users.ChangePasswordWithResponse(context.Background(), currentUserID,
+			userclient.PasswordChange{OldPassword: oldPassword, NewPassword: newPassword})
 		if err != nil {
 			fmt.Println("Error sending request:", err)
 			return
 		}
-		defer resp.Body.Close()
-
-		// Read and display the response
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			fmt.Println("Error reading response:", err)
-			return
-		}
 
 		// Output the response from the server
-		if resp.StatusCode == http.StatusOK {
+		if resp.StatusCode() == http.StatusOK {
 			fmt.Println("Password updated successfully.")
 		} else {
-			fmt.Printf("Failed to update password: %s\n", string(body))
+			fmt.Printf("Failed to update password: %s\n", describeError(resp.StatusCode(), resp.Body))
 		}
 
 
@@ -592,55 +577,40 @@ type RentalsResponse struct {
 
 	// Function to view all rentals
 	func viewAllRentals() {
-		// Construct the URL with the current user ID as a query parameter
-		url := fmt.Sprintf("%s?user_id=%d", viewRentalsURL, currentUserID)
-	
-		// Send the GET request to the view rentals endpoint
-		resp, err := http.Get(url)
-		if err != nil {
-			fmt.Println("Error retrieving rentals:", err)
-			return
-		}
-		defer resp.Body.Close()
-	
-		// Read the response body
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			fmt.Println("Error reading response body:", err)
-			return
-		}
-	
-		// Check the response status
-		if resp.StatusCode == http.StatusOK {
-			// Parse the JSON response
-			var rentalsResponse struct {
-				Rentals []struct {
-					ID            int    `json:"id"`
-					StartDate     string `json:"start_date"`
-					EndDate       string `json:"end_date"`
-					OvertimeHours int    `json:"overtime_hours"`
-					Status        string `json:"status"`
-					VehicleID     int    `json:"vehicle_id"`
-				} `json:"rentals"`
-			}
-	
-			err = json.Unmarshal(body, &rentalsResponse)
+		// Optionally narrow the list to one status
+		params := &userclient.ListRentalsParams{}
+		status := getUserInput("Filter by status (active/completed/cancelled, Enter for all): ")
+		if status != "" {
+			filter := userclient.ListRentalsParamsStatus(status)
+			params.Status = &filter
+		}
+
+		fmt.Println("Rentals:")
+		pageThrough(func(cursor *string) *string {
+			// Retrieve a page of the user's rentals
+			params.Cursor = cursor
+			resp, err := users.ListRentalsWithResponse(context.Background(), currentUserID, params)
 			if err != nil {
-				fmt.Println("Error parsing rentals:", err)
-				return
+				fmt.Println("Error retrieving rentals:", err)
+				return nil
 			}
-	
+			if resp.JSON200 == nil {
+				fmt.Printf("Error retrieving rentals: %s\n", describeError(resp.StatusCode(), resp.Body))
+				return nil
+			}
+			if len(resp.JSON200.Data) == 0 && cursor == nil {
+				fmt.Println("  No rentals found.")
+			}
+
 			// Display the rentals in a formatted manner
-			fmt.Println("Rentals:")
-			for _, rental := range rentalsResponse.Rentals {
+			for _, rental := range resp.JSON200.Data {
 				fmt.Printf(
 					"  Rental ID: %d\n  Start Date: %s\n  End Date: %s\n  Overtime Hours: %d\n  Status: %s\n  Vehicle ID: %d\n\n",
-					rental.ID, rental.StartDate, rental.EndDate, rental.OvertimeHours, rental.Status, rental.VehicleID,
+					rental.Id, displayTime(rental.StartDate), displayTime(rental.EndDate), rental.OvertimeHours, rental.Status, rental.VehicleId,
 				)
 			}
-		} else {
-			fmt.Printf("Error retrieving rentals: %s\n", resp.Status)
-		}
+			return resp.JSON200.Page.NextCursor
+		})
 	}
 	
 
@@ -650,45 +620,35 @@ type RentalsResponse struct {
 			fmt.Println("You must be logged in to create a rental.")
 			return
 		}
-		// Step 1: Fetch available vehicles
-		url := fmt.Sprintf("http://localhost:8081/vehicles/available?user_id=%d", currentUserID)
-		resp, err := http.Get(url)
-		if err != nil {
-			fmt.Println("Error fetching available vehicles:", err)
-			return
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			fmt.Printf("Error: Unable to fetch vehicles. Status: %s\n", resp.Status)
-			return
-		}
-
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			fmt.Println("Error reading response:", err)
-			return
-		}
-
-		// Parse the available vehicles
-		var vehicles []map[string]interface{}
-		if err := json.Unmarshal(body, &vehicles); err != nil {
-			fmt.Println("Error parsing vehicle data:", err)
-			return
-		}
+		// Step 1: List the available vehicles, a page at a time
+		fmt.Println("Available Vehicles:")
+		params := &vehicleclient.ListAvailableVehiclesParams{UserId: currentUserID}
+		found := false
+		pageThrough(func(cursor *string) *string {
+			params.Cursor = cursor
+			resp, err := vehicles.ListAvailableVehiclesWithResponse(context.Background(), params)
+			if err != nil {
+				fmt.Println("Error fetching available vehicles:", err)
+				return nil
+			}
+			if resp.JSON200 == nil {
+				fmt.Printf("Error: Unable to fetch vehicles. %s\n", describeError(resp.StatusCode(), resp.Body))
+				return nil
+			}
 
-		if len(vehicles) == 0 {
+			// Print available vehicle details (id, make, model, year, cost per hour, vip access)
+			for _, v := range resp.JSON200.Data {
+				found = true
+				fmt.Printf("ID: %v, Make: %v, Model: %v, Year: %v, Cost per Hour: $%.2f, VIP Access: %v\n",
+					v.Id, v.Make, v.Model, v.Year, v.CostPerHour, v.VipAccess)
+			}
+			return resp.JSON200.Page.NextCursor
+		})
+		if !found {
 			fmt.Println("No vehicles available for rental.")
 			return
 		}
 
-		fmt.Println("Available Vehicles:")
-		// Print available vehicle details (id, make, model, year, cost per hour, vip access)
-		for _, v := range vehicles {
-			fmt.Printf("ID: %v, Make: %v, Model: %v, Year: %v, Cost per Hour: $%.2f, VIP Access: %v\n",
-				v["id"], v["make"], v["model"], v["year"], v["cost_per_hour"], v["vip_access"])
-		}
-
 		// Step 2: Get user choice for vehicle ID
 		vehicleIDStr := getUserInput("Enter the Vehicle ID you want to rent: ")
 		vehicleID, err := strconv.Atoi(vehicleIDStr)
@@ -706,62 +666,25 @@ type RentalsResponse struct {
 			fmt.Println("Invalid input for hours.")
 			return
 		}
-		// Step 4: Fetch estimated cost using POST request with user_id in the query and other data in the body
-	estimateURL := fmt.Sprintf("http://localhost:8082/billing/estimate-cost?user_id=%d", currentUserID)
-
-	estimateData := map[string]interface{}{
-		"vehicle_id": vehicleID,
-		"hours":      hours,
-	}
-
-	// Create JSON payload for estimate data
-	estimateJSON, err := json.Marshal(estimateData)
-	if err != nil {
-		fmt.Println("Error creating JSON payload for estimate:", err)
-		return
-	}
-	// Send the POST request
-	resp, err = http.Post(estimateURL, "application/json", bytes.NewBuffer(estimateJSON))
+		// Step 4: Fetch estimated cost
+	estimateResp, err := billing.EstimateCostWithResponse(context.Background(),
+		billingclient.NewEstimate{UserId: currentUserID, VehicleId: vehicleID, Hours: hours})
 	if err != nil {
 		fmt.Println("Error fetching cost estimate:", err)
 		return
 	}
-	defer resp.Body.Close()
 
-	// Log the request body for debugging
-	estimateDataJSON, err := json.Marshal(estimateData)
-	if err != nil {
-		fmt.Println("Error marshaling request data:", err)
-		return
-	}
-	fmt.Println(estimateURL)
-	fmt.Printf("Request Data: %s\n", string(estimateDataJSON))  // Log the request body
+	// Log the response status for debugging
+	fmt.Printf("Response Status: %s\n", estimateResp.Status())  // Log the status
 
-	// Log the response status and body for debugging
-	fmt.Printf("Response Status: %s\n", resp.Status)  // Log the status
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		fmt.Println("Error reading response:", err)
+	// Check if the response contains the estimated cost
+	if estimateResp.JSON200 == nil {
+		fmt.Printf("Error fetching cost estimate: %s\n", describeError(estimateResp.StatusCode(), estimateResp.Body))
 		return
 	}
-	fmt.Printf("Response Body: %s\n", string(bodyBytes))  // Log the response body for debugging
 
-	// Check if the response body contains the estimated cost
-	var estimateResp map[string]interface{}
-	if err := json.Unmarshal(bodyBytes, &estimateResp); err != nil {
-		fmt.Println("Error parsing estimate data:", err)
-		return
-	}
-
-	// Check if 'estimated_cost' exists and handle the case if it's missing
-	estimatedCost, ok := estimateResp["total_cost"].(float64) // Or check type accordingly
-	if !ok {
-		fmt.Println("Error: 'total_cost' not found in the response or is of the wrong type")
-		return
-	}
-
-	// Print the estimated cost if found
-	fmt.Printf("Estimated Cost: $%.2f\n", estimatedCost)
+	// Print the estimated cost
+	fmt.Printf("Estimated Cost: $%.2f\n", estimateResp.JSON200.TotalCost)
 
 		// Step 5: Confirm rental
 		confirm := getUserInput("Do you want to confirm the rental? (yes/no): ")
@@ -771,39 +694,18 @@ type RentalsResponse struct {
 		}
 
 	// Step 6: Create the rental
-	createURL := fmt.Sprintf("http://localhost:8081/vehicles/create-rental?user_id=%d", currentUserID)
-	rentalData := map[string]interface{}{
-		"vehicle_id": vehicleID,
-		"hours":      hours,
-	}
-
-	rentalJSON, err := json.Marshal(rentalData)
-	if err != nil {
-		fmt.Println("Error creating JSON payload for rental:", err)
-		return
-	}
-
-	resp, err = http.Post(createURL, "application/json", bytes.NewBuffer(rentalJSON))
+	rentalResp, err := vehicles.CreateRentalWithResponse(context.Background(),
+		vehicleclient.NewRental{UserId: currentUserID, VehicleId: vehicleID, Hours: hours})
 	if err != nil {
 		fmt.Println("Error creating rental:", err)
 		return
 	}
-	defer resp.Body.Close()
-
-	// Check if the status code indicates an existing ongoing rental
-	if resp.StatusCode == http.StatusConflict {
-		fmt.Println("Error: User already has an ongoing rental. Please return the current vehicle before renting another.")
-		return
-	}
 
 	// Handle the status codes
-	if resp.StatusCode == http.StatusConflict {
-		fmt.Println("Error: User already has an ongoing rental. Please return the current vehicle before renting another.")
-		return
-	} else if resp.StatusCode == http.StatusOK {
+	if rentalResp.StatusCode() == http.StatusCreated {
 		fmt.Println("Rental created successfully!")
 	} else {
-		fmt.Printf("Error creating rental. Status: %s\n", resp.Status)
+		fmt.Printf("Error creating rental: %s\n", describeError(rentalResp.StatusCode(), rentalResp.Body))
 	}
 
 	}
@@ -823,75 +725,53 @@ type RentalsResponse struct {
 			return
 		}
 	
-		// Step 1: Confirm cancellation
+		// Step 1: Find the active rental
+		lastRental, ok := latestRental()
+		if !ok {
+			return
+		}
+		if lastRental.Status != userclient.RentalStatusActive {
+			fmt.Println("No active rental to cancel.")
+			return
+		}
+
+		// Step 2: Confirm cancellation
 		confirm := getUserInput("Do you want to confirm the rental cancellation? (yes/no): ")
 		if strings.ToLower(confirm) != "yes" {
 			fmt.Println("Cancellation aborted.")
 			return
 		}
 	
-		// Step 2: Construct the URL for canceling the rental
-		url := fmt.Sprintf("http://localhost:8081/vehicles/cancel-rental?user_id=%d", currentUserID)
-	
-		// Step 3: Make the POST request using curl
-		cmd := exec.Command("curl", "-s", "-X", "POST", url)
-		output, err := cmd.CombinedOutput()
+		// Step 3: Cancel the rental
+		resp, err := vehicles.CancelRentalWithResponse(context.Background(), lastRental.Id)
 		if err != nil {
-			fmt.Println("Error while executing curl command:", err)
+			fmt.Println("Error cancelling rental:", err)
 			return
 		}
 	
-		// Step 4: Process and format the server response
-		response := strings.TrimSpace(string(output)) // Clean up any extra spaces or newlines
-		if strings.Contains(response, "No active rentals") {
-			fmt.Println("No active rentals found for the user.")
-		} else if strings.Contains(response, "Rental cancelled successfully") {
+		// Step 4: Report the outcome
+		if resp.StatusCode() == http.StatusOK {
 			fmt.Println("Rental canceled successfully!")
 		} else {
-			fmt.Printf("Unexpected response from the server: %s\n", response)
+			fmt.Println("Failed to cancel rental:", describeError(resp.StatusCode(), resp.Body))
 		}
 	}
 	
 	func extendRental() {
 		// Step 1: View active rentals
 		fmt.Println("Fetching active rentals...")
-		viewRentalsURL := fmt.Sprintf("%s?user_id=%d", viewRentalsURL, currentUserID)
-		resp, err := http.Get(viewRentalsURL)
-		if err != nil {
-			fmt.Println("Error viewing active rentals:", err)
-			return
-		}
-		defer resp.Body.Close()
-	
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			fmt.Println("Error reading response body:", err)
+		lastRental, ok := latestRental()
+		if !ok {
 			return
 		}
-	
-		// Step 2: Parse the response and get the last rental
-		var rentalsResponse RentalsResponse
-		err = json.Unmarshal(body, &rentalsResponse)
-		if err != nil {
-			fmt.Println("Error parsing response:", err)
-			return
-		}
-	
-		// Check if there are any rentals and display the last one
-		if len(rentalsResponse.Rentals) == 0 {
-			fmt.Println("No active rentals found.")
-			return
-		}
-	
-		lastRental := rentalsResponse.Rentals[len(rentalsResponse.Rentals)-1]
-		if lastRental.Status != "active"{
+		if lastRental.Status != userclient.RentalStatusActive{
 			println("No Active Rentals")
 			return
 		}
 		fmt.Println("Last Active Rental: ")
-		fmt.Printf("Vehicle ID: %d\n", lastRental.VehicleID)
-		fmt.Printf("Start Date: %s\n", lastRental.StartDate)
-		fmt.Printf("End Date: %s\n", lastRental.EndDate)
+		fmt.Printf("Vehicle ID: %d\n", lastRental.VehicleId)
+		fmt.Printf("Start Date: %s\n", displayTime(lastRental.StartDate))
+		fmt.Printf("End Date: %s\n", displayTime(lastRental.EndDate))
 		fmt.Printf("Status: %s\n", lastRental.Status)
 	
 		// Step 3: Select hours to extend
@@ -909,71 +789,58 @@ type RentalsResponse struct {
 			return
 		}
 	
-		// Step 5: Extend the rental (POST request)
-		extendRentalURL := fmt.Sprintf("http://localhost:8081/vehicles/extend-rental?user_id=%d", currentUserID)
-		extendRequestBody := fmt.Sprintf("{\"hours\": %d}", hours)
-		req, err := http.NewRequest("POST", extendRentalURL, bytes.NewBuffer([]byte(extendRequestBody)))
-		if err != nil {
-			fmt.Println("Error creating extend rental request:", err)
-			return
-		}
-	
-		req.Header.Set("Content-Type", "application/json")
-		resp, err = http.DefaultClient.Do(req)
+		// Step 5: Extend the rental
+		resp, err := vehicles.ExtendRentalWithResponse(context.Background(), lastRental.Id,
+			vehicleclient.ExtendRentalRequest{Hours: hours})
 		if err != nil {
 			fmt.Println("Error extending rental:", err)
 			return
 		}
-		defer resp.Body.Close()
 	
-		if resp.StatusCode == http.StatusOK {
+		if resp.StatusCode() == http.StatusOK {
 			fmt.Println("Rental extended successfully!")
 		} else {
-			fmt.Printf("Error extending rental: %s\n", resp.Status)
+			fmt.Printf("Error extending rental: %s\n", describeError(resp.StatusCode(), resp.Body))
 		}
 	}
 
-	func completeRental() {
-		// Step 1: View active rentals
-		fmt.Println("Fetching active rentals...")
-		viewRentalsURL := fmt.Sprintf("%s?user_id=%d", viewRentalsURL, currentUserID)
-		resp, err := http.Get(viewRentalsURL)
+	// latestRental fetches the user's most recent rental, reporting why if there is none
+	func latestRental() (userclient.Rental, bool) {
+		newest, one := userclient.MinusId, 1
+		params := &userclient.ListRentalsParams{Sort: &newest, Limit: &one}
+		resp, err := users.ListRentalsWithResponse(context.Background(), currentUserID, params)
 		if err != nil {
 			fmt.Println("Error viewing active rentals:", err)
-			return
-		}
-		defer resp.Body.Close()
-	
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			fmt.Println("Error reading response body:", err)
-			return
+			return userclient.Rental{}, false
 		}
-	
-		// Step 2: Parse the response and get the last rental
-		var rentalsResponse RentalsResponse
-		err = json.Unmarshal(body, &rentalsResponse)
-		if err != nil {
-			fmt.Println("Error parsing response:", err)
-			return
+		if resp.JSON200 == nil {
+			fmt.Println("Error viewing active rentals:", describeError(resp.StatusCode(), resp.Body))
+			return userclient.Rental{}, false
 		}
 	
-		// Check if there are any rentals and display the last one
-		if len(rentalsResponse.Rentals) == 0 {
+		// The newest rental comes first
+		if len(resp.JSON200.Data) == 0 {
 			fmt.Println("No active rentals found.")
+			return userclient.Rental{}, false
+		}
+		return resp.JSON200.Data[0], true
+	}
+
+	func completeRental() {
+		// Step 1: View active rentals
+		fmt.Println("Fetching active rentals...")
+		lastRental, ok := latestRental()
+		if !ok {
 			return
 		}
-	
-		// Fetch the last rental in the response
-		lastRental := rentalsResponse.Rentals[len(rentalsResponse.Rentals)-1]
 
 		// Check if the rental status is "active"
-		if lastRental.Status == "active" {
+		if lastRental.Status == userclient.RentalStatusActive {
 			fmt.Println("Last Active Rental: ")
-			fmt.Printf("Rental ID: %d\n", lastRental.ID)
-			fmt.Printf("Vehicle ID: %d\n", lastRental.VehicleID)
-			fmt.Printf("Start Date: %s\n", lastRental.StartDate)
-			fmt.Printf("End Date: %s\n", lastRental.EndDate)
+			fmt.Printf("Rental ID: %d\n", lastRental.Id)
+			fmt.Printf("Vehicle ID: %d\n", lastRental.VehicleId)
+			fmt.Printf("Start Date: %s\n", displayTime(lastRental.StartDate))
+			fmt.Printf("End Date: %s\n", displayTime(lastRental.EndDate))
 			fmt.Printf("Status: %s\n", lastRental.Status)
 		} else {
 			// If last rental is not active, output a message indicating no active rentals
@@ -983,43 +850,29 @@ type RentalsResponse struct {
 
 
 		// Step 3: Confirm the completion of the rental
-		confirm := getUserInput("Do you want to complete the rental for Vehicle ID " + strconv.Itoa(lastRental.VehicleID) + "? (yes/no): ")
+		confirm := getUserInput("Do you want to complete the rental for Vehicle ID " + strconv.Itoa(lastRental.VehicleId) + "? (yes/no): ")
 		if confirm != "yes" {
 			fmt.Println("Rental completion canceled.")
 			return
 		}
 	
-		// Step 4: Complete the rental (POST request)
-		completeRentalURL := fmt.Sprintf("http://localhost:8081/vehicles/complete-rental?user_id=%d", currentUserID)
-		req, err := http.NewRequest("POST", completeRentalURL, nil)
-		if err != nil {
-			fmt.Println("Error creating complete rental request:", err)
-			return
-		}
-	
-		resp, err = http.DefaultClient.Do(req)
+		// Step 4: Complete the rental
+		resp, err := vehicles.CompleteRentalWithResponse(context.Background(), lastRental.Id)
 		if err != nil {
 			fmt.Println("Error completing rental:", err)
 			return
 		}
-		defer resp.Body.Close()
 	
-		// Step 5: Print out the invoice (assuming the response contains invoice details)
-		if resp.StatusCode == http.StatusOK {
+		// Step 5: Print out the invoice
+		if completed := resp.JSON200; completed != nil {
 			fmt.Println("Rental completed successfully!")
-	
-			// Read the response body for the invoice details
-			invoiceBody, err := ioutil.ReadAll(resp.Body)
-			if err != nil {
-				fmt.Println("Error reading invoice response:", err)
-				return
-			}
-	
-			// Assuming the response contains the invoice details
 			fmt.Println("Invoice: ")
-			fmt.Println(string(invoiceBody))
+			fmt.Printf("  Invoice ID: %d\n", completed.Invoice.Id)
+			fmt.Printf("  Hours: %d\n", completed.Invoice.Hours)
+			fmt.Printf("  Hours Overdue: %d\n", completed.Invoice.HoursOverdue)
+			fmt.Printf("  Final Cost: $%.2f\n", completed.Invoice.FinalCost)
 		} else {
-			fmt.Println("Error completing rental:", resp.Status)
+			fmt.Println("Error completing rental:", describeError(resp.StatusCode(), resp.Body))
 		}
 	}
 	
@@ -1042,93 +895,433 @@ type RentalsResponse struct {
 	}
 	
 	func viewAllInvoices(unpaidOnly bool) {
-		// Create the URL with the necessary query parameters
-		url := fmt.Sprintf("http://localhost:8082/billing/get-invoices?userid=%d", currentUserID)
+		// Only ask for unpaid invoices when filtering
+		params := &billingclient.ListInvoicesParams{}
 		if unpaidOnly {
-			url += "&unpaidonly=true" // Append the unpaidonly=true query parameter
+			paid := false
+			params.Paid = &paid
 		}
 		
-		// Make the GET request to the API
-		resp, err := http.Get(url)
+		fmt.Println("Invoices:")
+		pageThrough(func(cursor *string) *string {
+			// Make the request to the API
+			params.Cursor = cursor
+			resp, err := billing.ListInvoicesWithResponse(context.Background(), currentUserID, params)
+			if err != nil {
+				log.Fatal("Error making GET request:", err)
+			}
+			
+			// Check if the request was successful
+			if resp.JSON200 == nil {
+				fmt.Println("Failed to retrieve invoices:", describeError(resp.StatusCode(), resp.Body))
+				return nil
+			}
+			if len(resp.JSON200.Data) == 0 && cursor == nil {
+				fmt.Println("No invoices found.")
+			}
+			
+			// Display the invoices
+			for _, invoice := range resp.JSON200.Data {
+				fmt.Printf("Invoice ID: %v\n", invoice.Id)
+				fmt.Printf("Rental ID: %v\n", invoice.RentalId)
+				fmt.Printf("Hours: %v\n", invoice.Hours)
+				fmt.Printf("Hours Overdue: %v\n", invoice.HoursOverdue)
+				fmt.Printf("Final Cost: $%v\n", invoice.FinalCost)
+				fmt.Printf("Paid Status: %v\n", invoice.PaidStatus)
+				fmt.Printf("Created At: %s\n", displayTime(invoice.CreatedAt))
+				fmt.Println("----------")
+			}
+			return resp.JSON200.Page.NextCursor
+		})
+	}
+	func payInvoice() {
+		// Ask for the invoice ID
+		invoiceIDStr := getUserInput("Enter the Invoice ID to pay: ")
+		invoiceID, err := strconv.Atoi(invoiceIDStr)
+		if err != nil || invoiceID <= 0 {
+			fmt.Println("Invalid Invoice ID. Please enter a valid number.")
+			return
+		}
+	
+		// Make the payment request
+		resp, err := billing.PayInvoiceWithResponse(context.Background(), invoiceID,
+			billingclient.NewPayment{UserId: currentUserID})
 		if err != nil {
-			log.Fatal("Error making GET request:", err)
+			log.Fatal("Error making POST request:", err)
 		}
-		defer resp.Body.Close()
-		
-		// Read the response body
-		body, err := ioutil.ReadAll(resp.Body)
+	
+		// Handle the response
+		if resp.StatusCode() == http.StatusCreated {
+			fmt.Println("Invoice payment successful!")
+		} else {
+			fmt.Printf("Failed to pay invoice: %s\n", describeError(resp.StatusCode(), resp.Body))
+		}
+	}
+	
+
+	// friendlyErrors maps API error codes to messages for console users. Codes not listed here
+	// show the server's own message, which already explains the problem.
+	var friendlyErrors = map[string]string{
+		apierror.CodeInvalidCredentials:  "Incorrect email or password.",
+		apierror.CodeInvalidCode:         "That code is not valid. Check your authenticator app and try again.",
+		apierror.CodeEmailNotVerified:    "Your email address has not been verified. Use option 14 to verify it or option 15 to get a new link.",
+		apierror.CodeEmailTaken:          "That email is already used by another account.",
+		apierror.CodeAccountLocked:       "Your account is locked. Use the unlock code from your email (option 18) or reset your password.",
+		apierror.CodeRateLimited:         "Too many attempts. Please wait a little and try again.",
+		apierror.CodeTokenExpired:        "That code has expired. Please request a new one.",
+		apierror.CodeTokenUsed:           "That code has already been used. Please request a new one.",
+		apierror.CodeTokenInvalid:        "That code is not valid. Check you copied it correctly.",
+		apierror.CodeTwoFactorEnabled:    "Two-factor authentication is already enabled.",
+		apierror.CodeLicencePending:      "Your licence is already awaiting review.",
+		apierror.CodeLicenceRequired:     "You need an approved driver's licence to rent. Submit your licence (option 21) and wait for it to be approved.",
+		apierror.CodeRentalInProgress:    "You already have an ongoing rental. Please return the current vehicle first.",
+		apierror.CodeNoActiveRental:      "You have no active rental.",
+		apierror.CodeVehicleUnavailable:  "That vehicle is no longer available. Please choose another.",
+		apierror.CodeVIPRequired:         "That vehicle is only available to VIP members.",
+		apierror.CodeCancellationExpired: "Rentals can only be cancelled within 1 hour of the start time.",
+		apierror.CodeUnpaidInvoices:      "Please pay your outstanding invoices first.",
+		apierror.CodeServiceUnavailable:  "The service is unavailable right now. Please try again later.",
+		apierror.CodeInternal:            "Something went wrong on our side. Please try again later.",
+	}
+
+	// describeError turns an API error response into a message for the user. Unexpected failures
+	// include the request ID so they can be traced when reported.
+	func describeError(status int, body []byte) string {
+		apiErr := apierror.Parse(status, body)
+		message := apiErr.Message
+		if friendly, ok := friendlyErrors[apiErr.Code]; ok {
+			message = friendly
+		}
+		if apiErr.Code == apierror.CodeInternal || apiErr.Code == apierror.CodeServiceUnavailable {
+			id := apiErr.RequestID
+			if id == "" {
+				// The error did not come from a service, e.g. a proxy answered instead
+				id = requestID
+			}
+			message += fmt.Sprintf(" (request ID: %s)", id)
+		}
+		for field, reason := range apiErr.Fields {
+			message += fmt.Sprintf("\n  %s: %s", field, reason)
+		}
+		return message
+	}
+
+	// displayTime shows a time from the services in the zone it was sent in, which is the user's
+	// preferred timezone
+	func displayTime(t time.Time) string {
+		return t.Format("2006-01-02 15:04 (UTC-07:00)")
+	}
+
+	// pageThrough shows a list a page at a time. show fetches and prints the page after cursor,
+	// which is nil for the first page, and returns the cursor of the next page, or nil once the
+	// list is exhausted or the request failed.
+	func pageThrough(show func(cursor *string) *string) {
+		cursor := show(nil)
+		for cursor != nil && strings.ToLower(getUserInput("Show more? (y/n): ")) == "y" {
+			cursor = show(cursor)
+		}
+	}
+
+	// valueOr returns *value, or fallback if the service left it out
+	func valueOr[T any](value *T, fallback T) T {
+		if value == nil {
+			return fallback
+		}
+		return *value
+	}
+
+	// Function to verify the user's email with the code from the verification email
+	func verifyEmail() {
+		token := getUserInput("Enter the verification code from your email: ")
+		resp, err := users.VerifyEmailWithResponse(context.Background(), userclient.TokenRequest{Token: &token})
 		if err != nil {
-			log.Fatal("Error reading response body:", err)
+			fmt.Println("Error verifying email:", err)
+			return
 		}
-		
-		// Check if the request was successful
-		if resp.StatusCode != http.StatusOK {
-			fmt.Println("Failed to retrieve invoices:", string(body))
+		if resp.StatusCode() == http.StatusOK {
+			fmt.Println("Email verified successfully! You can now log in.")
+		} else {
+			fmt.Printf("Failed to verify email: %s\n", describeError(resp.StatusCode(), resp.Body))
+		}
+	}
+
+	// Function to request a new verification email
+	func resendVerification() {
+		email := getUserInput("Enter your email: ")
+		resp, err := users.ResendVerificationWithResponse(context.Background(), userclient.EmailRequest{Email: email})
+		if err != nil {
+			fmt.Println("Error requesting verification email:", err)
 			return
 		}
-		
-		// Parse the JSON response
-		var invoices []map[string]interface{}
-		err = json.Unmarshal(body, &invoices)
+		if resp.StatusCode() == http.StatusAccepted {
+			fmt.Println("If the account exists and is unverified, a new verification email has been sent.")
+		} else {
+			fmt.Printf("Failed to request verification email: %s\n", describeError(resp.StatusCode(), resp.Body))
+		}
+	}
+
+	// Function to request a password reset email
+	func forgotPassword() {
+		email := getUserInput("Enter your email: ")
+		resp, err := users.RequestPasswordResetWithResponse(context.Background(), userclient.EmailRequest{Email: email})
 		if err != nil {
-			log.Fatal("Error parsing JSON response:", err)
+			fmt.Println("Error requesting password reset:", err)
+			return
 		}
-		
-		// Display the invoices
-		if len(invoices) == 0 {
-			fmt.Println("No invoices found.")
+		if resp.StatusCode() == http.StatusAccepted {
+			fmt.Println("If the account exists, a reset code has been sent to your email. Use option 17 to reset your password.")
+		} else {
+			fmt.Printf("Failed to request password reset: %s\n", describeError(resp.StatusCode(), resp.Body))
+		}
+	}
+
+	// Function to reset the password with the code from the reset email
+	func resetPassword() {
+		token := getUserInput("Enter the reset code from your email: ")
+		newPassword := getUserInput("Enter new password: ")
+		confirmPassword := getUserInput("Confirm new password: ")
+		if newPassword != confirmPassword {
+			fmt.Println("Passwords do not match. Try again.")
 			return
 		}
-		
-		fmt.Println("Invoices:")
-		for _, invoice := range invoices {
-			fmt.Printf("Invoice ID: %v\n", invoice["id"])
-			fmt.Printf("Rental ID: %v\n", invoice["rental_id"])
-			fmt.Printf("Hours: %v\n", invoice["hours"])
-			fmt.Printf("Hours Overdue: %v\n", invoice["hours_overdue"])
-			fmt.Printf("Final Cost: $%v\n", invoice["final_cost"])
-			fmt.Printf("Paid Status: %v\n", invoice["paid_status"])
-			fmt.Printf("Created At: %v\n", invoice["created_at"])
-			fmt.Println("----------")
+
+		resp, err := users.ResetPasswordWithResponse(context.Background(), userclient.ResetPasswordRequest{
+			Token:       token,
+			NewPassword: newPassword,
+		})
+		if err != nil {
+			fmt.Println("Error resetting password:", err)
+			return
+		}
+		if resp.StatusCode() == http.StatusOK {
+			fmt.Println("Password reset successfully! You can now log in.")
+		} else {
+			fmt.Printf("Failed to reset password: %s\n", describeError(resp.StatusCode(), resp.Body))
 		}
 	}
-	func payInvoice() {
-		// Ask for the invoice ID
-		invoiceIDStr := getUserInput("Enter the Invoice ID to pay: ")
-		invoiceID, err := strconv.Atoi(invoiceIDStr)
-		if err != nil || invoiceID <= 0 {
-			fmt.Println("Invalid Invoice ID. Please enter a valid number.")
+
+	// Function to unlock a locked account with the code from the lockout email
+	func unlockAccount() {
+		token := getUserInput("Enter the unlock code from your email: ")
+		resp, err := users.UnlockAccountWithResponse(context.Background(), userclient.TokenRequest{Token: &token})
+		if err != nil {
+			fmt.Println("Error unlocking account:", err)
 			return
 		}
-	
-		// Prepare the API URL and payload
-		url := fmt.Sprintf("http://localhost:8082/billing/pay-invoice?userid=%d", currentUserID)
-		payload := map[string]interface{}{
-			"invoice_id": invoiceID,
+		if resp.StatusCode() == http.StatusOK {
+			fmt.Println("Account unlocked! You can now log in.")
+		} else {
+			fmt.Printf("Failed to unlock account: %s\n", describeError(resp.StatusCode(), resp.Body))
 		}
-		payloadBytes, err := json.Marshal(payload)
+	}
+
+	// Function to enable two-factor authentication with an authenticator app
+	func enableTwoFactor() {
+		password := getUserInput("Enter your password: ")
+		resp, err := users.EnrollTwoFactorWithResponse(context.Background(), currentUserID,
+			userclient.PasswordRequest{Password: password})
 		if err != nil {
-			log.Fatal("Error creating JSON payload:", err)
+			fmt.Println("Error starting two-factor enrolment:", err)
+			return
 		}
-	
-		// Make the POST request
-		resp, err := http.Post(url, "application/json", bytes.NewBuffer(payloadBytes))
+		enrolment := resp.JSON200
+		if enrolment == nil {
+			fmt.Printf("Failed to start two-factor enrolment: %s\n", describeError(resp.StatusCode(), resp.Body))
+			return
+		}
+		fmt.Println("Add this account to your authenticator app using the URI or secret below:")
+		fmt.Println("  URI:", enrolment.ProvisioningUri)
+		fmt.Println("  Secret:", enrolment.Secret)
+
+		code := getUserInput("Enter the 6-digit code shown in your app: ")
+		confirmResp, err := users.ConfirmTwoFactorWithResponse(context.Background(), currentUserID,
+			userclient.CodeRequest{Code: code})
 		if err != nil {
-			log.Fatal("Error making POST request:", err)
+			fmt.Println("Error confirming two-factor authentication:", err)
+			return
 		}
-		defer resp.Body.Close()
-	
-		// Read the response body
-		body, err := ioutil.ReadAll(resp.Body)
+		confirmation := confirmResp.JSON200
+		if confirmation == nil {
+			fmt.Printf("Failed to confirm two-factor authentication: %s\n", describeError(confirmResp.StatusCode(), confirmResp.Body))
+			return
+		}
+		fmt.Println("Two-factor authentication enabled!")
+		fmt.Println("Store these recovery codes somewhere safe. Each can be used once if you lose your device:")
+		for _, code := range confirmation.RecoveryCodes {
+			fmt.Println("  " + code)
+		}
+	}
+
+	// Function to disable two-factor authentication
+	func disableTwoFactor() {
+		password := getUserInput("Enter your password: ")
+		code := getUserInput("Enter a code from your authenticator app (or a recovery code): ")
+		resp, err := users.DisableTwoFactorWithResponse(context.Background(), currentUserID,
+			userclient.DisableTwoFactorRequest{Password: password, Code: code})
 		if err != nil {
-			log.Fatal("Error reading response body:", err)
+			fmt.Println("Error disabling two-factor authentication:", err)
+			return
 		}
-	
-		// Handle the response
-		if resp.StatusCode == http.StatusOK {
-			fmt.Println("Invoice payment successful!")
+		if resp.StatusCode() == http.StatusOK {
+			fmt.Println("Two-factor authentication disabled.")
 		} else {
-			fmt.Printf("Failed to pay invoice: %s\n", string(body))
+			fmt.Printf("Failed to disable two-factor authentication: %s\n", describeError(resp.StatusCode(), resp.Body))
+		}
+	}
+
+	// Function to submit a driver's licence for review
+	func submitLicence() {
+		licenceNumber := getUserInput("Enter licence number: ")
+		country := getUserInput("Enter issuing country code (e.g. SG): ")
+		expiryDate := getUserInput("Enter expiry date (YYYY-MM-DD): ")
+		imagePath := getUserInput("Enter path to a photo or scan of your licence (JPEG, PNG or PDF): ")
+
+		image, err := os.Open(imagePath)
+		if err != nil {
+			fmt.Println("Error opening licence image:", err)
+			return
+		}
+		defer image.Close()
+
+		// Build the multipart form with the licence fields and image
+		var form bytes.Buffer
+		writer := multipart.NewWriter(&form)
+		writer.WriteField("licence_number", licenceNumber)
+		writer.WriteField("issuing_country", country)
+		writer.WriteField("expiry_date", expiryDate)
+		part, err := writer.CreateFormFile("image", image.Name())
+		if err != nil {
+			fmt.Println("Error creating form:", err)
+			return
+		}
+		if _, err := io.Copy(part, image); err != nil {
+			fmt.Println("Error reading licence image:", err)
+			return
+		}
+		writer.Close()
+
+		resp, err := users.SubmitLicenceWithBodyWithResponse(context.Background(), currentUserID,
+			writer.FormDataContentType(), &form)
+		if err != nil {
+			fmt.Println("Error submitting licence:", err)
+			return
+		}
+
+		if resp.StatusCode() == http.StatusCreated {
+			fmt.Println("Licence submitted! You will be emailed once it has been reviewed.")
+		} else {
+			fmt.Printf("Failed to submit licence: %s\n", describeError(resp.StatusCode(), resp.Body))
+		}
+	}
+
+	// Function to view the status of the latest licence submission
+	func viewLicence() {
+		resp, err := users.GetLatestLicenceWithResponse(context.Background(), currentUserID)
+		if err != nil {
+			fmt.Println("Error retrieving licence:", err)
+			return
+		}
+		if resp.StatusCode() == http.StatusNotFound {
+			fmt.Println("You have not submitted a driver's licence yet.")
+			return
+		}
+		licence := resp.JSON200
+		if licence == nil {
+			fmt.Printf("Failed to retrieve licence: %s\n", describeError(resp.StatusCode(), resp.Body))
+			return
+		}
+
+		fmt.Println("Driver's Licence:")
+		fmt.Printf("  Licence Number: %v\n", licence.LicenceNumber)
+		fmt.Printf("  Issuing Country: %v\n", licence.IssuingCountry)
+		fmt.Printf("  Expiry Date: %v\n", licence.ExpiryDate)
+		fmt.Printf("  Status: %v\n", licence.Status)
+		if licence.ReviewNote != nil {
+			fmt.Printf("  Review Note: %v\n", *licence.ReviewNote)
+		}
+	}
+
+	// Function to download a copy of all personal data held about the user
+	func exportData() {
+		resp, err := users.ExportDataWithResponse(context.Background(), currentUserID)
+		if err != nil {
+			fmt.Println("Error exporting data:", err)
+			return
+		}
+		if resp.StatusCode() != http.StatusOK {
+			fmt.Printf("Failed to export data: %s\n", describeError(resp.StatusCode(), resp.Body))
+			return
+		}
+
+		filename := fmt.Sprintf("user-%d-export.json", currentUserID)
+		if err := os.WriteFile(filename, resp.Body, 0o600); err != nil {
+			fmt.Println("Error saving export:", err)
+			return
+		}
+		fmt.Println("Your data has been saved to", filename)
+	}
+
+	// Function to permanently delete the user's account and personal data
+	func deleteAccount() {
+		fmt.Println("Deleting your account erases your personal data and cannot be undone.")
+		fmt.Println("Records of past rentals and invoices are kept without your personal details.")
+		confirm := getUserInput("Type DELETE to confirm: ")
+		if confirm != "DELETE" {
+			fmt.Println("Account deletion cancelled.")
+			return
+		}
+		password := getUserInput("Enter your password: ")
+
+		resp, err := users.DeleteUserWithResponse(context.Background(), currentUserID,
+			userclient.PasswordRequest{Password: password})
+		if err != nil {
+			fmt.Println("Error deleting account:", err)
+			return
+		}
+		if resp.StatusCode() == http.StatusOK {
+			fmt.Println("Your account has been deleted.")
+			logout()
+		} else {
+			fmt.Printf("Failed to delete account: %s\n", describeError(resp.StatusCode(), resp.Body))
+		}
+	}
+
+	// Function to start changing the account email; the current email keeps working until confirmed
+	func changeEmail() {
+		newEmail := getUserInput("Enter your new email: ")
+		password := getUserInput("Enter your current password: ")
+
+		resp, err := users.RequestEmailChangeWithResponse(context.Background(), currentUserID,
+			userclient.EmailChange{Password: password, NewEmail: newEmail})
+		if err != nil {
+			fmt.Println("Error requesting email change:", err)
+			return
+		}
+		switch resp.StatusCode() {
+		case http.StatusAccepted:
+			fmt.Println("A confirmation code has been sent to your new email. Use option 26 to confirm the change.")
+			fmt.Println("Until then, keep logging in with your current email.")
+		case http.StatusConflict:
+			fmt.Println("That email is already used by another account.")
+		default:
+			fmt.Printf("Failed to request email change: %s\n", describeError(resp.StatusCode(), resp.Body))
+		}
+	}
+
+	// Function to confirm an email change with the code sent to the new address
+	func confirmEmailChange() {
+		token := getUserInput("Enter the confirmation code from your new email: ")
+		resp, err := users.ConfirmEmailChangeWithResponse(context.Background(), userclient.TokenRequest{Token: &token})
+		if err != nil {
+			fmt.Println("Error confirming email change:", err)
+			return
+		}
+		switch resp.StatusCode() {
+		case http.StatusOK:
+			fmt.Println("Your email has been changed. Use the new email to log in from now on.")
+		case http.StatusConflict:
+			fmt.Println("That email is already used by another account. Please request the change again with a different email.")
+		default:
+			fmt.Printf("Failed to confirm email change: %s\n", describeError(resp.StatusCode(), resp.Body))
 		}
 	}
-	
@@ -0,0 +1,122 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAPIv2RentalLifecycle(t *testing.T) {
+	e := newEnv(t)
+	userID := e.renter("Dave", "dave@example.com")
+
+	// Renting answers 201 and points at the new rental
+	var created struct {
+		ID int `json:"id"`
+	}
+	header := e.call("POST", e.vehicleURLf("/api/v2/rentals"),
+		map[string]int{"user_id": userID, "vehicle_id": 4, "hours": 2}, http.StatusCreated, &created)
+	if want := fmt.Sprintf("/api/v2/rentals/%d", created.ID); header.Get("Location") != want {
+		t.Fatalf("got Location %q, want %q", header.Get("Location"), want)
+	}
+	if header.Get("Deprecation") != "" {
+		t.Fatalf("v2 route answered with Deprecation %q", header.Get("Deprecation"))
+	}
+
+	var rental struct {
+		UserID int    `json:"user_id"`
+		Status string `json:"status"`
+	}
+	e.call("GET", e.vehicleURLf("/api/v2/rentals/%d", created.ID), nil, http.StatusOK, &rental)
+	if rental.UserID != userID || rental.Status != "active" {
+		t.Fatalf("got rental %+v, want an active rental of user %d", rental, userID)
+	}
+	e.fail("GET", e.vehicleURLf("/api/v2/rentals/%d", created.ID+1), nil, http.StatusNotFound, "not_found")
+
+	// Return it; a finished rental can no longer be acted on
+	e.clock.Advance(time.Hour)
+	var completed struct {
+		Invoice invoiceResponse `json:"invoice"`
+	}
+	e.call("POST", e.vehicleURLf("/api/v2/rentals/%d/completion", created.ID), nil, http.StatusOK, &completed)
+	e.assertRow("completed", "SELECT status FROM rentals WHERE id = ?", created.ID)
+	e.fail("POST", e.vehicleURLf("/api/v2/rentals/%d/cancellation", created.ID), nil,
+		http.StatusConflict, "no_active_rental")
+
+	var invoices struct {
+		Data []invoiceResponse `json:"data"`
+	}
+	e.call("GET", e.billingURLf("/api/v2/users/%d/invoices?paid=false", userID), nil, http.StatusOK, &invoices)
+	if len(invoices.Data) != 1 || invoices.Data[0].ID != completed.Invoice.ID {
+		t.Fatalf("got unpaid invoices %+v, want invoice %d", invoices.Data, completed.Invoice.ID)
+	}
+
+	// Paying records a payment
+	e.call("POST", e.billingURLf("/api/v2/invoices/%d/payments", completed.Invoice.ID),
+		map[string]int{"user_id": userID}, http.StatusCreated, nil)
+	e.assertRow("1", "SELECT paid_status FROM invoices WHERE id = ?", completed.Invoice.ID)
+}
+
+func TestV1RoutesAreDeprecated(t *testing.T) {
+	e := newEnv(t)
+	userID := e.renter("Erin", "erin@example.com")
+
+	// v1 keeps its status codes and names the v2 route it maps to
+	var created struct {
+		ID int `json:"id"`
+	}
+	header := e.call("POST", e.vehicleURLf("/vehicles/create-rental?user_id=%d", userID),
+		map[string]int{"vehicle_id": 5, "hours": 1}, http.StatusOK, &created)
+	if header.Get("Deprecation") == "" {
+		t.Fatal("v1 route answered without a Deprecation header")
+	}
+	if header.Get("Location") != "" {
+		t.Fatalf("v1 route answered with Location %q", header.Get("Location"))
+	}
+
+	header = e.call("POST", e.vehicleURLf("/vehicles/cancel-rental?user_id=%d", userID), nil, http.StatusOK, nil)
+	if want := fmt.Sprintf(`</api/v2/rentals/%d/cancellation>; rel="successor-version"`, created.ID); header.Get("Link") != want {
+		t.Fatalf("got Link %q, want %q", header.Get("Link"), want)
+	}
+	e.assertRow("cancelled", "SELECT status FROM rentals WHERE id = ?", created.ID)
+	e.fail("POST", e.vehicleURLf("/vehicles/cancel-rental?user_id=%d", userID), nil,
+		http.StatusNotFound, "no_active_rental")
+
+	header = e.call("GET", e.userURLf("/view-details?user_id=%d", userID), nil, http.StatusOK, nil)
+	if want := fmt.Sprintf(`</api/v2/users/%d>; rel="successor-version"`, userID); header.Get("Link") != want {
+		t.Fatalf("got Link %q, want %q", header.Get("Link"), want)
+	}
+}
+
+func TestExtendingKeepsTheRentingRules(t *testing.T) {
+	e := newEnv(t)
+	userID := e.renter("Leo", "leo@example.com")
+	var created struct {
+		ID int `json:"id"`
+	}
+	e.call("POST", e.vehicleURLf("/api/v2/rentals"),
+		map[string]int{"user_id": userID, "vehicle_id": 2, "hours": 2}, http.StatusCreated, &created)
+	extension := e.vehicleURLf("/api/v2/rentals/%d/extension", created.ID)
+
+	// The licence runs out tomorrow: a few more hours are fine, two more days are not
+	if _, err := e.db.Exec("UPDATE driver_licences SET expiry_date = '2030-03-05' WHERE user_id = ?", userID); err != nil {
+		t.Fatal(err)
+	}
+	e.call("POST", extension, map[string]int{"hours": 1}, http.StatusOK, nil)
+	e.fail("POST", extension, map[string]int{"hours": 48}, http.StatusForbidden, "licence_required")
+
+	// A suspended account cannot extend either
+	data, _ := json.Marshal(map[string]string{"status": "suspended", "reason": "Chargeback under review"})
+	req, err := http.NewRequest("PUT", e.userURLf("/api/v2/admin/users/%d/status", userID), bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Admin-Token", adminToken)
+	e.send(req, http.StatusOK, nil)
+	e.fail("POST", extension, map[string]int{"hours": 1}, http.StatusForbidden, "account_inactive")
+	e.assertRow("2030-03-04 12:00:00", "SELECT end_date FROM rentals WHERE id = ?", created.ID)
+}
@@ -0,0 +1,9 @@
+// Package e2e drives the three services end to end. Its tests boot the user, vehicle and billing
+// routers against a disposable in-process MySQL-compatible database, migrated from scratch for
+// every test, with each service connecting through its own account as in production. Rental
+// times come from a fake clock, so overtime and the cancellation window are tested without
+// waiting.
+//
+// The tests replace package-level settings in the handler packages, so they must not run in
+// parallel.
+package e2e
@@ -0,0 +1,459 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"electric-car-sharing/api"
+	"electric-car-sharing/schema"
+	billing_handlers "electric-car-sharing/services/billing-service/handlers"
+	billing_repository "electric-car-sharing/services/billing-service/repository"
+	"electric-car-sharing/services/common/clock"
+	"electric-car-sharing/services/common/database"
+	"electric-car-sharing/services/common/httpserver"
+	"electric-car-sharing/services/common/idempotency"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/metrics"
+	"electric-car-sharing/services/common/migrate"
+	"electric-car-sharing/services/common/openapi"
+	user_handlers "electric-car-sharing/services/user-service/handlers"
+	"electric-car-sharing/services/user-service/loginguard"
+	"electric-car-sharing/services/user-service/mailer"
+	user_repository "electric-car-sharing/services/user-service/repository"
+	"electric-car-sharing/services/user-service/tokens"
+	vehicle_handlers "electric-car-sharing/services/vehicle-service/handlers"
+	vehicle_repository "electric-car-sharing/services/vehicle-service/repository"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/server"
+	gmssql "github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/gorilla/mux"
+)
+
+const (
+	databaseName  = "electric_car_sharing"
+	internalToken = "e2e-internal-token"
+	adminToken    = "e2e-admin-token"
+)
+
+// start is where the fake clock begins: a weekday morning far enough ahead that licences
+// checked against the real clock are still valid
+var start = time.Date(2030, time.March, 4, 9, 0, 0, 0, time.UTC)
+
+// outbox keeps every email the user service sends
+type outbox struct {
+	mu       sync.Mutex
+	messages []mailer.Message
+}
+
+func (o *outbox) Send(msg mailer.Message) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.messages = append(o.messages, msg)
+	return nil
+}
+
+var verificationCode = regexp.MustCompile(`Verification code: (\S+)`)
+
+// verificationToken returns the token from the last verification email sent to address
+func (o *outbox) verificationToken(t *testing.T, address string) string {
+	t.Helper()
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for i := len(o.messages) - 1; i >= 0; i-- {
+		msg := o.messages[i]
+		if msg.To != address {
+			continue
+		}
+		if match := verificationCode.FindStringSubmatch(msg.Body); match != nil {
+			return match[1]
+		}
+	}
+	t.Fatalf("no verification email sent to %s", address)
+	return ""
+}
+
+// env is one running copy of the system: a fresh database and the three services
+type env struct {
+	t     *testing.T
+	clock *clock.Fake
+	mail  *outbox
+	// db is the administrative connection the tests use to inspect every table
+	db *sql.DB
+
+	userURL, vehicleURL, billingURL string
+}
+
+// newEnv migrates a new database, loads the sample fleet and starts the three services
+func newEnv(t *testing.T) *env {
+	t.Helper()
+	addr := startDatabase(t)
+
+	// The tests read times as the raw strings stored, to check they are UTC
+	root := openDB(t, database.Open, "root", "", addr)
+	migrations, err := schema.Migrations()
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	seeds, err := schema.Seeds()
+	if err != nil {
+		t.Fatalf("load seeds: %v", err)
+	}
+	migrator := migrate.New(root, migrations)
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	if err := migrator.Seed(context.Background(), seeds); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+
+	e := &env{
+		t:     t,
+		clock: clock.NewFake(start),
+		mail:  &outbox{},
+		db:    root,
+	}
+	e.setGlobals(t)
+
+	// The servers start before any routes exist so each service's clients know the others' URLs
+	userRouter, vehicleRouter, billingRouter := httpserver.NewRouter(), httpserver.NewRouter(), httpserver.NewRouter()
+	userServer := httptest.NewServer(userRouter)
+	vehicleServer := httptest.NewServer(vehicleRouter)
+	billingServer := httptest.NewServer(billingRouter)
+	t.Cleanup(userServer.Close)
+	t.Cleanup(vehicleServer.Close)
+	t.Cleanup(billingServer.Close)
+	e.userURL, e.vehicleURL, e.billingURL = userServer.URL, vehicleServer.URL, billingServer.URL
+
+	users := internalapi.NewUsers(e.userURL, internalToken)
+	vehicles := internalapi.NewVehicles(e.vehicleURL, internalToken)
+	billing := internalapi.NewBilling(e.billingURL, internalToken)
+
+	userDB := openDB(t, database.Open, "user_service", "user_service_password", addr)
+	userStore := user_repository.NewMySQL(userDB)
+	user_handlers.Register(userRouter, user_handlers.Dependencies{
+		DB:            userDB,
+		Users:         userStore.Users(),
+		Memberships:   userStore.Memberships(),
+		Mailer:        e.mail,
+		Signer:        tokens.NewSigner([]byte("e2e-token-secret"), e.clock),
+		Guard:         loginguard.New(loginguard.DefaultPolicy, e.clock),
+		Vehicles:      vehicles,
+		Billing:       billing,
+		InternalToken: internalToken,
+	})
+	metrics.Install(userRouter, "user", userDB)
+	installOpenAPI(t, userRouter, api.User)
+	idempotency.Install(userRouter, idempotency.NewMySQL(userDB, "user_idempotency_keys"), e.clock, idempotency.RequestUser)
+
+	vehicleDB := openDB(t, database.OpenUTC, "vehicle_service", "vehicle_service_password", addr)
+	vehicleStore := vehicle_repository.NewMySQL(vehicleDB)
+	vehicle_handlers.Register(vehicleRouter, vehicleStore, vehicleStore, users, billing, e.clock, internalToken)
+	metrics.Install(vehicleRouter, "vehicle", vehicleDB)
+	installOpenAPI(t, vehicleRouter, api.Vehicle)
+	idempotency.Install(vehicleRouter, idempotency.NewMySQL(vehicleDB, "vehicle_idempotency_keys"), e.clock,
+		vehicle_handlers.IdempotencyUser(vehicleStore))
+
+	billingDB := openDB(t, database.OpenUTC, "billing_service", "billing_service_password", addr)
+	billing_handlers.Register(billingRouter, billing_repository.NewMySQL(billingDB), users, vehicles, e.clock, internalToken)
+	metrics.Install(billingRouter, "billing", billingDB)
+	installOpenAPI(t, billingRouter, api.Billing)
+	idempotency.Install(billingRouter, idempotency.NewMySQL(billingDB, "billing_idempotency_keys"), e.clock, idempotency.RequestUser)
+
+	return e
+}
+
+// installOpenAPI serves document on router and validates requests against it, as the services do
+func installOpenAPI(t *testing.T, router *mux.Router, document []byte) {
+	t.Helper()
+	if err := openapi.Install(router, document); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// setGlobals points the user handlers' settings at this environment and restores them when the
+// test ends
+func (e *env) setGlobals(t *testing.T) {
+	admin, uploads := user_handlers.AdminToken, user_handlers.LicenceUploadDir
+	t.Cleanup(func() {
+		user_handlers.AdminToken, user_handlers.LicenceUploadDir = admin, uploads
+	})
+	user_handlers.AdminToken = adminToken
+	user_handlers.LicenceUploadDir = t.TempDir()
+}
+
+// startDatabase runs an in-memory MySQL-compatible server on a free local port until the test
+// ends, with account management enabled so the service accounts' grants are enforced
+func startDatabase(t *testing.T) string {
+	t.Helper()
+	db := memory.NewDatabase(databaseName)
+	db.EnablePrimaryKeyIndexes()
+	provider := memory.NewDBProvider(db)
+	engine := sqle.NewDefault(provider)
+	accounts := engine.Analyzer.Catalog.MySQLDb
+	accounts.AddRootAccount()
+	accounts.SetPersister(&mysql_db.NoopPersister{})
+	accounts.SetEnabled(true)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	cfg := server.Config{Protocol: "tcp", Listener: listener}
+	srv, err := server.NewServer(cfg, engine, gmssql.NewContext, memory.NewSessionBuilder(provider), nil)
+	if err != nil {
+		t.Fatalf("start database: %v", err)
+	}
+	go srv.Start()
+	t.Cleanup(func() { srv.Close() })
+	return listener.Addr().String()
+}
+
+// openDB connects to the test database as the given account, with the function the service
+// itself opens its database with
+func openDB(t *testing.T, open func(string) (*sql.DB, error), user, password, addr string) *sql.DB {
+	t.Helper()
+	dsn := fmt.Sprintf("%s:%s@tcp(%s)/%s", user, password, addr, databaseName)
+	db, err := open(dsn)
+	if err != nil {
+		t.Fatalf("connect as %s: %v", user, err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// call sends a request with a JSON body, unless body is nil, and checks the status code. The
+// response is decoded into out when it is not nil, and its headers are returned.
+func (e *env) call(method, url string, body interface{}, wantStatus int, out interface{}) http.Header {
+	e.t.Helper()
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			e.t.Fatalf("encode %s %s: %v", method, url, err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		e.t.Fatalf("build %s %s: %v", method, url, err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return e.send(req, wantStatus, out)
+}
+
+// send performs req, failing the test unless it gets wantStatus, and returns the response headers
+func (e *env) send(req *http.Request, wantStatus int, out interface{}) http.Header {
+	e.t.Helper()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		e.t.Fatalf("%s %s: %v", req.Method, req.URL, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		e.t.Fatalf("read %s %s: %v", req.Method, req.URL, err)
+	}
+	if resp.StatusCode != wantStatus {
+		e.t.Fatalf("%s %s: got status %d, want %d: %s", req.Method, req.URL.Path, resp.StatusCode, wantStatus, data)
+	}
+	if out != nil {
+		if err := json.Unmarshal(data, out); err != nil {
+			e.t.Fatalf("decode %s %s: %v: %s", req.Method, req.URL.Path, err, data)
+		}
+	}
+	return resp.Header
+}
+
+// errorCode is the machine-readable code of an error response
+type errorCode struct {
+	Error struct {
+		Code string `json:"code"`
+	} `json:"error"`
+}
+
+// fail sends a request that must fail with wantStatus and the error code wantCode
+func (e *env) fail(method, url string, body interface{}, wantStatus int, wantCode string) {
+	e.t.Helper()
+	var resp errorCode
+	e.call(method, url, body, wantStatus, &resp)
+	if resp.Error.Code != wantCode {
+		e.t.Fatalf("%s %s: got error code %q, want %q", method, url, resp.Error.Code, wantCode)
+	}
+}
+
+// userURLf, vehicleURLf and billingURLf build a URL on the service from a format string
+func (e *env) userURLf(format string, args ...interface{}) string {
+	return e.userURL + fmt.Sprintf(format, args...)
+}
+
+func (e *env) vehicleURLf(format string, args ...interface{}) string {
+	return e.vehicleURL + fmt.Sprintf(format, args...)
+}
+
+func (e *env) billingURLf(format string, args ...interface{}) string {
+	return e.billingURL + fmt.Sprintf(format, args...)
+}
+
+// trackedTables are the tables compared at every step; the ones no flow writes to are checked
+// to stay empty
+var trackedTables = []string{
+	"users", "user_details", "user_tokens", "failed_logins", "user_totp", "user_recovery_codes",
+	"driver_licences", "account_status_audit", "account_events", "rentals", "invoices",
+	"user_idempotency_keys", "vehicle_idempotency_keys", "billing_idempotency_keys",
+}
+
+// state is the expected row count of each tracked table, zero when left out, plus the number of
+// vehicles still available to rent
+type state struct {
+	rows      map[string]int
+	available int
+}
+
+// assertState checks the row count of every tracked table and the fleet's availability
+func (e *env) assertState(step string, want state) {
+	e.t.Helper()
+	for _, table := range trackedTables {
+		if got := e.count("SELECT COUNT(*) FROM " + table); got != want.rows[table] {
+			e.t.Errorf("%s: %s has %d rows, want %d", step, table, got, want.rows[table])
+		}
+	}
+	if got := e.count("SELECT COUNT(*) FROM vehicles WHERE available = TRUE"); got != want.available {
+		e.t.Errorf("%s: %d vehicles available, want %d", step, got, want.available)
+	}
+	if e.t.Failed() {
+		e.t.FailNow()
+	}
+}
+
+// count runs a query selecting a single number
+func (e *env) count(query string, args ...interface{}) int {
+	e.t.Helper()
+	var n int
+	if err := e.db.QueryRow(query, args...).Scan(&n); err != nil {
+		e.t.Fatalf("%s: %v", query, err)
+	}
+	return n
+}
+
+// assertRow checks the single row a query selects, with its columns joined by "|" and NULL
+// shown as "NULL"
+func (e *env) assertRow(want, query string, args ...interface{}) {
+	e.t.Helper()
+	rows, err := e.db.Query(query, args...)
+	if err != nil {
+		e.t.Fatalf("%s: %v", query, err)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		e.t.Fatalf("%s: %v", query, err)
+	}
+	if !rows.Next() {
+		e.t.Fatalf("%s: no rows", query)
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		e.t.Fatalf("%s: %v", query, err)
+	}
+	got := make([]string, len(values))
+	for i, v := range values {
+		got[i] = "NULL"
+		if v.Valid {
+			got[i] = v.String
+		}
+	}
+	if strings.Join(got, "|") != want {
+		e.t.Fatalf("%s:\ngot  %s\nwant %s", query, strings.Join(got, "|"), want)
+	}
+}
+
+// pngImage is the smallest upload the user service accepts as a licence image
+var pngImage = append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 64)...)
+
+// submitLicence uploads a licence for the user and returns its ID
+func (e *env) submitLicence(userID int, number string) int {
+	e.t.Helper()
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	form.WriteField("licence_number", number)
+	form.WriteField("issuing_country", "SG")
+	form.WriteField("expiry_date", "2099-12-31")
+	image, err := form.CreateFormFile("image", "licence.png")
+	if err != nil {
+		e.t.Fatalf("build licence form: %v", err)
+	}
+	image.Write(pngImage)
+	form.Close()
+
+	req, err := http.NewRequest("POST", e.userURLf("/licence?user_id=%d", userID), &body)
+	if err != nil {
+		e.t.Fatalf("build licence request: %v", err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	var resp struct {
+		LicenceID int `json:"licence_id"`
+	}
+	e.send(req, http.StatusCreated, &resp)
+	return resp.LicenceID
+}
+
+// approveLicence approves a pending licence as an operator
+func (e *env) approveLicence(licenceID int) {
+	e.t.Helper()
+	data, _ := json.Marshal(map[string]interface{}{"licence_id": licenceID, "decision": "approve"})
+	req, err := http.NewRequest("POST", e.userURLf("/admin/licences/review"), bytes.NewReader(data))
+	if err != nil {
+		e.t.Fatalf("build review request: %v", err)
+	}
+	req.Header.Set("X-Admin-Token", adminToken)
+	e.send(req, http.StatusOK, nil)
+}
+
+// signUp creates a user, verifies their email and logs in, returning the user ID
+func (e *env) signUp(name, email string) int {
+	e.t.Helper()
+	password := "correct horse battery staple"
+	e.call("POST", e.userURLf("/create-user"), map[string]string{
+		"name": name, "email": email, "password": password,
+	}, http.StatusCreated, nil)
+	e.call("POST", e.userURLf("/verify-email"), map[string]string{
+		"token": e.mail.verificationToken(e.t, email),
+	}, http.StatusOK, nil)
+	var login struct {
+		UserID int `json:"user_id"`
+	}
+	e.call("POST", e.userURLf("/login"), map[string]string{
+		"email": email, "password": password,
+	}, http.StatusOK, &login)
+	return login.UserID
+}
+
+// renter signs up a user with an approved licence, ready to rent
+func (e *env) renter(name, email string) int {
+	e.t.Helper()
+	userID := e.signUp(name, email)
+	e.approveLicence(e.submitLicence(userID, fmt.Sprintf("S%07dA", userID)))
+	return userID
+}
@@ -0,0 +1,151 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"electric-car-sharing/services/common/idempotency"
+)
+
+// callWithKey is call with an Idempotency-Key header
+func (e *env) callWithKey(key, method, url string, body interface{}, wantStatus int, out interface{}) http.Header {
+	e.t.Helper()
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			e.t.Fatalf("encode %s %s: %v", method, url, err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		e.t.Fatalf("build %s %s: %v", method, url, err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Idempotency-Key", key)
+	return e.send(req, wantStatus, out)
+}
+
+// failWithKey is fail with an Idempotency-Key header
+func (e *env) failWithKey(key, method, url string, body interface{}, wantStatus int, wantCode string) {
+	e.t.Helper()
+	var resp errorCode
+	e.callWithKey(key, method, url, body, wantStatus, &resp)
+	if resp.Error.Code != wantCode {
+		e.t.Fatalf("%s %s: got error code %q, want %q", method, url, resp.Error.Code, wantCode)
+	}
+}
+
+func assertReplayed(t *testing.T, what string, header http.Header, want bool) {
+	t.Helper()
+	if got := header.Get("Idempotent-Replayed") == "true"; got != want {
+		t.Fatalf("%s: got Idempotent-Replayed %q, want replayed %v", what, header.Get("Idempotent-Replayed"), want)
+	}
+}
+
+func TestRetriedRentalAndPaymentAreHandledOnce(t *testing.T) {
+	e := newEnv(t)
+	userID := e.renter("Heidi", "heidi@example.com")
+
+	// Renting twice with one key starts one rental and answers both times with it
+	rent := map[string]int{"user_id": userID, "vehicle_id": 1, "hours": 2}
+	var first, retried struct {
+		ID int `json:"id"`
+	}
+	header := e.callWithKey("rent-1", "POST", e.vehicleURLf("/api/v2/rentals"), rent, http.StatusCreated, &first)
+	assertReplayed(t, "first rental", header, false)
+	header = e.callWithKey("rent-1", "POST", e.vehicleURLf("/api/v2/rentals"), rent, http.StatusCreated, &retried)
+	assertReplayed(t, "retried rental", header, true)
+	if retried.ID != first.ID || header.Get("Location") == "" {
+		t.Fatalf("retry answered rental %d with Location %q, want rental %d and its Location", retried.ID, header.Get("Location"), first.ID)
+	}
+	e.assertRow("1", "SELECT COUNT(*) FROM rentals WHERE user_id = ?", userID)
+
+	// The key only stands for that request
+	e.failWithKey("rent-1", "POST", e.vehicleURLf("/api/v2/rentals"),
+		map[string]int{"user_id": userID, "vehicle_id": 2, "hours": 2}, http.StatusUnprocessableEntity, "idempotency_key_reused")
+	e.fail("POST", e.vehicleURLf("/api/v2/rentals"), rent, http.StatusConflict, "rental_in_progress")
+
+	// Keys are kept per user, so another user's key does not clash
+	otherID := e.renter("Ivan", "ivan@example.com")
+	header = e.callWithKey("rent-1", "POST", e.vehicleURLf("/api/v2/rentals"),
+		map[string]int{"user_id": otherID, "vehicle_id": 2, "hours": 1}, http.StatusCreated, nil)
+	assertReplayed(t, "another user's rental", header, false)
+
+	// Completing twice issues one invoice, where a plain retry would find no active rental
+	e.clock.Advance(time.Hour)
+	var completed, completedAgain struct {
+		Invoice invoiceResponse `json:"invoice"`
+	}
+	completion := e.vehicleURLf("/api/v2/rentals/%d/completion", first.ID)
+	e.callWithKey("complete-1", "POST", completion, nil, http.StatusOK, &completed)
+	header = e.callWithKey("complete-1", "POST", completion, nil, http.StatusOK, &completedAgain)
+	assertReplayed(t, "retried completion", header, true)
+	if completedAgain.Invoice.ID != completed.Invoice.ID {
+		t.Fatalf("retry answered invoice %d, want %d", completedAgain.Invoice.ID, completed.Invoice.ID)
+	}
+	e.assertRow("1", "SELECT COUNT(*) FROM invoices WHERE user_id = ?", userID)
+	e.fail("POST", completion, nil, http.StatusConflict, "no_active_rental")
+
+	// Paying twice pays once, and the retry gets the first answer
+	payment := e.billingURLf("/api/v2/invoices/%d/payments", completed.Invoice.ID)
+	e.callWithKey("pay-1", "POST", payment, map[string]int{"user_id": userID}, http.StatusCreated, nil)
+	header = e.callWithKey("pay-1", "POST", payment, map[string]int{"user_id": userID}, http.StatusCreated, nil)
+	assertReplayed(t, "retried payment", header, true)
+	e.assertRow("1", "SELECT paid_status FROM invoices WHERE id = ?", completed.Invoice.ID)
+
+	e.assertRow("3", "SELECT COUNT(*) FROM vehicle_idempotency_keys")
+	e.assertRow("1", "SELECT COUNT(*) FROM billing_idempotency_keys")
+	e.assertRow("0", "SELECT COUNT(*) FROM user_idempotency_keys")
+
+	// The purge job clears the records once their TTL has passed
+	keys := idempotency.NewMySQL(e.db, "vehicle_idempotency_keys")
+	if purged, err := keys.Purge(context.Background(), e.clock.Now().Add(-idempotency.TTL)); err != nil || purged != 0 {
+		t.Fatalf("purged %d records within their TTL, %v", purged, err)
+	}
+	if purged, err := keys.Purge(context.Background(), e.clock.Now().Add(time.Hour)); err != nil || purged != 3 {
+		t.Fatalf("got %d records purged, %v; want 3", purged, err)
+	}
+}
+
+func TestIdempotencyKeysOnV1AndWithheldResponses(t *testing.T) {
+	e := newEnv(t)
+	userID := e.renter("Judy", "judy@example.com")
+	e.call("POST", e.vehicleURLf("/api/v2/rentals"),
+		map[string]int{"user_id": userID, "vehicle_id": 3, "hours": 1}, http.StatusCreated, nil)
+
+	// A v1 retry is answered with the v1 response, headers included, even though the rental it
+	// acted on is no longer active
+	complete := e.vehicleURLf("/vehicles/complete-rental?user_id=%d", userID)
+	e.callWithKey("v1-complete", "POST", complete, nil, http.StatusOK, nil)
+	header := e.callWithKey("v1-complete", "POST", complete, nil, http.StatusOK, nil)
+	assertReplayed(t, "retried v1 completion", header, true)
+	if header.Get("Deprecation") == "" || header.Get("Link") == "" {
+		t.Fatalf("replayed v1 response lost its Deprecation %q and Link %q headers", header.Get("Deprecation"), header.Get("Link"))
+	}
+
+	// Secrets are shown once: the enrolment is not kept, so a retry is refused rather than replayed
+	enroll := e.userURLf("/api/v2/users/%d/two-factor", userID)
+	password := map[string]string{"password": "correct horse battery staple"}
+	var enrolled struct {
+		Secret string `json:"secret"`
+	}
+	e.callWithKey("enroll-1", "POST", enroll, password, http.StatusOK, &enrolled)
+	if enrolled.Secret == "" {
+		t.Fatal("enrolment answered without a secret")
+	}
+	e.failWithKey("enroll-1", "POST", enroll, password, http.StatusConflict, "conflict")
+	e.assertRow("0", "SELECT COUNT(*) FROM user_idempotency_keys WHERE response_body IS NOT NULL")
+
+	// Keys must be short printable ASCII
+	e.failWithKey(strings.Repeat("k", 256), "POST", enroll, password, http.StatusBadRequest, "invalid_request")
+}
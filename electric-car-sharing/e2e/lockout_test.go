@@ -0,0 +1,80 @@
+package e2e
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/user-service/loginguard"
+	"electric-car-sharing/services/user-service/totp"
+)
+
+func TestPasswordChecksShareTheLoginLockout(t *testing.T) {
+	e := newEnv(t)
+	userID := e.signUp("Noor", "noor@example.com")
+	emailChange := e.userURLf("/api/v2/users/%d/email-change", userID)
+	wrong := map[string]string{"password": "wrong", "new_email": "noor@example.org"}
+
+	// Wrong passwords are allowed a few times, then have to wait out the backoff
+	for i := 0; i < loginguard.DefaultPolicy.FreeAttempts; i++ {
+		e.fail("POST", emailChange, wrong, http.StatusUnauthorized, apierror.CodeInvalidCredentials)
+	}
+	e.fail("POST", emailChange, wrong, http.StatusTooManyRequests, apierror.CodeRateLimited)
+	e.assertRow("bad_password bad_password bad_password throttled",
+		"SELECT GROUP_CONCAT(reason ORDER BY id SEPARATOR ' ') FROM failed_logins WHERE user_id = ?", userID)
+
+	// Deleting the account counts towards the same lockout, which then stops logins too
+	deletion := e.userURLf("/api/v2/users/%d", userID)
+	for i := loginguard.DefaultPolicy.FreeAttempts; i < loginguard.DefaultPolicy.LockoutThreshold; i++ {
+		e.clock.Advance(loginguard.DefaultPolicy.MaxBackoff)
+		e.fail("DELETE", deletion, map[string]string{"password": "wrong"}, http.StatusUnauthorized, apierror.CodeInvalidCredentials)
+	}
+	e.clock.Advance(time.Minute)
+	e.fail("POST", e.userURLf("/login"), map[string]string{
+		"email": "noor@example.com", "password": "correct horse battery staple",
+	}, http.StatusLocked, apierror.CodeAccountLocked)
+
+	e.mail.mu.Lock()
+	defer e.mail.mu.Unlock()
+	last := e.mail.messages[len(e.mail.messages)-1]
+	if last.To != "noor@example.com" || !strings.Contains(last.Subject, "locked") {
+		t.Errorf("last email %q to %s, want the unlock email", last.Subject, last.To)
+	}
+}
+
+func TestTwoFactorCodesAreThrottled(t *testing.T) {
+	e := newEnv(t)
+	userID := e.signUp("Ravi", "ravi@example.com")
+	password := "correct horse battery staple"
+	var enrolment struct {
+		Secret string `json:"secret"`
+	}
+	e.call("POST", e.userURLf("/api/v2/users/%d/two-factor", userID), map[string]string{"password": password}, http.StatusOK, &enrolment)
+
+	// Guessing the confirmation code is held back like guessing a password
+	confirm := e.userURLf("/api/v2/users/%d/two-factor/confirmation", userID)
+	for i := 0; i < loginguard.DefaultPolicy.FreeAttempts; i++ {
+		e.fail("POST", confirm, map[string]string{"code": "000000"}, http.StatusUnauthorized, apierror.CodeInvalidCode)
+	}
+	code, err := totp.Code(enrolment.Secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.fail("POST", confirm, map[string]string{"code": code}, http.StatusTooManyRequests, apierror.CodeRateLimited)
+	e.clock.Advance(time.Minute)
+	e.call("POST", confirm, map[string]string{"code": code}, http.StatusOK, nil)
+
+	// Replacing the recovery codes needs the password as well as a code, and wrong codes count
+	regenerate := e.userURLf("/api/v2/users/%d/two-factor/recovery-codes", userID)
+	e.fail("POST", regenerate, map[string]string{"code": code}, http.StatusBadRequest, apierror.CodeInvalidRequest)
+	for i := 0; i < loginguard.DefaultPolicy.FreeAttempts; i++ {
+		e.fail("POST", regenerate, map[string]string{"password": password, "code": "000000"}, http.StatusUnauthorized, apierror.CodeInvalidCode)
+	}
+	// and hold back disabling two-factor too
+	e.fail("DELETE", e.userURLf("/api/v2/users/%d/two-factor", userID), map[string]string{"password": password, "code": "000000"},
+		http.StatusTooManyRequests, apierror.CodeRateLimited)
+	e.assertRow("bad_2fa_code bad_2fa_code bad_2fa_code throttled bad_2fa_code bad_2fa_code bad_2fa_code throttled",
+		"SELECT GROUP_CONCAT(reason ORDER BY id SEPARATOR ' ') FROM failed_logins WHERE user_id = ?", userID)
+}
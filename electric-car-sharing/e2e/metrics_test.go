@@ -0,0 +1,111 @@
+package e2e
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// scrape fetches a service's /metrics and returns every sample by series, such as
+// carshare_rentals_total{event="created"}
+func (e *env) scrape(baseURL string) map[string]float64 {
+	e.t.Helper()
+	resp, err := http.Get(baseURL + "/metrics")
+	if err != nil {
+		e.t.Fatalf("scrape %s: %v", baseURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		e.t.Fatalf("scrape %s: got status %d", baseURL, resp.StatusCode)
+	}
+
+	samples := map[string]float64{}
+	lines := bufio.NewScanner(resp.Body)
+	for lines.Scan() {
+		line := lines.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		i := strings.LastIndexByte(line, ' ')
+		value, err := strconv.ParseFloat(line[i+1:], 64)
+		if err != nil {
+			e.t.Fatalf("scrape %s: bad sample %q", baseURL, line)
+		}
+		samples[line[:i]] = value
+	}
+	if err := lines.Err(); err != nil {
+		e.t.Fatalf("scrape %s: %v", baseURL, err)
+	}
+	return samples
+}
+
+// assertMoved checks how far each series moved between two scrapes. The counters are shared by
+// every test in the process, so only the difference is meaningful.
+func assertMoved(t *testing.T, before, after map[string]float64, want map[string]float64) {
+	t.Helper()
+	for series, delta := range want {
+		if got := after[series] - before[series]; got != delta {
+			t.Errorf("%s moved by %v, want %v", series, got, delta)
+		}
+	}
+}
+
+// requests names the series counting a service's responses on a route with a status
+func requests(service, method, route string, status int) string {
+	return fmt.Sprintf(`carshare_http_request_duration_seconds_count{method=%q,route=%q,service=%q,status="%d"}`,
+		method, route, service, status)
+}
+
+func TestMetricsCountRentalsAndPayments(t *testing.T) {
+	e := newEnv(t)
+	userID := e.renter("Dave", "dave@example.com")
+	vehicleBefore, billingBefore := e.scrape(e.vehicleURL), e.scrape(e.billingURL)
+
+	// One rental cancelled, one returned two and a half hours late
+	e.call("POST", e.vehicleURLf("/vehicles/create-rental?user_id=%d", userID),
+		map[string]int{"vehicle_id": 2, "hours": 2}, http.StatusOK, nil)
+	e.call("POST", e.vehicleURLf("/vehicles/cancel-rental?user_id=%d", userID), nil, http.StatusOK, nil)
+	e.call("POST", e.vehicleURLf("/vehicles/create-rental?user_id=%d", userID),
+		map[string]int{"vehicle_id": 2, "hours": 2}, http.StatusOK, nil)
+	e.clock.Advance(4*time.Hour + 30*time.Minute)
+	var completed struct {
+		Invoice invoiceResponse `json:"invoice"`
+	}
+	e.call("POST", e.vehicleURLf("/vehicles/complete-rental?user_id=%d", userID), nil, http.StatusOK, &completed)
+
+	// A bad request, an unknown invoice, the real payment and a second payment of the same invoice
+	payURL := e.billingURLf("/billing/pay-invoice?userid=%d", userID)
+	e.fail("POST", payURL, map[string]int{"invoice_id": 0}, http.StatusBadRequest, "invalid_request")
+	e.fail("POST", payURL, map[string]int{"invoice_id": 9999}, http.StatusNotFound, "not_found")
+	e.call("POST", payURL, map[string]int{"invoice_id": completed.Invoice.ID}, http.StatusOK, nil)
+	e.fail("POST", payURL, map[string]int{"invoice_id": completed.Invoice.ID}, http.StatusNotFound, "not_found")
+
+	vehicleAfter, billingAfter := e.scrape(e.vehicleURL), e.scrape(e.billingURL)
+	assertMoved(t, vehicleBefore, vehicleAfter, map[string]float64{
+		`carshare_rentals_total{event="created"}`:                   2,
+		`carshare_rentals_total{event="cancelled"}`:                 1,
+		`carshare_rentals_total{event="completed"}`:                 1,
+		`carshare_rental_overtime_hours_total`:                      2,
+		requests("vehicle", "POST", "/vehicles/create-rental", 200): 2,
+	})
+	assertMoved(t, billingBefore, billingAfter, map[string]float64{
+		`carshare_invoice_revenue_dollars_total`:                    100,
+		`carshare_payment_failures_total{reason="invalid_request"}`: 1,
+		`carshare_payment_failures_total{reason="not_found"}`:       2,
+		`carshare_payment_failures_total{reason="error"}`:           0,
+		requests("billing", "POST", "/internal/invoices", 201):      1,
+		requests("billing", "POST", "/billing/pay-invoice", 404):    2,
+	})
+
+	// Each service reports its own connection pool
+	for service, samples := range map[string]map[string]float64{"vehicle": vehicleAfter, "billing": billingAfter} {
+		series := `go_sql_max_open_connections{db_name="` + service + `"}`
+		if _, ok := samples[series]; !ok {
+			t.Errorf("%s service does not report %s", service, series)
+		}
+	}
+}
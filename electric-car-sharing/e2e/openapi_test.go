@@ -0,0 +1,39 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestInvalidRequestsNameTheRejectedField(t *testing.T) {
+	e := newEnv(t)
+	userID := e.renter("Lena", "lena@example.com")
+
+	for _, tc := range []struct {
+		what  string
+		url   string
+		body  interface{}
+		field string
+	}{
+		{"wrong type", e.vehicleURLf("/api/v2/rentals"),
+			map[string]interface{}{"user_id": userID, "vehicle_id": 1, "hours": "two"}, "hours"},
+		{"missing property", e.vehicleURLf("/api/v2/rentals"),
+			map[string]interface{}{"user_id": userID, "hours": 2}, "vehicle_id"},
+		{"query parameter", e.vehicleURLf("/api/v2/vehicles?user_id=%d&limit=101", userID), nil, "limit"},
+	} {
+		var resp struct {
+			Error struct {
+				Code   string            `json:"code"`
+				Fields map[string]string `json:"fields"`
+			} `json:"error"`
+		}
+		method := "POST"
+		if tc.body == nil {
+			method = "GET"
+		}
+		e.call(method, tc.url, tc.body, http.StatusBadRequest, &resp)
+		if resp.Error.Code != "invalid_request" || len(resp.Error.Fields) != 1 || resp.Error.Fields[tc.field] == "" {
+			t.Errorf("%s: got %s with fields %v, want invalid_request naming %s", tc.what, resp.Error.Code, resp.Error.Fields, tc.field)
+		}
+	}
+}
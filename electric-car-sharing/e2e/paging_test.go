@@ -0,0 +1,178 @@
+package e2e
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// page is the paging metadata of a list response
+type page struct {
+	Limit      int    `json:"limit"`
+	Sort       string `json:"sort"`
+	NextCursor string `json:"next_cursor"`
+}
+
+// ids lists the IDs of a page of items
+func ids(items []struct {
+	ID int `json:"id"`
+}) []int {
+	var list []int
+	for _, item := range items {
+		list = append(list, item.ID)
+	}
+	return list
+}
+
+// listIDs follows a list from its first page to its last, returning the IDs on each page
+func (e *env) listIDs(url string) [][]int {
+	e.t.Helper()
+	var pages [][]int
+	cursor := ""
+	for {
+		next := url
+		if cursor != "" {
+			next += "&cursor=" + cursor
+		}
+		var resp struct {
+			Data []struct {
+				ID int `json:"id"`
+			} `json:"data"`
+			Page page `json:"page"`
+		}
+		e.call("GET", next, nil, http.StatusOK, &resp)
+		pages = append(pages, ids(resp.Data))
+		if resp.Page.NextCursor == "" {
+			return pages
+		}
+		cursor = resp.Page.NextCursor
+	}
+}
+
+func assertPages(t *testing.T, what string, got, want [][]int) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: got pages %v, want %v", what, got, want)
+	}
+	for i := range got {
+		if len(got[i]) != len(want[i]) {
+			t.Fatalf("%s: got pages %v, want %v", what, got, want)
+		}
+		for j := range got[i] {
+			if got[i][j] != want[i][j] {
+				t.Fatalf("%s: got pages %v, want %v", what, got, want)
+			}
+		}
+	}
+}
+
+func TestVehicleListIsPagedFilteredAndSorted(t *testing.T) {
+	e := newEnv(t)
+	userID := e.renter("Frank", "frank@example.com")
+
+	// Basic members see vehicles 1 to 6; equal years are ordered by ID in the same direction
+	assertPages(t, "newest first", e.listIDs(e.vehicleURLf("/api/v2/vehicles?user_id=%d&sort=-year&limit=4", userID)),
+		[][]int{{5, 4, 2, 6}, {1, 3}})
+	assertPages(t, "default order", e.listIDs(e.vehicleURLf("/api/v2/vehicles?user_id=%d", userID)),
+		[][]int{{1, 2, 3, 4, 5, 6}})
+	assertPages(t, "make", e.listIDs(e.vehicleURLf("/api/v2/vehicles?user_id=%d&make=tesla", userID)),
+		[][]int{{5}})
+	assertPages(t, "year range", e.listIDs(e.vehicleURLf("/api/v2/vehicles?user_id=%d&min_year=2021&max_year=2022", userID)),
+		[][]int{{2, 4}})
+	assertPages(t, "VIP only", e.listIDs(e.vehicleURLf("/api/v2/vehicles?user_id=%d&vip=true", userID)),
+		[][]int{nil})
+	assertPages(t, "cost range", e.listIDs(e.vehicleURLf("/api/v2/vehicles?user_id=%d&max_cost=19.99", userID)),
+		[][]int{nil})
+
+	// A cursor only continues the order it was issued for
+	var first struct {
+		Page page `json:"page"`
+	}
+	e.call("GET", e.vehicleURLf("/api/v2/vehicles?user_id=%d&sort=year&limit=1", userID), nil, http.StatusOK, &first)
+	if first.Page.Limit != 1 || first.Page.Sort != "year" || first.Page.NextCursor == "" {
+		t.Fatalf("got page %+v, want limit 1 sorted by year with a next cursor", first.Page)
+	}
+	e.fail("GET", e.vehicleURLf("/api/v2/vehicles?user_id=%d&sort=make&cursor=%s", userID, first.Page.NextCursor), nil,
+		http.StatusBadRequest, "invalid_request")
+	e.fail("GET", e.vehicleURLf("/api/v2/vehicles?user_id=%d&limit=101", userID), nil, http.StatusBadRequest, "invalid_request")
+	e.fail("GET", e.vehicleURLf("/api/v2/vehicles?user_id=%d&sort=model", userID), nil, http.StatusBadRequest, "invalid_request")
+
+	// v1 still answers a bare list
+	var v1 []struct {
+		ID int `json:"id"`
+	}
+	e.call("GET", e.vehicleURLf("/vehicles/available?user_id=%d", userID), nil, http.StatusOK, &v1)
+	if len(v1) != 6 {
+		t.Fatalf("v1 listed %d vehicles, want 6", len(v1))
+	}
+}
+
+func TestRentalAndInvoiceListsArePagedFilteredAndSorted(t *testing.T) {
+	e := newEnv(t)
+	userID := e.renter("Grace", "grace@example.com")
+
+	// Three rentals an hour apart, returned on time for $20, $60 and $40; then one cancelled
+	var rentalIDs, invoiceIDs []int
+	for _, hours := range []int{1, 3, 2} {
+		var created struct {
+			ID int `json:"id"`
+		}
+		e.call("POST", e.vehicleURLf("/api/v2/rentals"),
+			map[string]int{"user_id": userID, "vehicle_id": 1, "hours": hours}, http.StatusCreated, &created)
+		e.clock.Advance(time.Hour)
+		var completed struct {
+			Invoice invoiceResponse `json:"invoice"`
+		}
+		e.call("POST", e.vehicleURLf("/api/v2/rentals/%d/completion", created.ID), nil, http.StatusOK, &completed)
+		rentalIDs = append(rentalIDs, created.ID)
+		invoiceIDs = append(invoiceIDs, completed.Invoice.ID)
+	}
+	var cancelled struct {
+		ID int `json:"id"`
+	}
+	e.call("POST", e.vehicleURLf("/api/v2/rentals"),
+		map[string]int{"user_id": userID, "vehicle_id": 2, "hours": 1}, http.StatusCreated, &cancelled)
+	e.call("POST", e.vehicleURLf("/api/v2/rentals/%d/cancellation", cancelled.ID), nil, http.StatusOK, nil)
+
+	// Rentals are listed by the user service from the vehicle service
+	assertPages(t, "rentals", e.listIDs(e.userURLf("/api/v2/users/%d/rentals?limit=3", userID)),
+		[][]int{rentalIDs, {cancelled.ID}})
+	assertPages(t, "newest rentals", e.listIDs(e.userURLf("/api/v2/users/%d/rentals?sort=-start_date&limit=2", userID)),
+		[][]int{{cancelled.ID, rentalIDs[2]}, {rentalIDs[1], rentalIDs[0]}})
+	assertPages(t, "completed rentals", e.listIDs(e.userURLf("/api/v2/users/%d/rentals?status=completed", userID)),
+		[][]int{rentalIDs})
+	from := url.QueryEscape(e.clock.Now().Add(-2 * time.Hour).Format(time.RFC3339))
+	to := url.QueryEscape(e.clock.Now().Format(time.RFC3339))
+	assertPages(t, "rentals started in a range", e.listIDs(e.userURLf("/api/v2/users/%d/rentals?from=%s&to=%s", userID, from, to)),
+		[][]int{{rentalIDs[1], rentalIDs[2]}})
+	e.fail("GET", e.userURLf("/api/v2/users/%d/rentals?cursor=nonsense", userID), nil, http.StatusBadRequest, "invalid_request")
+
+	// Invoices by amount, and by whether they are paid
+	assertPages(t, "invoices by amount", e.listIDs(e.billingURLf("/api/v2/users/%d/invoices?sort=-final_cost&limit=2", userID)),
+		[][]int{{invoiceIDs[1], invoiceIDs[2]}, {invoiceIDs[0]}})
+	assertPages(t, "invoices in an amount range", e.listIDs(e.billingURLf("/api/v2/users/%d/invoices?min_amount=30&max_amount=50", userID)),
+		[][]int{{invoiceIDs[2]}})
+	e.call("POST", e.billingURLf("/api/v2/invoices/%d/payments", invoiceIDs[0]),
+		map[string]int{"user_id": userID}, http.StatusCreated, nil)
+	assertPages(t, "unpaid invoices", e.listIDs(e.billingURLf("/api/v2/users/%d/invoices?paid=false", userID)),
+		[][]int{{invoiceIDs[1], invoiceIDs[2]}})
+	assertPages(t, "paid invoices", e.listIDs(e.billingURLf("/api/v2/users/%d/invoices?paid=true", userID)),
+		[][]int{{invoiceIDs[0]}})
+
+	// v1 keeps its bodies: unpaged lists, and the unpaidonly filter
+	var v1Invoices []invoiceResponse
+	e.call("GET", e.billingURLf("/billing/get-invoices?userid=%d&unpaidonly=true", userID), nil, http.StatusOK, &v1Invoices)
+	if len(v1Invoices) != 2 {
+		t.Fatalf("v1 listed %d unpaid invoices, want 2", len(v1Invoices))
+	}
+	var v1Rentals struct {
+		Rentals []struct {
+			ID int `json:"id"`
+		} `json:"rentals"`
+	}
+	e.call("GET", e.userURLf("/view-rentals?user_id=%d", userID), nil, http.StatusOK, &v1Rentals)
+	if len(v1Rentals.Rentals) != 4 {
+		t.Fatalf("v1 listed %d rentals, want 4", len(v1Rentals.Rentals))
+	}
+}
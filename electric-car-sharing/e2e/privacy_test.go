@@ -0,0 +1,56 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestExportAndDeletionCoverTheWholeProfile(t *testing.T) {
+	e := newEnv(t)
+	userID := e.signUp("Kim", "kim@example.com")
+
+	e.callWithKey("profile-1", "PATCH", e.userURLf("/api/v2/users/%d", userID), map[string]interface{}{
+		"address":      map[string]string{"street": "1 Raffles Place", "unit": "#10-01", "postal_code": "048616"},
+		"phone_number": "+65 9123 4567",
+		"gender":       "Other",
+		"timezone":     "Asia/Singapore",
+	}, http.StatusOK, nil)
+
+	// The export carries the structured address and the time zone
+	var export struct {
+		Profile map[string]interface{} `json:"profile"`
+	}
+	e.call("GET", e.userURLf("/api/v2/users/%d/export", userID), nil, http.StatusOK, &export)
+	for column, want := range map[string]string{
+		"address_street":      "1 Raffles Place",
+		"address_unit":        "#10-01",
+		"address_postal_code": "048616",
+		"timezone":            "Asia/Singapore",
+	} {
+		if got := export.Profile[column]; got != want {
+			t.Errorf("export has %s %v, want %q", column, got, want)
+		}
+	}
+
+	// Deleting the account leaves none of it behind
+	e.call("DELETE", e.userURLf("/api/v2/users/%d", userID),
+		map[string]string{"password": "correct horse battery staple"}, http.StatusOK, nil)
+	e.assertRow("NULL|NULL|NULL|NULL|NULL|NULL|NULL", `
+		SELECT address, address_street, address_unit, address_postal_code, phone_number, gender, timezone
+		FROM user_details WHERE id = ?`, userID)
+	// So is the stored response to the keyed update, which held the same details
+	e.assertRow("0", "SELECT COUNT(*) FROM user_idempotency_keys WHERE user_id = ?", userID)
+
+	// Deletion is final: an operator cannot reactivate the account
+	data, _ := json.Marshal(map[string]string{"status": "active"})
+	req, err := http.NewRequest("PUT", e.userURLf("/api/v2/admin/users/%d/status", userID), bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Admin-Token", adminToken)
+	e.send(req, http.StatusConflict, nil)
+	e.assertRow("closed", "SELECT status FROM users WHERE id = ?", userID)
+}
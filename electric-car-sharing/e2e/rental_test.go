@@ -0,0 +1,243 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// invoiceResponse is the part of an invoice the tests look at
+type invoiceResponse struct {
+	ID           int     `json:"id"`
+	RentalID     int     `json:"rental_id"`
+	Hours        int     `json:"hours"`
+	HoursOverdue int     `json:"hours_overdue"`
+	FinalCost    float64 `json:"final_cost"`
+	PaidStatus   bool    `json:"paid_status"`
+	CreatedAt    string  `json:"created_at"`
+}
+
+func TestRentalLifecycle(t *testing.T) {
+	e := newEnv(t)
+	e.assertState("fresh database", state{available: 9})
+
+	// Create the user; the account cannot log in until the email is verified
+	const email, password = "alice@example.com", "correct horse battery staple"
+	var created struct {
+		User struct {
+			ID int `json:"id"`
+		} `json:"user"`
+	}
+	e.call("POST", e.userURLf("/create-user"), map[string]string{
+		"name": "Alice", "email": email, "password": password,
+	}, http.StatusCreated, &created)
+	userID := created.User.ID
+	e.assertState("create user", state{
+		rows:      map[string]int{"users": 1, "user_details": 1, "user_tokens": 1},
+		available: 9,
+	})
+	e.assertRow("Alice|0|1|active", "SELECT name, email_verified, membership_id, status FROM users WHERE id = ?", userID)
+	e.assertRow("verify_email|NULL", "SELECT purpose, used_at FROM user_tokens WHERE user_id = ?", userID)
+
+	e.fail("POST", e.userURLf("/login"), map[string]string{"email": email, "password": password},
+		http.StatusForbidden, "email_not_verified")
+
+	e.call("POST", e.userURLf("/verify-email"), map[string]string{
+		"token": e.mail.verificationToken(t, email),
+	}, http.StatusOK, nil)
+	e.assertState("verify email", state{
+		rows:      map[string]int{"users": 1, "user_details": 1, "user_tokens": 1},
+		available: 9,
+	})
+	e.assertRow("1", "SELECT email_verified FROM users WHERE id = ?", userID)
+	e.assertRow("1", "SELECT used_at IS NOT NULL FROM user_tokens WHERE user_id = ?", userID)
+
+	// Log in
+	var login struct {
+		UserID int `json:"user_id"`
+	}
+	e.call("POST", e.userURLf("/login"), map[string]string{"email": email, "password": password},
+		http.StatusOK, &login)
+	if login.UserID != userID {
+		t.Fatalf("login returned user %d, want %d", login.UserID, userID)
+	}
+
+	// Renting needs an approved licence
+	e.fail("POST", e.vehicleURLf("/vehicles/create-rental?user_id=%d", userID),
+		map[string]int{"vehicle_id": 1, "hours": 2}, http.StatusForbidden, "licence_required")
+	licenceID := e.submitLicence(userID, "S1234567A")
+	e.assertRow("pending", "SELECT status FROM driver_licences WHERE id = ?", licenceID)
+	e.approveLicence(licenceID)
+	e.assertState("approve licence", state{
+		rows:      map[string]int{"users": 1, "user_details": 1, "user_tokens": 1, "driver_licences": 1},
+		available: 9,
+	})
+	e.assertRow("approved|2099-12-31", "SELECT status, expiry_date FROM driver_licences WHERE id = ?", licenceID)
+
+	// Basic members only see the six standard vehicles
+	var vehicles []struct {
+		ID        int  `json:"id"`
+		VIPAccess bool `json:"vip_access"`
+	}
+	e.call("GET", e.vehicleURLf("/vehicles/available?user_id=%d", userID), nil, http.StatusOK, &vehicles)
+	if len(vehicles) != 6 {
+		t.Fatalf("got %d available vehicles, want 6", len(vehicles))
+	}
+	for _, v := range vehicles {
+		if v.VIPAccess {
+			t.Fatalf("basic member was offered VIP vehicle %d", v.ID)
+		}
+	}
+
+	// Estimate three hours in vehicle 1 at $20 an hour
+	var estimate struct {
+		HourlyRate float64 `json:"hourly_rate"`
+		TotalCost  float64 `json:"total_cost"`
+	}
+	e.call("POST", e.billingURLf("/billing/estimate-cost?user_id=%d", userID),
+		map[string]int{"vehicle_id": 1, "hours": 3}, http.StatusOK, &estimate)
+	if estimate.HourlyRate != 20 || estimate.TotalCost != 60 {
+		t.Fatalf("got estimate %+v, want $20/hour and $60 in total", estimate)
+	}
+
+	// Times are stored in UTC and shown in the zone the user picks
+	e.call("PATCH", e.userURLf("/update-details?user_id=%d", userID),
+		map[string]string{"timezone": "Asia/Singapore"}, http.StatusOK, nil)
+	e.assertRow("Asia/Singapore", "SELECT timezone FROM user_details WHERE id = ?", userID)
+
+	// Rent vehicle 1 for two hours
+	var rental struct {
+		StartDate string `json:"start_date"`
+		EndDate   string `json:"end_date"`
+	}
+	e.call("POST", e.vehicleURLf("/vehicles/create-rental?user_id=%d", userID),
+		map[string]int{"vehicle_id": 1, "hours": 2}, http.StatusOK, &rental)
+	if rental.StartDate != "2030-03-04T17:00:00+08:00" || rental.EndDate != "2030-03-04T19:00:00+08:00" {
+		t.Fatalf("got rental from %s to %s, want 17:00 to 19:00 Singapore time", rental.StartDate, rental.EndDate)
+	}
+	rentalState := state{
+		rows:      map[string]int{"users": 1, "user_details": 1, "user_tokens": 1, "driver_licences": 1, "rentals": 1},
+		available: 8,
+	}
+	e.assertState("create rental", rentalState)
+	e.assertRow("1|2030-03-04 09:00:00|2030-03-04 11:00:00|active|0",
+		"SELECT vehicle_id, start_date, end_date, status, overtime_hours FROM rentals WHERE user_id = ?", userID)
+	e.assertRow("0", "SELECT available FROM vehicles WHERE id = 1")
+	e.fail("POST", e.vehicleURLf("/vehicles/create-rental?user_id=%d", userID),
+		map[string]int{"vehicle_id": 2, "hours": 1}, http.StatusConflict, "rental_in_progress")
+
+	// Extend it by an hour
+	var extended struct {
+		NewEndDate string `json:"new_end_date"`
+	}
+	e.call("POST", e.vehicleURLf("/vehicles/extend-rental?user_id=%d", userID),
+		map[string]int{"hours": 1}, http.StatusOK, &extended)
+	if extended.NewEndDate != "2030-03-04T20:00:00+08:00" {
+		t.Fatalf("got new end %s, want 20:00 Singapore time", extended.NewEndDate)
+	}
+	e.assertState("extend rental", rentalState)
+	e.assertRow("2030-03-04 09:00:00|2030-03-04 12:00:00|active",
+		"SELECT start_date, end_date, status FROM rentals WHERE user_id = ?", userID)
+
+	// Return it on time
+	e.clock.Advance(3 * time.Hour)
+	var completed struct {
+		RentalID int             `json:"rental_id"`
+		Invoice  invoiceResponse `json:"invoice"`
+	}
+	e.call("POST", e.vehicleURLf("/vehicles/complete-rental?user_id=%d", userID), nil, http.StatusOK, &completed)
+	invoicedState := state{
+		rows:      map[string]int{"users": 1, "user_details": 1, "user_tokens": 1, "driver_licences": 1, "rentals": 1, "invoices": 1},
+		available: 9,
+	}
+	e.assertState("complete rental", invoicedState)
+	e.assertRow("completed", "SELECT status FROM rentals WHERE id = ?", completed.RentalID)
+	e.assertRow("1", "SELECT available FROM vehicles WHERE id = 1")
+	e.assertRow("3|0|60.00|0|2030-03-04 12:00:00",
+		"SELECT hours, hours_overdue, FORMAT(final_cost, 2), paid_status, created_at FROM invoices WHERE rental_id = ?", completed.RentalID)
+	if inv := completed.Invoice; inv.RentalID != completed.RentalID || inv.FinalCost != 60 {
+		t.Fatalf("complete-rental returned invoice %+v, want $60 for rental %d", inv, completed.RentalID)
+	}
+
+	// The invoice is listed unpaid
+	var invoices []invoiceResponse
+	e.call("GET", e.billingURLf("/billing/get-invoices?userid=%d", userID), nil, http.StatusOK, &invoices)
+	if len(invoices) != 1 || invoices[0].ID != completed.Invoice.ID || invoices[0].PaidStatus {
+		t.Fatalf("got invoices %+v, want invoice %d unpaid", invoices, completed.Invoice.ID)
+	}
+	if invoices[0].CreatedAt != "2030-03-04T20:00:00+08:00" {
+		t.Fatalf("got invoice created at %s, want 20:00 Singapore time", invoices[0].CreatedAt)
+	}
+
+	// Pay it, once
+	e.call("POST", e.billingURLf("/billing/pay-invoice?userid=%d", userID),
+		map[string]int{"invoice_id": completed.Invoice.ID}, http.StatusOK, nil)
+	e.assertState("pay invoice", invoicedState)
+	e.assertRow("1", "SELECT paid_status FROM invoices WHERE id = ?", completed.Invoice.ID)
+	e.fail("POST", e.billingURLf("/billing/pay-invoice?userid=%d", userID),
+		map[string]int{"invoice_id": completed.Invoice.ID}, http.StatusNotFound, "not_found")
+}
+
+func TestOvertimeIsChargedAtTheMultiplier(t *testing.T) {
+	e := newEnv(t)
+	userID := e.renter("Bob", "bob@example.com")
+
+	e.call("POST", e.vehicleURLf("/vehicles/create-rental?user_id=%d", userID),
+		map[string]int{"vehicle_id": 2, "hours": 2}, http.StatusOK, nil)
+
+	// Back two and a half hours late: only whole overdue hours are charged, at 1.5 times the
+	// undiscounted rate
+	e.clock.Advance(4*time.Hour + 30*time.Minute)
+	var completed struct {
+		Invoice invoiceResponse `json:"invoice"`
+	}
+	e.call("POST", e.vehicleURLf("/vehicles/complete-rental?user_id=%d", userID), nil, http.StatusOK, &completed)
+
+	if inv := completed.Invoice; inv.Hours != 2 || inv.HoursOverdue != 2 || inv.FinalCost != 100 {
+		t.Fatalf("got invoice %+v, want 2 hours, 2 overdue and $100", inv)
+	}
+	e.assertRow("2|2|100.00|0", "SELECT hours, hours_overdue, FORMAT(final_cost, 2), paid_status FROM invoices WHERE user_id = ?", userID)
+	e.assertRow("completed", "SELECT status FROM rentals WHERE user_id = ?", userID)
+	e.assertRow("1", "SELECT available FROM vehicles WHERE id = 2")
+}
+
+func TestCancellationWindow(t *testing.T) {
+	e := newEnv(t)
+	userID := e.renter("Carol", "carol@example.com")
+
+	// Each case starts a new rental of vehicle 3 and tries to cancel it after the given time
+	cases := []struct {
+		after   time.Duration
+		allowed bool
+	}{
+		{59 * time.Minute, true},
+		{time.Hour, true},
+		{time.Hour + time.Minute, false},
+	}
+	for i, c := range cases {
+		e.call("POST", e.vehicleURLf("/vehicles/create-rental?user_id=%d", userID),
+			map[string]int{"vehicle_id": 3, "hours": 4}, http.StatusOK, nil)
+		e.clock.Advance(c.after)
+
+		rentalID := e.count("SELECT MAX(id) FROM rentals WHERE user_id = ?", userID)
+		if c.allowed {
+			e.call("POST", e.vehicleURLf("/vehicles/cancel-rental?user_id=%d", userID), nil, http.StatusOK, nil)
+			e.assertRow("cancelled", "SELECT status FROM rentals WHERE id = ?", rentalID)
+			e.assertRow("1", "SELECT available FROM vehicles WHERE id = 3")
+		} else {
+			e.fail("POST", e.vehicleURLf("/vehicles/cancel-rental?user_id=%d", userID), nil,
+				http.StatusBadRequest, "cancellation_window_closed")
+			e.assertRow("active", "SELECT status FROM rentals WHERE id = ?", rentalID)
+			e.assertRow("0", "SELECT available FROM vehicles WHERE id = 3")
+		}
+		if got := e.count("SELECT COUNT(*) FROM rentals WHERE user_id = ?", userID); got != i+1 {
+			t.Fatalf("after case %d: user has %d rentals, want %d", i, got, i+1)
+		}
+	}
+
+	// Cancelled rentals are never invoiced
+	e.assertState("cancellations", state{
+		rows:      map[string]int{"users": 1, "user_details": 1, "user_tokens": 1, "driver_licences": 1, "rentals": 3},
+		available: 8,
+	})
+}
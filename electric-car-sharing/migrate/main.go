@@ -0,0 +1,180 @@
+// Command migrate manages the database schema.
+//
+//	migrate up            apply every pending migration, creating the database if needed
+//	migrate down [N]      roll back the last N migrations (default 1)
+//	migrate status        list migrations and when they were applied
+//	migrate create NAME   add an empty NNNN_NAME.up.sql and .down.sql pair
+//	migrate seed          load the sample data in schema/seeds
+//
+// It connects with MIGRATE_DSN, an account allowed to create tables and users, which the
+// services' own accounts are not.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"electric-car-sharing/schema"
+	"electric-car-sharing/services/common/migrate"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const defaultDSN = "root@tcp(127.0.0.1:3306)/electric_car_sharing"
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: migrate [-dsn DSN] [-dir DIR] up | down [N] | status | create NAME | seed")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	dsnDefault := os.Getenv("MIGRATE_DSN")
+	if dsnDefault == "" {
+		dsnDefault = defaultDSN
+	}
+	dsn := flag.String("dsn", dsnDefault, "MySQL DSN of an account that can change the schema (env MIGRATE_DSN)")
+	dir := flag.String("dir", filepath.Join("schema", "migrations"), "directory new migrations are created in")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+	}
+
+	command, args := flag.Arg(0), flag.Args()[1:]
+	if command == "create" {
+		if len(args) != 1 {
+			usage()
+		}
+		if err := create(*dir, args[0]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	migrations, err := schema.Migrations()
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, err := open(*dsn, command == "up")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	migrator := migrate.New(db, migrations)
+	migrator.Log = log.Printf
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if applied == 0 {
+			fmt.Println("Schema is up to date at version", migrate.Latest(migrations))
+		}
+	case "down":
+		steps := 1
+		if len(args) == 1 {
+			steps, err = strconv.Atoi(args[0])
+			if err != nil || steps <= 0 {
+				log.Fatalf("down: %q is not a positive number of migrations", args[0])
+			}
+		} else if len(args) > 1 {
+			usage()
+		}
+		if _, err := migrator.Down(ctx, steps); errors.Is(err, migrate.ErrNoChange) {
+			fmt.Println("No migrations to roll back")
+		} else if err != nil {
+			log.Fatal(err)
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, status := range statuses {
+			applied := "pending"
+			if !status.AppliedAt.IsZero() {
+				applied = "applied " + status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d  %-30s  %s\n", status.Version, status.Name, applied)
+		}
+	case "seed":
+		seeds, err := schema.Seeds()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := migrator.Seed(ctx, seeds); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		usage()
+	}
+}
+
+// open connects to the database. With createDB, the database named in the DSN is created first
+// if it does not exist.
+func open(dsn string, createDB bool) (*sql.DB, error) {
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if createDB && cfg.DBName != "" {
+		server := cfg.Clone()
+		server.DBName = ""
+		db, err := sql.Open("mysql", server.FormatDSN())
+		if err != nil {
+			return nil, err
+		}
+		_, err = db.Exec("CREATE DATABASE IF NOT EXISTS `" + strings.ReplaceAll(cfg.DBName, "`", "``") + "`")
+		db.Close()
+		if err != nil {
+			return nil, fmt.Errorf("create database %s: %w", cfg.DBName, err)
+		}
+	}
+
+	db, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+var migrationName = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// create writes an empty migration pair numbered after the highest existing version in dir
+func create(dir, name string) error {
+	if !migrationName.MatchString(name) {
+		return fmt.Errorf("create: name must be lower case letters, digits and underscores, got %q", name)
+	}
+	migrations, err := migrate.Load(os.DirFS(dir))
+	if err != nil {
+		return err
+	}
+	version := migrate.Latest(migrations) + 1
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%04d_%s.%s.sql", version, name, direction))
+		if err := os.WriteFile(path, []byte("-- "+strings.ToUpper(direction[:1])+direction[1:]+" migration\n"), 0o644); err != nil {
+			return err
+		}
+		fmt.Println("Created", path)
+	}
+	return nil
+}
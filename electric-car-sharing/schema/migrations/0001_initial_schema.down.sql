@@ -0,0 +1,14 @@
+-- Drop every table in reverse dependency order
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS rentals;
+DROP TABLE IF EXISTS vehicles;
+DROP TABLE IF EXISTS account_events;
+DROP TABLE IF EXISTS account_status_audit;
+DROP TABLE IF EXISTS driver_licences;
+DROP TABLE IF EXISTS user_recovery_codes;
+DROP TABLE IF EXISTS user_totp;
+DROP TABLE IF EXISTS failed_logins;
+DROP TABLE IF EXISTS user_tokens;
+DROP TABLE IF EXISTS user_details;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS memberships;
@@ -0,0 +1,167 @@
+-- Baseline schema: every table in dependency order
+
+-- Create the memberships table
+CREATE TABLE memberships (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    hourly_rate_discount INT NOT NULL,
+    vip_access BOOLEAN NOT NULL
+);
+
+-- Membership tiers are reference data the code relies on: users default to membership 1 (Basic)
+INSERT INTO memberships (id, name, hourly_rate_discount, vip_access) VALUES
+(1, 'Basic', 0, FALSE),
+(2, 'Premium', 10, FALSE),
+(3, 'VIP', 20, TRUE);
+
+-- Create the users table
+CREATE TABLE users (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) UNIQUE NOT NULL,
+    password VARCHAR(255) NOT NULL,
+    membership_id INT DEFAULT 1,  -- Add membership_id column directly in the table creation
+    email_verified BOOLEAN NOT NULL DEFAULT FALSE,  -- Set once the user opens the verification link
+    pending_email VARCHAR(255) DEFAULT NULL,  -- Requested new email, awaiting confirmation
+    status ENUM('active', 'suspended', 'banned', 'closed') NOT NULL DEFAULT 'active',  -- Only active accounts may log in or rent
+    status_reason VARCHAR(255) DEFAULT NULL,
+    status_changed_at DATETIME DEFAULT NULL,
+    deleted_at DATETIME DEFAULT NULL,  -- Set when the user deletes their account; personal fields are anonymised
+    FOREIGN KEY (membership_id) REFERENCES memberships(id)  -- Link membership_id to the memberships table
+);
+
+-- Create the user_details table
+CREATE TABLE user_details (
+    id INT PRIMARY KEY,  -- Foreign key linking to users table
+    address VARCHAR(255) DEFAULT NULL,  -- User's address on one line, formatted from the fields below (nullable)
+    address_street VARCHAR(120) DEFAULT NULL,
+    address_unit VARCHAR(16) DEFAULT NULL,  -- e.g. #05-123
+    address_postal_code CHAR(6) DEFAULT NULL,  -- Singapore postal code
+    phone_number VARCHAR(16) DEFAULT NULL,  -- User's phone number in E.164 form, e.g. +6591234567 (nullable)
+    gender ENUM('Male', 'Female', 'Other') DEFAULT NULL,  -- User's gender (nullable)
+    FOREIGN KEY (id) REFERENCES users(id) ON DELETE CASCADE  -- Foreign key reference to users table
+);
+
+-- Create the user_tokens table for email verification and password reset links
+CREATE TABLE user_tokens (
+    id VARCHAR(32) PRIMARY KEY,  -- Random token ID, the signed token itself is never stored
+    user_id INT NOT NULL,
+    purpose VARCHAR(32) NOT NULL,  -- e.g. verify_email, reset_password
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME DEFAULT NULL,  -- Set when the token is consumed or revoked
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_tokens_user_purpose (user_id, purpose),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create the failed_logins table, an audit trail of failed and refused login attempts
+CREATE TABLE failed_logins (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT DEFAULT NULL,  -- NULL when the email does not belong to an account
+    email VARCHAR(255) NOT NULL,
+    ip_address VARCHAR(45) NOT NULL,
+    reason VARCHAR(32) NOT NULL,  -- bad_password, unknown_email, throttled or locked
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_failed_logins_email (email),
+    INDEX idx_failed_logins_ip (ip_address),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- Create the user_totp table for optional two-factor authentication
+CREATE TABLE user_totp (
+    user_id INT PRIMARY KEY,
+    secret VARCHAR(64) NOT NULL,  -- Base32 TOTP secret shared with the authenticator app
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,  -- FALSE until the first code is confirmed
+    last_used_step BIGINT NOT NULL DEFAULT 0,  -- Prevents a code from being used twice
+    enabled_at DATETIME DEFAULT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create the user_recovery_codes table, one row per single-use recovery code
+CREATE TABLE user_recovery_codes (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    code_hash CHAR(64) NOT NULL,  -- SHA-256 of the code, the code itself is only shown once
+    used_at DATETIME DEFAULT NULL,
+    INDEX idx_user_recovery_codes_user (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create the driver_licences table; a user may only rent with an approved, unexpired licence
+CREATE TABLE driver_licences (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    licence_number VARCHAR(32) NOT NULL,
+    issuing_country CHAR(2) NOT NULL,  -- ISO 3166-1 alpha-2 code
+    expiry_date DATE NOT NULL,
+    image_path VARCHAR(255) NOT NULL,  -- Path of the uploaded image on the user service host
+    status ENUM('pending', 'approved', 'rejected') NOT NULL DEFAULT 'pending',
+    review_note VARCHAR(255) DEFAULT NULL,  -- Operator's reason, required when rejecting
+    submitted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    reviewed_at DATETIME DEFAULT NULL,
+    expiry_notified_at DATETIME DEFAULT NULL,  -- Set once the user has been warned about expiry
+    INDEX idx_driver_licences_user_status (user_id, status),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create the account_status_audit table, recording every account status change
+CREATE TABLE account_status_audit (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    old_status VARCHAR(16) NOT NULL,
+    new_status VARCHAR(16) NOT NULL,
+    reason VARCHAR(255) DEFAULT NULL,
+    actor VARCHAR(64) NOT NULL,  -- Operator name, or 'system' for automatic changes
+    created_at DATETIME NOT NULL,
+    INDEX idx_account_status_audit_user (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create the account_events table for chargebacks and damage reports that can suspend an account
+CREATE TABLE account_events (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    event VARCHAR(32) NOT NULL,  -- chargeback or damage_report
+    detail VARCHAR(255) DEFAULT NULL,
+    created_at DATETIME NOT NULL,
+    INDEX idx_account_events_user_event (user_id, event, created_at),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create the vehicles table
+CREATE TABLE vehicles (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    make VARCHAR(50) NOT NULL,
+    model VARCHAR(50) NOT NULL,
+    year INT NOT NULL,
+    available BOOLEAN DEFAULT TRUE,
+    vip_access BOOLEAN DEFAULT FALSE,  -- Add vip_access directly in the vehicles table
+    cost_per_hour DECIMAL(10, 2) NOT NULL DEFAULT 20.00  -- Hourly rate before the membership discount
+);
+
+-- Create the rentals table
+CREATE TABLE rentals (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT,
+    vehicle_id INT,
+    start_date DATETIME,
+    end_date DATETIME,
+    status ENUM('active', 'completed', 'cancelled') DEFAULT 'active',
+    overtime_hours INT DEFAULT 0,
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (vehicle_id) REFERENCES vehicles(id)
+);
+
+-- Create the invoices table, one invoice per completed rental
+CREATE TABLE invoices (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    rental_id INT NOT NULL UNIQUE,
+    hours INT NOT NULL,
+    hours_overdue INT DEFAULT 0,
+    final_cost DECIMAL(10, 2) NOT NULL,
+    paid_status BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (rental_id) REFERENCES rentals(id),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
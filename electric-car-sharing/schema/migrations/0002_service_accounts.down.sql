@@ -0,0 +1,3 @@
+DROP USER IF EXISTS 'billing_service'@'localhost';
+DROP USER IF EXISTS 'vehicle_service'@'localhost';
+DROP USER IF EXISTS 'user_service'@'localhost';
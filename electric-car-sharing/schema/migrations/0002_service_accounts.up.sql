@@ -0,0 +1,24 @@
+-- Each service connects with its own account and can only touch the tables it owns.
+-- Foreign keys across service boundaries are kept for integrity but never joined in queries.
+-- The passwords are for development; set real ones with ALTER USER in other environments.
+CREATE USER IF NOT EXISTS 'user_service'@'localhost' IDENTIFIED BY 'user_service_password';
+GRANT SELECT ON schema_migrations TO 'user_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON users TO 'user_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON memberships TO 'user_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON user_details TO 'user_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON user_tokens TO 'user_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON failed_logins TO 'user_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON user_totp TO 'user_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON user_recovery_codes TO 'user_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON driver_licences TO 'user_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON account_status_audit TO 'user_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON account_events TO 'user_service'@'localhost';
+
+CREATE USER IF NOT EXISTS 'vehicle_service'@'localhost' IDENTIFIED BY 'vehicle_service_password';
+GRANT SELECT ON schema_migrations TO 'vehicle_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON vehicles TO 'vehicle_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON rentals TO 'vehicle_service'@'localhost';
+
+CREATE USER IF NOT EXISTS 'billing_service'@'localhost' IDENTIFIED BY 'billing_service_password';
+GRANT SELECT ON schema_migrations TO 'billing_service'@'localhost';
+GRANT SELECT, INSERT, UPDATE, DELETE ON invoices TO 'billing_service'@'localhost';
@@ -0,0 +1 @@
+ALTER TABLE user_details DROP COLUMN timezone;
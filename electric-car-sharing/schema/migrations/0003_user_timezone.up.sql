@@ -0,0 +1,3 @@
+-- The time zone a user wants rental and invoice times shown in. NULL means the service default
+-- (rentals.timezone). Times themselves are stored in UTC.
+ALTER TABLE user_details ADD COLUMN timezone VARCHAR(64) DEFAULT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS billing_idempotency_keys;
+DROP TABLE IF EXISTS vehicle_idempotency_keys;
+DROP TABLE IF EXISTS user_idempotency_keys;
@@ -0,0 +1,44 @@
+-- Responses to requests sent with an Idempotency-Key header, kept so a retried request is
+-- answered with the first response instead of being handled again. Each service keeps its own.
+
+CREATE TABLE user_idempotency_keys (
+    idempotency_key VARCHAR(255) NOT NULL,
+    user_id INT NOT NULL,  -- The user the request was made for, 0 if it named none
+    request_hash CHAR(64) NOT NULL,  -- SHA-256 of the method, path, query and body
+    status_code INT DEFAULT NULL,  -- NULL while the first request is being handled
+    response_headers TEXT DEFAULT NULL,  -- JSON object of the headers the handler set
+    response_body MEDIUMBLOB DEFAULT NULL,
+    withheld BOOLEAN NOT NULL DEFAULT FALSE,  -- The response held secrets and was not kept
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (idempotency_key, user_id),
+    INDEX idx_user_idempotency_keys_created (created_at)
+);
+GRANT SELECT, INSERT, UPDATE, DELETE ON user_idempotency_keys TO 'user_service'@'localhost';
+
+CREATE TABLE vehicle_idempotency_keys (
+    idempotency_key VARCHAR(255) NOT NULL,
+    user_id INT NOT NULL,  -- The user the request was made for, 0 if it named none
+    request_hash CHAR(64) NOT NULL,  -- SHA-256 of the method, path, query and body
+    status_code INT DEFAULT NULL,  -- NULL while the first request is being handled
+    response_headers TEXT DEFAULT NULL,  -- JSON object of the headers the handler set
+    response_body MEDIUMBLOB DEFAULT NULL,
+    withheld BOOLEAN NOT NULL DEFAULT FALSE,  -- The response held secrets and was not kept
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (idempotency_key, user_id),
+    INDEX idx_vehicle_idempotency_keys_created (created_at)
+);
+GRANT SELECT, INSERT, UPDATE, DELETE ON vehicle_idempotency_keys TO 'vehicle_service'@'localhost';
+
+CREATE TABLE billing_idempotency_keys (
+    idempotency_key VARCHAR(255) NOT NULL,
+    user_id INT NOT NULL,  -- The user the request was made for, 0 if it named none
+    request_hash CHAR(64) NOT NULL,  -- SHA-256 of the method, path, query and body
+    status_code INT DEFAULT NULL,  -- NULL while the first request is being handled
+    response_headers TEXT DEFAULT NULL,  -- JSON object of the headers the handler set
+    response_body MEDIUMBLOB DEFAULT NULL,
+    withheld BOOLEAN NOT NULL DEFAULT FALSE,  -- The response held secrets and was not kept
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (idempotency_key, user_id),
+    INDEX idx_billing_idempotency_keys_created (created_at)
+);
+GRANT SELECT, INSERT, UPDATE, DELETE ON billing_idempotency_keys TO 'billing_service'@'localhost';
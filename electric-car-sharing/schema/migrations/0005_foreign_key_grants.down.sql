@@ -0,0 +1,9 @@
+REVOKE SELECT ON rentals FROM 'billing_service'@'localhost';
+REVOKE SELECT ON users FROM 'billing_service'@'localhost';
+
+REVOKE SELECT ON invoices FROM 'vehicle_service'@'localhost';
+REVOKE SELECT ON users FROM 'vehicle_service'@'localhost';
+
+REVOKE SELECT ON vehicles FROM 'user_service'@'localhost';
+REVOKE SELECT ON invoices FROM 'user_service'@'localhost';
+REVOKE SELECT ON rentals FROM 'user_service'@'localhost';
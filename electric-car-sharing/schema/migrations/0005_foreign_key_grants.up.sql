@@ -0,0 +1,13 @@
+-- Let each service account read the tables on the other side of the foreign keys that touch
+-- the tables it owns. MySQL checks foreign keys without the account's privileges, but servers
+-- that do check them, like the MySQL-compatible one the tests run on, refuse the writes
+-- otherwise. The accounts still never query these tables.
+GRANT SELECT ON rentals TO 'user_service'@'localhost';
+GRANT SELECT ON invoices TO 'user_service'@'localhost';
+GRANT SELECT ON vehicles TO 'user_service'@'localhost';
+
+GRANT SELECT ON users TO 'vehicle_service'@'localhost';
+GRANT SELECT ON invoices TO 'vehicle_service'@'localhost';
+
+GRANT SELECT ON users TO 'billing_service'@'localhost';
+GRANT SELECT ON rentals TO 'billing_service'@'localhost';
@@ -0,0 +1,43 @@
+// Package schema embeds the database migrations and seed data so every binary carries the
+// schema it was built against
+package schema
+
+import (
+	"embed"
+	"io/fs"
+
+	"electric-car-sharing/services/common/migrate"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+//go:embed seeds/*.sql
+var seedFiles embed.FS
+
+// Migrations returns the schema migrations in version order
+func Migrations() ([]migrate.Migration, error) {
+	dir, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	return migrate.Load(dir)
+}
+
+// Seeds returns the sample data for development databases
+func Seeds() ([]migrate.Seed, error) {
+	dir, err := fs.Sub(seedFiles, "seeds")
+	if err != nil {
+		return nil, err
+	}
+	return migrate.LoadSeeds(dir)
+}
+
+// Version is the latest migration version, the schema this code expects
+var Version = func() int64 {
+	migrations, err := Migrations()
+	if err != nil {
+		panic(err)
+	}
+	return migrate.Latest(migrations)
+}()
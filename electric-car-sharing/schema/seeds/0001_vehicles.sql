@@ -0,0 +1,11 @@
+-- Sample fleet for development. Fixed IDs keep the seed safe to run again.
+INSERT IGNORE INTO vehicles (id, make, model, year, available, vip_access, cost_per_hour) VALUES
+(1, 'Toyota', 'Corolla', 2020, TRUE, FALSE, 20.00),
+(2, 'Honda', 'Civic', 2021, TRUE, FALSE, 20.00),
+(3, 'Ford', 'Fiesta', 2019, TRUE, FALSE, 20.00),
+(4, 'Chevrolet', 'Malibu', 2022, TRUE, FALSE, 20.00),
+(5, 'Tesla', 'Model 3', 2023, TRUE, FALSE, 20.00),
+(6, 'Hyundai', 'Elantra', 2020, TRUE, FALSE, 20.00),
+(7, 'Tesla', 'Model S', 2023, TRUE, TRUE, 35.00),
+(8, 'Porsche', 'Taycan', 2022, TRUE, TRUE, 35.00),
+(9, 'BMW', 'i8', 2021, TRUE, TRUE, 35.00);
@@ -14,76 +14,65 @@ package handlers
 //EstimateCost, takes in user id vehicle id and hours int
 
 import (
-	"database/sql"
+	"electric-car-sharing/services/billing-service/repository"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/paging"
 	"encoding/json"
-	"fmt"
+	"errors"
+	"log/slog"
 	"net/http"
 	"strconv"
+
+	"github.com/gorilla/mux"
 )
 
 
-func EstimateCost(db *sql.DB) http.HandlerFunc {
+func EstimateCost(users *internalapi.Users, vehicles *internalapi.Vehicles) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
 		// Define struct for the body request
 		type EstimateCostRequest struct {
+			UserID    int `json:"user_id"`
 			VehicleID int `json:"vehicle_id"`
 			Hours     int `json:"hours"`
 		}
 
-		// Define struct for the membership and vehicle
-		type Membership struct {
-			HourlyRateDiscount int
-		}
-
-		type Vehicle struct {
-			CostPerHour float64
-		}
-
-		// Get UserID from query params
-		userIDStr := r.URL.Query().Get("user_id")
-		if userIDStr == "" {
-			http.Error(w, "User ID is required", http.StatusBadRequest)
+		// Decode the JSON body for user, vehicle and hours
+		var req EstimateCostRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
 			return
 		}
-		userID, err := strconv.Atoi(userIDStr)
-		if err != nil || userID <= 0 {
-			http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		if req.UserID <= 0 {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid user ID")
 			return
 		}
+		userID := req.UserID
 
-		// Decode the JSON body for vehicle and hours
-		var req EstimateCostRequest
-		err = json.NewDecoder(r.Body).Decode(&req)
-		if err != nil {
-			http.Error(w, "Invalid request body", http.StatusBadRequest)
+		// Check user membership with the user service
+		profile, err := users.RentalProfile(r.Context(), userID)
+		if internalapi.IsNotFound(err) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User or membership not found")
 			return
-		}
-
-		// Check user membership
-		var membership Membership
-		query := `
-			SELECT m.hourly_rate_discount 
-			FROM users u
-			JOIN memberships m ON u.membership_id = m.id
-			WHERE u.id = ?`
-		err = db.QueryRow(query, userID).Scan(&membership.HourlyRateDiscount)
-		if err != nil {
-			http.Error(w, "User or membership not found", http.StatusNotFound)
+		} else if err != nil {
+			internalapi.WriteUnavailable(w, r, "user", err)
 			return
 		}
 
-		// Get vehicle cost per hour
-		var vehicle Vehicle
-		query = "SELECT cost_per_hour FROM vehicles WHERE id = ?"
-		err = db.QueryRow(query, req.VehicleID).Scan(&vehicle.CostPerHour)
-		if err != nil {
-			http.Error(w, "Vehicle not found", http.StatusNotFound)
+		// Get vehicle cost per hour from the vehicle service
+		vehicle, err := vehicles.Vehicle(r.Context(), req.VehicleID)
+		if internalapi.IsNotFound(err) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "Vehicle not found")
+			return
+		} else if err != nil {
+			internalapi.WriteUnavailable(w, r, "vehicle", err)
 			return
 		}
 
 		// Calculate total cost
-		discountedRate := vehicle.CostPerHour * (1 - float64(membership.HourlyRateDiscount)/100)
+		discountedRate := vehicle.CostPerHour * (1 - float64(profile.Membership.HourlyRateDiscount)/100)
 		totalCost := discountedRate * float64(req.Hours)
 
 		// Send response
@@ -99,140 +88,98 @@ func EstimateCost(db *sql.DB) http.HandlerFunc {
 	}
 }
 
-type Invoice struct {
-	ID          int     `json:"id"`
-	UserID      int     `json:"user_id"`
-	RentalID    int     `json:"rental_id"`
-	Hours       int     `json:"hours"`
-	HoursOverdue int    `json:"hours_overdue"`
-	FinalCost   float64 `json:"final_cost"`
-	PaidStatus  bool    `json:"paid_status"`
-	CreatedAt   string  `json:"created_at"`
-}
-
-func FetchInvoices(db *sql.DB) http.HandlerFunc {
+// FetchInvoices lists a page of a user's invoices, filtered and sorted by the query parameters,
+// with times in the user's time zone
+func FetchInvoices(invoices repository.Invoices, users *internalapi.Users) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Get the user ID from the URL parameters
-		userIDStr := r.URL.Query().Get("userid")
-		if userIDStr == "" {
-			http.Error(w, "userid is required", http.StatusBadRequest)
+		// Get the user ID from the path
+		userID, err := strconv.Atoi(mux.Vars(r)["id"])
+		if err != nil || userID <= 0 {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid user ID")
 			return
 		}
-		
-		// Convert userID from string to int
-		userID, err := strconv.Atoi(userIDStr)
+
+		// Read the page, sort and filters
+		page, err := paging.Parse(r.URL.Query(), repository.InvoiceSorts, paging.Sort{Field: "id"})
 		if err != nil {
-			http.Error(w, "Invalid userid", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
 			return
 		}
-
-		// Get the unpaidOnly query parameter
-		unpaidOnlyStr := r.URL.Query().Get("unpaidonly")
-		var unpaidOnly bool
-		if unpaidOnlyStr != "" {
-			unpaidOnly, err = strconv.ParseBool(unpaidOnlyStr)
-			if err != nil {
-				http.Error(w, "Invalid unpaidonly value", http.StatusBadRequest)
-				return
-			}
+		filters := paging.NewFilters(r.URL.Query())
+		filter := repository.InvoiceFilter{
+			Paid:      filters.Bool("paid"),
+			MinAmount: filters.Float("min_amount"),
+			MaxAmount: filters.Float("max_amount"),
 		}
-
-		// Prepare the query based on unpaidOnly
-		var invoices []Invoice
-		var query string
-		if unpaidOnly {
-			query = "SELECT id, user_id, rental_id, hours, hours_overdue, final_cost, paid_status, created_at FROM invoices WHERE user_id = ? AND paid_status = 0"
-		} else {
-			query = "SELECT id, user_id, rental_id, hours, hours_overdue, final_cost, paid_status, created_at FROM invoices WHERE user_id = ?"
+		if err := filters.Err(); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+			return
 		}
 
-		// Query the database
-		rows, err := db.Query(query, userID)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Error querying database: %v", err), http.StatusInternalServerError)
+		userInvoices, next, err := invoices.ListByUser(r.Context(), userID, filter, page)
+		if errors.Is(err, paging.ErrInvalidCursor) {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Query parameter 'cursor' is invalid")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching invoices", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch invoices")
 			return
 		}
-		defer rows.Close()
 
-		// Read the results into the invoices slice
-		for rows.Next() {
-			var invoice Invoice
-			if err := rows.Scan(&invoice.ID, &invoice.UserID, &invoice.RentalID, &invoice.Hours, &invoice.HoursOverdue, &invoice.FinalCost, &invoice.PaidStatus, &invoice.CreatedAt); err != nil {
-				http.Error(w, fmt.Sprintf("Error reading rows: %v", err), http.StatusInternalServerError)
-				return
+		result := []internalapi.Invoice{}
+		if len(userInvoices) > 0 {
+			location := userLocation(r.Context(), users, userID)
+			for _, invoice := range userInvoices {
+				shown := invoiceJSON(invoice)
+				shown.CreatedAt = shown.CreatedAt.In(location)
+				result = append(result, shown)
 			}
-			invoices = append(invoices, invoice)
-		}
-
-		// Check for errors from iterating over rows
-		if err := rows.Err(); err != nil {
-			http.Error(w, fmt.Sprintf("Row iteration error: %v", err), http.StatusInternalServerError)
-			return
 		}
 
-		// Return the invoices as JSON response
-		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(invoices); err != nil {
-			http.Error(w, fmt.Sprintf("Error encoding response: %v", err), http.StatusInternalServerError)
-			return
-		}
+		// Return the page of invoices
+		paging.Write(w, result, page.Page(next))
 	}
 }
-//Pay invoice
-
-func PayInvoice(db *sql.DB) http.HandlerFunc {
+// PayInvoice records a payment of the invoice in the path by the user in the body
+func PayInvoice(invoices repository.Invoices) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Get the user ID from the URL parameters
-		userIDStr := r.URL.Query().Get("userid")
-		if userIDStr == "" {
-			http.Error(w, "userid is required", http.StatusBadRequest)
-			return
-		}
-
-		// Convert userID from string to int
-		userID, err := strconv.Atoi(userIDStr)
-		if err != nil {
-			http.Error(w, "Invalid userid", http.StatusBadRequest)
+		// Get the invoice ID from the path
+		invoiceID, err := strconv.Atoi(mux.Vars(r)["id"])
+		if err != nil || invoiceID <= 0 {
+			paymentFailures.WithLabelValues(failureInvalidRequest).Inc()
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invoice ID must be a positive integer")
 			return
 		}
 
-		// Parse the invoice ID from the request body
+		// Parse the paying user's ID from the request body
 		var requestBody struct {
-			InvoiceID int `json:"invoice_id"`
+			UserID int `json:"user_id"`
 		}
 
 		// Decode the request body into the struct
 		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
-			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			paymentFailures.WithLabelValues(failureInvalidRequest).Inc()
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
 			return
 		}
 
-		// Ensure the invoice ID is provided
-		if requestBody.InvoiceID == 0 {
-			http.Error(w, "invoice_id is required", http.StatusBadRequest)
+		// Ensure the user ID is provided
+		if requestBody.UserID <= 0 {
+			paymentFailures.WithLabelValues(failureInvalidRequest).Inc()
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid user ID")
 			return
 		}
 
-		// Prepare the update query to set paid_status to true (1)
-		query := `UPDATE invoices SET paid_status = 1 WHERE id = ? AND user_id = ?`
-
-		// Execute the query to update the invoice
-		result, err := db.Exec(query, requestBody.InvoiceID, userID)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Error updating invoice: %v", err), http.StatusInternalServerError)
+		// The invoice might not exist, might not belong to the user or might already be paid
+		err = invoices.MarkPaid(r.Context(), requestBody.UserID, invoiceID)
+		if errors.Is(err, repository.ErrNotFound) {
+			paymentFailures.WithLabelValues(failureNotFound).Inc()
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "Invoice not found or not associated with user")
 			return
-		}
-
-		// Check if the invoice was actually updated
-		rowsAffected, err := result.RowsAffected()
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Error checking rows affected: %v", err), http.StatusInternalServerError)
-			return
-		}
-
-		// If no rows were affected, the invoice ID might not exist or doesn't belong to the user
-		if rowsAffected == 0 {
-			http.Error(w, "Invoice not found or not associated with user", http.StatusNotFound)
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error updating invoice", "err", err)
+			paymentFailures.WithLabelValues(failureError).Inc()
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to pay invoice")
 			return
 		}
 
@@ -240,12 +187,12 @@ func PayInvoice(db *sql.DB) http.HandlerFunc {
 		w.Header().Set("Content-Type", "application/json")
 		response := map[string]interface{}{
 			"message": "Invoice successfully paid",
-			"invoice_id": requestBody.InvoiceID,
+			"invoice_id": invoiceID,
 			"paid_status": true,
 		}
-		w.WriteHeader(http.StatusOK)
+		w.WriteHeader(http.StatusCreated)
 		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, fmt.Sprintf("Error encoding response: %v", err), http.StatusInternalServerError)
+			slog.ErrorContext(r.Context(), "Error encoding response", "err", err)
 		}
 	}
-}
\ No newline at end of file
+}
\ No newline at end of file
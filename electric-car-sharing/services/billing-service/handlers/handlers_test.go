@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"electric-car-sharing/services/billing-service/repository"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/clock"
+	"electric-car-sharing/services/common/internalapi"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+const testToken = "test-token"
+
+// service is the billing service running against the in-memory store, with the user and vehicle
+// services it calls faked by profiles and vehicles
+type service struct {
+	t        *testing.T
+	router   *mux.Router
+	store    *repository.Memory
+	clock    *clock.Fake
+	profiles map[int]internalapi.RentalProfile
+	vehicles map[int]internalapi.Vehicle
+}
+
+func newService(t *testing.T) *service {
+	s := &service{
+		t:        t,
+		router:   mux.NewRouter(),
+		store:    repository.NewMemory(),
+		clock:    clock.NewFake(time.Date(2030, time.March, 4, 9, 0, 0, 0, time.UTC)),
+		profiles: map[int]internalapi.RentalProfile{},
+		vehicles: map[int]internalapi.Vehicle{},
+	}
+
+	notFound := func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"not_found","message":"Not found"}}`))
+	}
+	fakes := mux.NewRouter()
+	fakes.HandleFunc("/internal/users/{id:[0-9]+}/rental-profile", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(mux.Vars(r)["id"])
+		if profile, ok := s.profiles[id]; ok {
+			json.NewEncoder(w).Encode(profile)
+		} else {
+			notFound(w)
+		}
+	})
+	fakes.HandleFunc("/internal/vehicles/{id:[0-9]+}", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(mux.Vars(r)["id"])
+		if vehicle, ok := s.vehicles[id]; ok {
+			json.NewEncoder(w).Encode(vehicle)
+		} else {
+			notFound(w)
+		}
+	})
+	fake := httptest.NewServer(fakes)
+	t.Cleanup(fake.Close)
+
+	Register(s.router, s.store, internalapi.NewUsers(fake.URL, testToken),
+		internalapi.NewVehicles(fake.URL, testToken), s.clock, testToken)
+	return s
+}
+
+// member adds a user with the given hourly rate discount
+func (s *service) member(id, discount int) {
+	s.profiles[id] = internalapi.RentalProfile{
+		UserID:     id,
+		Status:     internalapi.StatusActive,
+		Membership: internalapi.Membership{ID: 1, Name: "Member", HourlyRateDiscount: discount},
+	}
+}
+
+// do sends the request with the internal token and checks the status, decoding the response
+// into a map
+func (s *service) do(method, path, body string, status int) map[string]interface{} {
+	s.t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("X-Internal-Token", testToken)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	if rec.Code != status {
+		s.t.Fatalf("%s %s: got %d, want %d: %s", method, path, rec.Code, status, rec.Body)
+	}
+	var resp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+	return resp
+}
+
+// errorCode returns the code of an error response
+func errorCode(resp map[string]interface{}) string {
+	e, _ := resp["error"].(map[string]interface{})
+	code, _ := e["code"].(string)
+	return code
+}
+
+func TestInvoiceRental(t *testing.T) {
+	s := newService(t)
+	s.member(1, 20)
+
+	// 4 hours at 10 with 20% off, and 2 hours overdue at 1.5 times 10
+	charge := `{"user_id":1,"rental_id":7,"hours":4,"hours_overdue":2,"cost_per_hour":10}`
+	invoice := s.do("POST", "/internal/invoices", charge, http.StatusCreated)
+	if invoice["final_cost"] != 62.0 || invoice["paid_status"] != false {
+		t.Fatalf("invoice: %v", invoice)
+	}
+
+	// Invoicing the rental again returns the same invoice
+	again := s.do("POST", "/internal/invoices", charge, http.StatusOK)
+	if again["id"] != invoice["id"] {
+		t.Errorf("repeat invoice %v, want %v", again["id"], invoice["id"])
+	}
+
+	resp := s.do("POST", "/internal/invoices", `{"user_id":9,"rental_id":8,"hours":1,"cost_per_hour":10}`, http.StatusNotFound)
+	if errorCode(resp) != apierror.CodeNotFound {
+		t.Errorf("unknown user: %v", resp)
+	}
+}
+
+func TestPayInvoice(t *testing.T) {
+	s := newService(t)
+	s.member(1, 0)
+	s.member(2, 0)
+	invoice := s.do("POST", "/internal/invoices", `{"user_id":1,"rental_id":7,"hours":1,"cost_per_hour":10}`, http.StatusCreated)
+	path := "/api/v2/invoices/" + strconv.Itoa(int(invoice["id"].(float64))) + "/payments"
+
+	for _, tc := range []struct {
+		name   string
+		body   string
+		status int
+		code   string
+	}{
+		{"someone else's invoice", `{"user_id":2}`, http.StatusNotFound, apierror.CodeNotFound},
+		{"no user", `{}`, http.StatusBadRequest, apierror.CodeInvalidRequest},
+		{"paid", `{"user_id":1}`, http.StatusCreated, ""},
+		{"paid twice", `{"user_id":1}`, http.StatusNotFound, apierror.CodeNotFound},
+	} {
+		resp := s.do("POST", path, tc.body, tc.status)
+		if errorCode(resp) != tc.code {
+			t.Errorf("%s: got %v, want %q", tc.name, resp, tc.code)
+		}
+	}
+}
+
+func TestFetchInvoices(t *testing.T) {
+	s := newService(t)
+	s.member(1, 0)
+	for rental := 1; rental <= 3; rental++ {
+		s.do("POST", "/internal/invoices", `{"user_id":1,"rental_id":`+strconv.Itoa(rental)+`,"hours":`+strconv.Itoa(rental)+`,"cost_per_hour":10}`, http.StatusCreated)
+	}
+	s.do("POST", "/api/v2/invoices/2/payments", `{"user_id":1}`, http.StatusCreated)
+
+	costs := func(resp map[string]interface{}) []float64 {
+		var got []float64
+		for _, invoice := range resp["data"].([]interface{}) {
+			got = append(got, invoice.(map[string]interface{})["final_cost"].(float64))
+		}
+		return got
+	}
+
+	resp := s.do("GET", "/api/v2/users/1/invoices?limit=2&sort=-final_cost", "", http.StatusOK)
+	if got := costs(resp); len(got) != 2 || got[0] != 30 || got[1] != 20 {
+		t.Errorf("first page: %v", got)
+	}
+	next := resp["page"].(map[string]interface{})["next_cursor"].(string)
+	resp = s.do("GET", "/api/v2/users/1/invoices?limit=2&sort=-final_cost&cursor="+next, "", http.StatusOK)
+	if got := costs(resp); len(got) != 1 || got[0] != 10 {
+		t.Errorf("second page: %v", got)
+	}
+
+	if got := costs(s.do("GET", "/api/v2/users/1/invoices?paid=false", "", http.StatusOK)); len(got) != 2 || got[0] != 10 || got[1] != 30 {
+		t.Errorf("unpaid: %v", got)
+	}
+	if got := costs(s.do("GET", "/api/v2/users/2/invoices", "", http.StatusOK)); len(got) != 0 {
+		t.Errorf("another user's invoices: %v", got)
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	s := newService(t)
+	s.member(1, 10)
+	s.vehicles[3] = internalapi.Vehicle{ID: 3, CostPerHour: 20}
+
+	resp := s.do("POST", "/api/v2/estimates", `{"user_id":1,"vehicle_id":3,"hours":2}`, http.StatusOK)
+	if resp["hourly_rate"] != 18.0 || resp["total_cost"] != 36.0 {
+		t.Errorf("estimate: %v", resp)
+	}
+	resp = s.do("POST", "/api/v2/estimates", `{"user_id":1,"vehicle_id":4,"hours":2}`, http.StatusNotFound)
+	if errorCode(resp) != apierror.CodeNotFound {
+		t.Errorf("unknown vehicle: %v", resp)
+	}
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"electric-car-sharing/services/billing-service/models"
+	"electric-car-sharing/services/billing-service/repository"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/clock"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/paging"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// OvertimeMultiplier is applied to the full hourly rate for hours past the rental's end
+var OvertimeMultiplier = 1.5
+
+// Location is the time zone invoice times are shown in for users who have not chosen their own
+var Location = time.UTC
+
+// userLocation returns the zone the user wants times shown in. If the user service cannot be
+// reached the default Location is used rather than failing the request.
+func userLocation(ctx context.Context, users *internalapi.Users, userID int) *time.Location {
+	profile, err := users.RentalProfile(ctx, userID)
+	if err != nil {
+		slog.WarnContext(ctx, "Error fetching time zone, using the default", "user_id", userID, "err", err)
+		return Location
+	}
+	return profile.Location(Location)
+}
+
+// invoiceJSON converts an invoice to the form the API returns, with CreatedAt in UTC
+func invoiceJSON(invoice models.Invoice) internalapi.Invoice {
+	return internalapi.Invoice{
+		ID:           invoice.ID,
+		UserID:       invoice.UserID,
+		RentalID:     invoice.RentalID,
+		Hours:        invoice.Hours,
+		HoursOverdue: invoice.HoursOverdue,
+		FinalCost:    invoice.FinalCost,
+		PaidStatus:   invoice.PaidStatus,
+		CreatedAt:    invoice.CreatedAt,
+	}
+}
+
+// InvoiceRental is the internal endpoint the vehicle service calls when a rental is completed.
+// Rental hours get the membership discount and overtime hours are charged at the overtime rate.
+// invoices.rental_id is unique, so a repeated call returns the invoice created the first time.
+func InvoiceRental(invoices repository.Invoices, users *internalapi.Users, clk clock.Clock) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var charge internalapi.RentalCharge
+		if err := json.NewDecoder(r.Body).Decode(&charge); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
+			return
+		}
+		if charge.UserID <= 0 || charge.RentalID <= 0 || charge.Hours < 0 || charge.HoursOverdue < 0 || charge.CostPerHour < 0 {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "user_id, rental_id, hours, hours_overdue and cost_per_hour are required")
+			return
+		}
+
+		profile, err := users.RentalProfile(r.Context(), charge.UserID)
+		if internalapi.IsNotFound(err) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User or membership not found")
+			return
+		} else if err != nil {
+			internalapi.WriteUnavailable(w, r, "user", err)
+			return
+		}
+
+		discount := float64(profile.Membership.HourlyRateDiscount) / 100.0
+		finalCost := float64(charge.Hours)*charge.CostPerHour*(1-discount) +
+			float64(charge.HoursOverdue)*charge.CostPerHour*OvertimeMultiplier
+
+		invoice, created, err := invoices.CreateForRental(r.Context(), models.Invoice{
+			UserID:       charge.UserID,
+			RentalID:     charge.RentalID,
+			Hours:        charge.Hours,
+			HoursOverdue: charge.HoursOverdue,
+			FinalCost:    finalCost,
+			CreatedAt:    clk.Now().UTC(),
+		})
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error creating invoice", "rental_id", charge.RentalID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to create invoice")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if created {
+			invoiceRevenue.Add(invoice.FinalCost)
+			w.WriteHeader(http.StatusCreated)
+		}
+		json.NewEncoder(w).Encode(invoiceJSON(invoice))
+	}
+}
+
+// UserInvoices is the internal endpoint the user service uses to list a user's invoices
+func UserInvoices(invoices repository.Invoices) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := strconv.Atoi(mux.Vars(r)["id"])
+		if err != nil || userID <= 0 {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "User ID must be a positive integer")
+			return
+		}
+
+		userInvoices, _, err := invoices.ListByUser(r.Context(), userID, repository.InvoiceFilter{}, paging.All)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching invoices", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch invoices")
+			return
+		}
+
+		result := []internalapi.Invoice{}
+		for _, invoice := range userInvoices {
+			result = append(result, invoiceJSON(invoice))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"invoices": result})
+	}
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"electric-car-sharing/services/common/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Reasons counted by paymentFailures
+const (
+	failureInvalidRequest = "invalid_request"
+	failureNotFound       = "not_found"
+	failureError          = "error"
+)
+
+var (
+	invoiceRevenue = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "invoice_revenue_dollars_total",
+		Help:      "Total final cost of the invoices issued, whether paid or not.",
+	})
+
+	paymentFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "payment_failures_total",
+		Help:      "Invoice payments refused, by reason. not_found includes invoices already paid.",
+	}, []string{"reason"})
+)
+
+func init() {
+	prometheus.MustRegister(invoiceRevenue, paymentFailures)
+	for _, reason := range []string{failureInvalidRequest, failureNotFound, failureError} {
+		paymentFailures.WithLabelValues(reason)
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"electric-car-sharing/services/billing-service/repository"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/clock"
+	"electric-car-sharing/services/common/compat"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/paging"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// Register adds the billing service's routes to router: the resource routes under /api/v2, the
+// v1 routes they replace and the /internal/ routes. Invoices are dated by clk. Internal routes
+// only accept callers presenting internalToken. The probes are left to main.
+func Register(router *mux.Router, invoices repository.Invoices, users *internalapi.Users,
+	vehicles *internalapi.Vehicles, clk clock.Clock, internalToken string) {
+	estimateCost := EstimateCost(users, vehicles)
+	fetchInvoices := FetchInvoices(invoices, users)
+	payInvoice := PayInvoice(invoices)
+
+	v2 := router.PathPrefix("/api/v2").Subrouter()
+	v2.HandleFunc("/estimates", estimateCost).Methods("POST")
+	v2.HandleFunc("/users/{id:[0-9]+}/invoices", fetchInvoices).Methods("GET")
+	v2.HandleFunc("/invoices/{id:[0-9]+}/payments", payInvoice).Methods("POST")
+
+	// The deprecated v1 routes, answered by their v2 handlers. The invoice list, which v1 did not
+	// page, reads every page in the largest size.
+	router.HandleFunc("/billing/estimate-cost", compat.Handler(compat.Route{
+		Successor: "/api/v2/estimates",
+		Rewrites:  []compat.Rewrite{compat.QueryToBody("user_id", "user_id")},
+	}, estimateCost)).Methods("POST")
+	router.HandleFunc("/billing/get-invoices", compat.Handler(compat.Route{
+		Successor: "/api/v2/users/{id}/invoices",
+		Rewrites:  []compat.Rewrite{compat.QueryToPath("userid", "id"), unpaidOnly, compat.SetQuery("limit", strconv.Itoa(paging.MaxLimit))},
+		Body:      compat.Unwrap("data"),
+		AllPages:  true,
+	}, fetchInvoices)).Methods("GET")
+	router.HandleFunc("/billing/pay-invoice", compat.Handler(compat.Route{
+		Successor: "/api/v2/invoices/{id}/payments",
+		Rewrites:  []compat.Rewrite{compat.QueryToBody("userid", "user_id"), compat.BodyToPath("invoice_id", "id")},
+		Status:    map[int]int{http.StatusCreated: http.StatusOK},
+	}, payInvoice)).Methods("POST")
+
+	// Routes for the user and vehicle services, protected by the X-Internal-Token header
+	internal := router.PathPrefix("/internal").Subrouter()
+	internal.Use(internalapi.RequireToken(internalToken))
+	internal.HandleFunc("/invoices", InvoiceRental(invoices, users, clk)).Methods("POST")
+	internal.HandleFunc("/users/{id:[0-9]+}/invoices", UserInvoices(invoices)).Methods("GET")
+}
+
+// unpaidOnly turns the v1 unpaidonly query parameter into the v2 paid filter
+func unpaidOnly(r *http.Request, vars map[string]string) error {
+	query := r.URL.Query()
+	value := query.Get("unpaidonly")
+	if value == "" {
+		return nil
+	}
+	unpaid, err := strconv.ParseBool(value)
+	if err != nil {
+		return &compat.Refusal{Status: http.StatusBadRequest, Code: apierror.CodeInvalidRequest, Message: "Invalid unpaidonly value"}
+	}
+	query.Del("unpaidonly")
+	if unpaid {
+		query.Set("paid", "false")
+	}
+	r.URL.RawQuery = query.Encode()
+	return nil
+}
@@ -1 +1,15 @@
-package models
+package models
+
+import "time"
+
+// Invoice is the bill for one completed rental. CreatedAt is in UTC.
+type Invoice struct {
+	ID           int       `json:"id"`
+	UserID       int       `json:"user_id"`
+	RentalID     int       `json:"rental_id"`
+	Hours        int       `json:"hours"`
+	HoursOverdue int       `json:"hours_overdue"`
+	FinalCost    float64   `json:"final_cost"`
+	PaidStatus   bool      `json:"paid_status"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"electric-car-sharing/services/billing-service/models"
+	"electric-car-sharing/services/common/paging"
+)
+
+// Memory keeps invoices in a map. It is safe for concurrent use and is meant for tests and local
+// development.
+type Memory struct {
+	mu       sync.Mutex
+	invoices map[int]models.Invoice
+	nextID   int
+}
+
+var _ Invoices = (*Memory)(nil)
+
+// NewMemory creates an empty store
+func NewMemory() *Memory {
+	return &Memory{invoices: map[int]models.Invoice{}, nextID: 1}
+}
+
+// CreateForRental stores the invoice unless its rental has already been invoiced
+func (s *Memory) CreateForRental(ctx context.Context, invoice models.Invoice) (models.Invoice, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.invoices {
+		if existing.RentalID == invoice.RentalID {
+			return existing, false, nil
+		}
+	}
+	invoice.ID = s.nextID
+	s.nextID++
+	invoice.PaidStatus = false
+	invoice.CreatedAt = invoice.CreatedAt.UTC().Truncate(time.Second)
+	// final_cost is DECIMAL(10,2)
+	invoice.FinalCost = math.Round(invoice.FinalCost*100) / 100
+	s.invoices[invoice.ID] = invoice
+	return invoice, true, nil
+}
+
+// ListByUser returns a page of the user's invoices that match filter
+func (s *Memory) ListByUser(ctx context.Context, userID int, filter InvoiceFilter, page paging.Request) ([]models.Invoice, string, error) {
+	zero := invoiceKey(models.Invoice{}, page.Sort.Field)
+	if zero == nil {
+		return nil, "", errSort(page.Sort.Field)
+	}
+	after, err := page.AfterKey(zero)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var invoices []models.Invoice
+	for _, invoice := range s.invoices {
+		key := invoiceKey(invoice, page.Sort.Field)
+		if invoice.UserID == userID && filter.match(invoice) && (page.After == nil || page.Less(after, page.After.ID, key, invoice.ID)) {
+			invoices = append(invoices, invoice)
+		}
+	}
+	sort.Slice(invoices, func(i, j int) bool {
+		return page.Less(invoiceKey(invoices[i], page.Sort.Field), invoices[i].ID, invoiceKey(invoices[j], page.Sort.Field), invoices[j].ID)
+	})
+	invoices, next := pageInvoices(invoices, page)
+	return invoices, next, nil
+}
+
+// MarkPaid pays one of the user's unpaid invoices
+func (s *Memory) MarkPaid(ctx context.Context, userID, invoiceID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	invoice, ok := s.invoices[invoiceID]
+	if !ok || invoice.UserID != userID || invoice.PaidStatus {
+		return ErrNotFound
+	}
+	invoice.PaidStatus = true
+	s.invoices[invoiceID] = invoice
+	return nil
+}
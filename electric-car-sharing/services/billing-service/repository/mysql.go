@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"electric-car-sharing/services/billing-service/models"
+	"electric-car-sharing/services/common/paging"
+)
+
+// MySQL stores invoices in the invoices table. Its connection must be opened with
+// database.OpenUTC so invoice times are stored and read as UTC.
+type MySQL struct {
+	db *sql.DB
+}
+
+var _ Invoices = (*MySQL)(nil)
+
+// NewMySQL creates a store backed by db
+func NewMySQL(db *sql.DB) *MySQL {
+	return &MySQL{db: db}
+}
+
+const invoiceColumns = "id, user_id, rental_id, hours, hours_overdue, final_cost, paid_status, created_at"
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanInvoice(row scanner) (models.Invoice, error) {
+	var invoice models.Invoice
+	err := row.Scan(&invoice.ID, &invoice.UserID, &invoice.RentalID, &invoice.Hours, &invoice.HoursOverdue,
+		&invoice.FinalCost, &invoice.PaidStatus, &invoice.CreatedAt)
+	return invoice, err
+}
+
+// CreateForRental inserts the invoice. invoices.rental_id is unique, so a second call for the
+// same rental leaves the first invoice in place and returns it.
+func (s *MySQL) CreateForRental(ctx context.Context, invoice models.Invoice) (models.Invoice, bool, error) {
+	result, err := s.db.ExecContext(ctx, `
+		INSERT INTO invoices (user_id, rental_id, hours, hours_overdue, final_cost, paid_status, created_at)
+		VALUES (?, ?, ?, ?, ?, FALSE, ?)
+		ON DUPLICATE KEY UPDATE id = id`,
+		invoice.UserID, invoice.RentalID, invoice.Hours, invoice.HoursOverdue, invoice.FinalCost,
+		invoice.CreatedAt.UTC().Truncate(time.Second))
+	if err != nil {
+		return invoice, false, err
+	}
+	created, _ := result.RowsAffected()
+
+	stored, err := scanInvoice(s.db.QueryRowContext(ctx, "SELECT "+invoiceColumns+" FROM invoices WHERE rental_id = ?", invoice.RentalID))
+	return stored, created == 1, err
+}
+
+// ListByUser returns a page of the user's invoices that match filter
+func (s *MySQL) ListByUser(ctx context.Context, userID int, filter InvoiceFilter, page paging.Request) ([]models.Invoice, string, error) {
+	zero := invoiceKey(models.Invoice{}, page.Sort.Field)
+	if zero == nil {
+		return nil, "", errSort(page.Sort.Field)
+	}
+	after, err := page.AfterKey(zero)
+	if err != nil {
+		return nil, "", err
+	}
+
+	conditions := []string{"user_id = ?"}
+	args := []interface{}{userID}
+	if filter.Paid != nil {
+		conditions = append(conditions, "paid_status = ?")
+		args = append(args, *filter.Paid)
+	}
+	if filter.MinAmount != 0 {
+		conditions = append(conditions, "final_cost >= ?")
+		args = append(args, filter.MinAmount)
+	}
+	if filter.MaxAmount != 0 {
+		conditions = append(conditions, "final_cost <= ?")
+		args = append(args, filter.MaxAmount)
+	}
+	// The sort field was checked by invoiceKey, so it is safe to use as a column
+	where, pageArgs, order := page.SQL(page.Sort.Field, after)
+	if where != "" {
+		conditions = append(conditions, where)
+		args = append(args, pageArgs...)
+	}
+
+	query := "SELECT " + invoiceColumns + " FROM invoices WHERE " + strings.Join(conditions, " AND ") + order
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var invoices []models.Invoice
+	for rows.Next() {
+		invoice, err := scanInvoice(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		invoices = append(invoices, invoice)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	invoices, next := pageInvoices(invoices, page)
+	return invoices, next, nil
+}
+
+// MarkPaid pays one of the user's unpaid invoices
+func (s *MySQL) MarkPaid(ctx context.Context, userID, invoiceID int) error {
+	result, err := s.db.ExecContext(ctx, "UPDATE invoices SET paid_status = 1 WHERE id = ? AND user_id = ? AND paid_status = 0", invoiceID, userID)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Package repository stores the billing service's invoices. Handlers depend on the Invoices
+// interface rather than on *sql.DB, so they can run against the MySQL store in production and
+// the in-memory store in tests.
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"electric-car-sharing/services/billing-service/models"
+	"electric-car-sharing/services/common/paging"
+)
+
+// ErrNotFound is returned when the invoice does not exist
+var ErrNotFound = errors.New("not found")
+
+// Invoices stores invoices
+type Invoices interface {
+	// CreateForRental stores invoice unless its rental has already been invoiced, and returns the
+	// rental's invoice and whether it was created by this call
+	CreateForRental(ctx context.Context, invoice models.Invoice) (models.Invoice, bool, error)
+	// ListByUser returns a page of the user's invoices that match filter, and the cursor of the
+	// next page, or "" on the last one
+	ListByUser(ctx context.Context, userID int, filter InvoiceFilter, page paging.Request) ([]models.Invoice, string, error)
+	// MarkPaid pays one of the user's unpaid invoices, or returns ErrNotFound if the user has no
+	// such unpaid invoice
+	MarkPaid(ctx context.Context, userID, invoiceID int) error
+}
+
+// InvoiceFilter narrows a list of invoices. Zero fields match every invoice.
+type InvoiceFilter struct {
+	// Paid, when set, keeps only the paid invoices, or only the unpaid ones
+	Paid *bool
+	// MinAmount and MaxAmount bound the final cost
+	MinAmount, MaxAmount float64
+}
+
+func (f InvoiceFilter) match(invoice models.Invoice) bool {
+	return (f.Paid == nil || invoice.PaidStatus == *f.Paid) &&
+		(f.MinAmount == 0 || invoice.FinalCost >= f.MinAmount) &&
+		(f.MaxAmount == 0 || invoice.FinalCost <= f.MaxAmount)
+}
+
+// InvoiceSorts are the fields invoices can be sorted by
+var InvoiceSorts = []string{"id", "created_at", "final_cost"}
+
+// invoiceKey is the invoice's value of the sort field, or nil if invoices cannot be sorted by it
+func invoiceKey(invoice models.Invoice, field string) interface{} {
+	switch field {
+	case "id":
+		return invoice.ID
+	case "created_at":
+		return invoice.CreatedAt
+	case "final_cost":
+		return invoice.FinalCost
+	}
+	return nil
+}
+
+// pageInvoices cuts the invoices fetched for page down to it and returns the next page's cursor
+func pageInvoices(invoices []models.Invoice, page paging.Request) ([]models.Invoice, string) {
+	n, more := page.Cut(len(invoices))
+	invoices = invoices[:n]
+	if !more {
+		return invoices, ""
+	}
+	last := invoices[n-1]
+	return invoices, page.Next(invoiceKey(last, page.Sort.Field), last.ID)
+}
+
+// errSort refuses a sort field the handlers should not have let through
+func errSort(field string) error {
+	return fmt.Errorf("unknown sort field %q", field)
+}
@@ -0,0 +1,196 @@
+// Package apierror defines the JSON error envelope returned by every service:
+//
+//	{"error": {"code": "not_found", "message": "User not found", "fields": {...}, "request_id": "..."}}
+//
+// Clients should branch on the code; the message is for people and may change.
+package apierror
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Generic codes, used when there is nothing more specific to say
+const (
+	CodeInvalidRequest     = "invalid_request"
+	CodeValidationFailed   = "validation_failed"
+	CodeUnauthorized       = "unauthorized"
+	CodeForbidden          = "forbidden"
+	CodeNotFound           = "not_found"
+	CodeMethodNotAllowed   = "method_not_allowed"
+	CodeConflict           = "conflict"
+	CodeRateLimited        = "rate_limited"
+	CodeInternal           = "internal_error"
+	CodeServiceUnavailable = "service_unavailable"
+)
+
+// Account and authentication codes
+const (
+	CodeInvalidCredentials = "invalid_credentials"
+	CodeInvalidCode        = "invalid_code"
+	CodeEmailNotVerified   = "email_not_verified"
+	CodeEmailTaken         = "email_taken"
+	CodeAccountLocked      = "account_locked"
+	CodeAccountInactive    = "account_inactive"
+	CodeAdminRequired      = "admin_required"
+	CodeTokenInvalid       = "token_invalid"
+	CodeTokenExpired       = "token_expired"
+	CodeTokenUsed          = "token_used"
+	CodeTwoFactorEnabled   = "two_factor_enabled"
+	CodeLicencePending     = "licence_pending"
+	CodeLicenceRequired    = "licence_required"
+)
+
+// Rental and billing codes
+const (
+	CodeRentalInProgress    = "rental_in_progress"
+	CodeNoActiveRental      = "no_active_rental"
+	CodeVehicleUnavailable  = "vehicle_unavailable"
+	CodeVIPRequired         = "vip_required"
+	CodeCancellationExpired = "cancellation_window_closed"
+	CodeUnpaidInvoices      = "unpaid_invoices"
+)
+
+// Idempotency-Key codes
+const (
+	CodeIdempotencyKeyReused = "idempotency_key_reused"
+	CodeIdempotencyKeyInUse  = "idempotency_key_in_use"
+)
+
+// RequestIDHeader carries the request ID on both requests and responses
+const RequestIDHeader = "X-Request-ID"
+
+// Error is the body of the envelope
+type Error struct {
+	Code      string            `json:"code"`
+	Message   string            `json:"message"`
+	Fields    map[string]string `json:"fields,omitempty"`
+	RequestID string            `json:"request_id,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Envelope wraps Error so error responses are easy to tell apart from data
+type Envelope struct {
+	Error *Error `json:"error"`
+}
+
+// Write sends an error response. The request ID is taken from the response header set by Middleware.
+func Write(w http.ResponseWriter, status int, code, message string) {
+	write(w, status, &Error{Code: code, Message: message})
+}
+
+// WriteError sends e as an error response with status
+func WriteError(w http.ResponseWriter, status int, e *Error) {
+	write(w, status, e)
+}
+
+// WriteFields sends a 422 response listing the reason each field was rejected
+func WriteFields(w http.ResponseWriter, message string, fields map[string]string) {
+	write(w, http.StatusUnprocessableEntity, &Error{Code: CodeValidationFailed, Message: message, Fields: fields})
+}
+
+func write(w http.ResponseWriter, status int, e *Error) {
+	e.RequestID = w.Header().Get(RequestIDHeader)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(Envelope{Error: e})
+}
+
+// Parse decodes an error response. Bodies that are not an envelope, for example from a proxy,
+// are returned as the message with a code derived from the status.
+func Parse(status int, body []byte) *Error {
+	var envelope Envelope
+	if err := json.Unmarshal(body, &envelope); err == nil && envelope.Error != nil && envelope.Error.Code != "" {
+		return envelope.Error
+	}
+	return &Error{Code: codeForStatus(status), Message: strings.TrimSpace(string(body))}
+}
+
+func codeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeInvalidRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusUnprocessableEntity:
+		return CodeValidationFailed
+	case http.StatusLocked:
+		return CodeAccountLocked
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return CodeServiceUnavailable
+	}
+	return CodeInternal
+}
+
+// Middleware gives every request an ID, reusing the caller's X-Request-ID when it looks sane so a
+// request can be followed across services. The ID is echoed in the response header.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = NewRequestID()
+			r.Header.Set(RequestIDHeader, id)
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id)))
+	})
+}
+
+type requestIDKey struct{}
+
+// RequestID returns the ID Middleware assigned to the request, or "" outside a request
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 64 {
+		return false
+	}
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// NewRequestID returns a random request ID, for callers starting a request of their own
+func NewRequestID() string {
+	raw := make([]byte, 8)
+	rand.Read(raw)
+	return hex.EncodeToString(raw)
+}
+
+// NotFoundHandler answers requests for unknown routes with the envelope
+func NotFoundHandler() http.Handler {
+	return Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Write(w, http.StatusNotFound, CodeNotFound, "No such endpoint")
+	}))
+}
+
+// MethodNotAllowedHandler answers requests using the wrong method with the envelope
+func MethodNotAllowedHandler() http.Handler {
+	return Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Write(w, http.StatusMethodNotAllowed, CodeMethodNotAllowed, "Method not allowed")
+	}))
+}
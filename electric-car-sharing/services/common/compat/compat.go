@@ -0,0 +1,321 @@
+// Package compat keeps the original, unversioned routes working on top of the /api/v2
+// handlers. A v1 request is rewritten into the v2 request it stands for, with IDs moved from
+// the query or body into the path, and answered by the v2 handler. Responses carry a
+// Deprecation header (RFC 9745) and a Link to the v2 successor, and keep the v1 status codes
+// and, where v2 changed them, the v1 bodies.
+package compat
+
+import (
+	"bytes"
+	"electric-car-sharing/services/common/apierror"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// Deprecated is when the v1 routes were deprecated in favour of /api/v2
+var Deprecated = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+// Route describes how a v1 route maps onto its v2 successor
+type Route struct {
+	// Successor is the v2 path template, e.g. /api/v2/users/{id}, filled from the rewritten
+	// request's path variables
+	Successor string
+	// Rewrites turn the v1 request into the v2 one, in order
+	Rewrites []Rewrite
+	// Status maps the status codes the v2 handler answers with to the ones the v1 route has
+	// always used, e.g. 201 to 200
+	Status map[int]int
+	// Body, if set, turns a successful v2 response body into the one the v1 route has always
+	// sent, e.g. a page of results into a bare list
+	Body func(body []byte) ([]byte, error)
+	// AllPages, for a v2 list answering with pages of results, follows page.next_cursor until
+	// the list is exhausted, so a v1 list that was never paged still gets every item. The
+	// response, and what Body is given, is a single page holding all of them.
+	AllPages bool
+}
+
+// Rewrite adapts part of a v1 request, setting the path variables the v2 handler reads in vars.
+// Returning a *Refusal answers the request with it; any other error is a 500.
+type Rewrite func(r *http.Request, vars map[string]string) error
+
+// Refusal is a Rewrite's reason for answering a request without passing it on
+type Refusal struct {
+	Status  int
+	Code    string
+	Message string
+}
+
+func (e *Refusal) Error() string {
+	return e.Message
+}
+
+// Handler serves a v1 route with next, the handler of its v2 successor
+func Handler(route Route, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", fmt.Sprintf("@%d", Deprecated.Unix()))
+
+		r = r.Clone(r.Context())
+		vars := make(map[string]string)
+		for name, value := range mux.Vars(r) {
+			vars[name] = value
+		}
+		for _, rewrite := range route.Rewrites {
+			if err := rewrite(r, vars); err != nil {
+				var refusal *Refusal
+				if errors.As(err, &refusal) {
+					apierror.Write(w, refusal.Status, refusal.Code, refusal.Message)
+					return
+				}
+				slog.ErrorContext(r.Context(), "Error adapting v1 request", "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Internal server error")
+				return
+			}
+		}
+
+		w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", expand(route.Successor, vars)))
+		if len(route.Status) > 0 {
+			w = &statusMapper{ResponseWriter: w, status: route.Status}
+		}
+		if route.Body == nil && !route.AllPages {
+			next.ServeHTTP(w, mux.SetURLVars(r, vars))
+			return
+		}
+
+		var status int
+		var body []byte
+		if route.AllPages {
+			var err error
+			if status, body, err = allPages(w, mux.SetURLVars(r, vars), next); err != nil {
+				slog.ErrorContext(r.Context(), "Error reading v2 page", "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Internal server error")
+				return
+			}
+		} else {
+			buffer := &responseBuffer{ResponseWriter: w}
+			next.ServeHTTP(buffer, mux.SetURLVars(r, vars))
+			if buffer.status == 0 {
+				buffer.status = http.StatusOK
+			}
+			status, body = buffer.status, buffer.body.Bytes()
+		}
+		if route.Body != nil && status >= 200 && status <= 299 {
+			var err error
+			if body, err = route.Body(body); err != nil {
+				slog.ErrorContext(r.Context(), "Error adapting v2 response", "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Internal server error")
+				return
+			}
+		}
+		w.Header().Del("Content-Length")
+		w.WriteHeader(status)
+		w.Write(body)
+	}
+}
+
+// page is the part of a v2 list response allPages reads and merges
+type page struct {
+	Data []json.RawMessage `json:"data"`
+	Page struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"page"`
+}
+
+// allPages serves r with next once per page of the list, following the next cursor, and returns
+// the status and a page holding every item. The first response that is not a successful page is
+// returned as it is.
+func allPages(w http.ResponseWriter, r *http.Request, next http.Handler) (int, []byte, error) {
+	items := []json.RawMessage{}
+	for {
+		buffer := &responseBuffer{ResponseWriter: w}
+		next.ServeHTTP(buffer, r)
+		if buffer.status == 0 {
+			buffer.status = http.StatusOK
+		}
+		if buffer.status < 200 || buffer.status > 299 {
+			return buffer.status, buffer.body.Bytes(), nil
+		}
+		var result page
+		if err := json.Unmarshal(buffer.body.Bytes(), &result); err != nil {
+			return 0, nil, err
+		}
+		items = append(items, result.Data...)
+		if result.Page.NextCursor == "" {
+			body, err := json.Marshal(map[string]interface{}{"data": items, "page": map[string]interface{}{}})
+			return buffer.status, body, err
+		}
+
+		r = r.Clone(r.Context())
+		query := r.URL.Query()
+		query.Set("cursor", result.Page.NextCursor)
+		r.URL.RawQuery = query.Encode()
+	}
+}
+
+// QueryToPath moves the positive integer query parameter param into the path variable name
+func QueryToPath(param, name string) Rewrite {
+	return func(r *http.Request, vars map[string]string) error {
+		id, err := QueryID(r, param)
+		if err != nil {
+			return err
+		}
+		vars[name] = strconv.Itoa(id)
+		return nil
+	}
+}
+
+// QueryToBody moves the positive integer query parameter param into field of the JSON body
+func QueryToBody(param, field string) Rewrite {
+	return func(r *http.Request, vars map[string]string) error {
+		id, err := QueryID(r, param)
+		if err != nil {
+			return err
+		}
+		body, err := readBody(r)
+		if err != nil {
+			return err
+		}
+		object := map[string]json.RawMessage{}
+		if len(bytes.TrimSpace(body)) > 0 {
+			if json.Unmarshal(body, &object) != nil {
+				// Left for the handler to refuse
+				setBody(r, body)
+				return nil
+			}
+		}
+		object[field] = json.RawMessage(strconv.Itoa(id))
+		body, err = json.Marshal(object)
+		if err != nil {
+			return err
+		}
+		setBody(r, body)
+		return nil
+	}
+}
+
+// BodyToPath copies the integer field of the JSON body into the path variable name. A missing
+// or malformed field becomes 0, so the v2 handler refuses it with its own message.
+func BodyToPath(field, name string) Rewrite {
+	return func(r *http.Request, vars map[string]string) error {
+		body, err := readBody(r)
+		if err != nil {
+			return err
+		}
+		setBody(r, body)
+		var object map[string]json.RawMessage
+		var id int64
+		if json.Unmarshal(body, &object) == nil && json.Unmarshal(object[field], &id) == nil {
+			vars[name] = strconv.FormatInt(id, 10)
+		} else {
+			vars[name] = "0"
+		}
+		return nil
+	}
+}
+
+// SetQuery sets the query parameter param to value, e.g. to give a v1 list the largest page
+func SetQuery(param, value string) Rewrite {
+	return func(r *http.Request, vars map[string]string) error {
+		query := r.URL.Query()
+		query.Set(param, value)
+		r.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
+// Unwrap is a Route Body that replaces a JSON object with the value of its field, e.g. a page of
+// results with its data
+func Unwrap(field string) func(body []byte) ([]byte, error) {
+	return func(body []byte) ([]byte, error) {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(body, &object); err != nil {
+			return nil, err
+		}
+		value, ok := object[field]
+		if !ok {
+			return nil, fmt.Errorf("response has no %q field", field)
+		}
+		return append(value, '\n'), nil
+	}
+}
+
+// QueryID reads the positive integer query parameter param, refusing the request if it is
+// missing or invalid. It is for Rewrites that look the v2 ID up from a v1 one.
+func QueryID(r *http.Request, param string) (int, error) {
+	value := r.URL.Query().Get(param)
+	if value == "" {
+		return 0, &Refusal{http.StatusBadRequest, apierror.CodeInvalidRequest, fmt.Sprintf("Query parameter '%s' is required", param)}
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil || id <= 0 {
+		return 0, &Refusal{http.StatusBadRequest, apierror.CodeInvalidRequest, fmt.Sprintf("Query parameter '%s' must be a positive integer", param)}
+	}
+	return id, nil
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	defer r.Body.Close()
+	return io.ReadAll(r.Body)
+}
+
+func setBody(r *http.Request, body []byte) {
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+}
+
+// expand fills the {name} variables of template from vars
+func expand(template string, vars map[string]string) string {
+	pairs := make([]string, 0, 2*len(vars))
+	for name, value := range vars {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(template)
+}
+
+// statusMapper rewrites the status codes written through it
+type statusMapper struct {
+	http.ResponseWriter
+	status map[int]int
+}
+
+func (s *statusMapper) WriteHeader(code int) {
+	if mapped, ok := s.status[code]; ok {
+		if code == http.StatusCreated {
+			// v1 never pointed at what it created
+			s.Header().Del("Location")
+		}
+		code = mapped
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+// responseBuffer holds back the response written through it so a Route Body can rewrite it
+type responseBuffer struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (b *responseBuffer) WriteHeader(code int) {
+	if b.status == 0 {
+		b.status = code
+	}
+}
+
+func (b *responseBuffer) Write(p []byte) (int, error) {
+	if b.status == 0 {
+		b.status = http.StatusOK
+	}
+	return b.body.Write(p)
+}
@@ -0,0 +1,332 @@
+// Package config loads the settings shared by the services and the console. Values start from
+// built-in defaults, are overridden by an optional YAML or TOML file named in CONFIG_FILE, and
+// finally by environment variables. Load validates the result so a bad setting stops the
+// process at startup instead of failing on the first request.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/go-sql-driver/mysql"
+	"gopkg.in/yaml.v3"
+)
+
+// Names of the processes that load a configuration
+const (
+	UserService    = "user"
+	VehicleService = "vehicle"
+	BillingService = "billing"
+	Console        = "console"
+)
+
+// Service is how to run and reach one service
+type Service struct {
+	// URL is where the other services and the console reach this service
+	URL string `yaml:"url" toml:"url"`
+	// ListenAddr is the address the service listens on
+	ListenAddr string `yaml:"listen_addr" toml:"listen_addr"`
+	// DSN is the MySQL DSN for the service's own database account
+	DSN string `yaml:"dsn" toml:"dsn"`
+}
+
+// Services lists every service
+type Services struct {
+	User    Service `yaml:"user" toml:"user"`
+	Vehicle Service `yaml:"vehicle" toml:"vehicle"`
+	Billing Service `yaml:"billing" toml:"billing"`
+}
+
+// Rentals holds the rental rules
+type Rentals struct {
+	// Timezone is the IANA time zone rental and invoice times are shown in for users who have
+	// not chosen their own
+	Timezone string `yaml:"timezone" toml:"timezone"`
+	// CancellationWindow is how long after the start a rental can still be cancelled
+	CancellationWindow time.Duration `yaml:"cancellation_window" toml:"cancellation_window"`
+
+	// Location is the loaded Timezone
+	Location *time.Location `yaml:"-" toml:"-"`
+}
+
+// Billing holds the pricing rules
+type Billing struct {
+	// OvertimeMultiplier is applied to the hourly rate for hours past a rental's end
+	OvertimeMultiplier float64 `yaml:"overtime_multiplier" toml:"overtime_multiplier"`
+}
+
+// Users holds the user service's settings. AdminToken also guards /admin/clock in the vehicle
+// and billing services' time travel builds.
+type Users struct {
+	TokenSecret      string `yaml:"token_secret" toml:"token_secret"`
+	PublicURL        string `yaml:"public_url" toml:"public_url"`
+	MailOutbox       string `yaml:"mail_outbox" toml:"mail_outbox"`
+	AdminToken       string `yaml:"admin_token" toml:"admin_token"`
+	LicenceUploadDir string `yaml:"licence_upload_dir" toml:"licence_upload_dir"`
+}
+
+// Log holds the logging settings
+type Log struct {
+	// Format is text, for people, or json, for log collectors
+	Format string `yaml:"format" toml:"format"`
+	// Level is the least severe level written: debug, info, warn or error
+	Level string `yaml:"level" toml:"level"`
+
+	// MinLevel is the parsed Level
+	MinLevel slog.Level `yaml:"-" toml:"-"`
+}
+
+// Tracing holds the OpenTelemetry tracing settings
+type Tracing struct {
+	// Exporter is where finished spans go: none, stdout, or otlp for an OTLP/HTTP collector
+	Exporter string `yaml:"exporter" toml:"exporter"`
+	// Endpoint is the collector's base URL when Exporter is otlp
+	Endpoint string `yaml:"endpoint" toml:"endpoint"`
+}
+
+// Config is the full configuration of one process
+type Config struct {
+	// Name is the process the configuration was loaded for
+	Name string `yaml:"-" toml:"-"`
+
+	Services      Services `yaml:"services" toml:"services"`
+	InternalToken string   `yaml:"internal_token" toml:"internal_token"`
+	// ShutdownTimeout is how long in-flight requests and background jobs get to finish when
+	// the service is stopped
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+	Rentals         Rentals       `yaml:"rentals" toml:"rentals"`
+	Billing         Billing       `yaml:"billing" toml:"billing"`
+	Users           Users         `yaml:"users" toml:"users"`
+	Log             Log           `yaml:"log" toml:"log"`
+	Tracing         Tracing       `yaml:"tracing" toml:"tracing"`
+}
+
+// Default returns the configuration used when nothing is overridden, which runs every
+// service on localhost
+func Default() Config {
+	return Config{
+		Services: Services{
+			User: Service{
+				URL:        "http://localhost:8080",
+				ListenAddr: ":8080",
+				DSN:        "user_service:user_service_password@tcp(127.0.0.1:3306)/electric_car_sharing",
+			},
+			Vehicle: Service{
+				URL:        "http://localhost:8081",
+				ListenAddr: ":8081",
+				DSN:        "vehicle_service:vehicle_service_password@tcp(127.0.0.1:3306)/electric_car_sharing",
+			},
+			Billing: Service{
+				URL:        "http://localhost:8082",
+				ListenAddr: ":8082",
+				DSN:        "billing_service:billing_service_password@tcp(127.0.0.1:3306)/electric_car_sharing",
+			},
+		},
+		ShutdownTimeout: 15 * time.Second,
+		Rentals: Rentals{
+			Timezone:           "Asia/Singapore",
+			CancellationWindow: time.Hour,
+		},
+		Billing: Billing{
+			OvertimeMultiplier: 1.5,
+		},
+		Users: Users{
+			PublicURL:        "http://localhost:8080",
+			LicenceUploadDir: "uploads/licences",
+		},
+		Log: Log{
+			Format: "text",
+			Level:  "info",
+		},
+		Tracing: Tracing{
+			Exporter: "none",
+			Endpoint: "http://localhost:4318",
+		},
+	}
+}
+
+// Load builds and validates the configuration for the named process
+func Load(name string) (*Config, error) {
+	cfg := Default()
+	cfg.Name = name
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Self returns the settings of the service the configuration was loaded for
+func (c *Config) Self() *Service {
+	switch c.Name {
+	case UserService:
+		return &c.Services.User
+	case VehicleService:
+		return &c.Services.Vehicle
+	case BillingService:
+		return &c.Services.Billing
+	}
+	return nil
+}
+
+// loadFile reads a YAML (.yaml, .yml) or TOML (.toml) file over the current values. Settings
+// missing from the file keep their current value.
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config: %w", err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, c)
+	case ".toml":
+		err = toml.Unmarshal(data, c)
+	default:
+		return fmt.Errorf("config: %s: unsupported file type, use .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("config: %s: %w", path, err)
+	}
+	return nil
+}
+
+// loadEnv applies environment variable overrides. DB_DSN and LISTEN_ADDR apply to the service
+// being started.
+func (c *Config) loadEnv() error {
+	if self := c.Self(); self != nil {
+		setString(&self.DSN, "DB_DSN")
+		setString(&self.ListenAddr, "LISTEN_ADDR")
+	}
+	setString(&c.Services.User.URL, "USER_SERVICE_URL")
+	setString(&c.Services.Vehicle.URL, "VEHICLE_SERVICE_URL")
+	setString(&c.Services.Billing.URL, "BILLING_SERVICE_URL")
+	setString(&c.InternalToken, "INTERNAL_TOKEN")
+	setString(&c.Rentals.Timezone, "TIMEZONE")
+	setString(&c.Users.TokenSecret, "TOKEN_SECRET")
+	setString(&c.Users.PublicURL, "PUBLIC_URL")
+	setString(&c.Users.MailOutbox, "MAIL_OUTBOX")
+	setString(&c.Users.AdminToken, "ADMIN_TOKEN")
+	setString(&c.Users.LicenceUploadDir, "LICENCE_UPLOAD_DIR")
+	setString(&c.Log.Format, "LOG_FORMAT")
+	setString(&c.Log.Level, "LOG_LEVEL")
+	setString(&c.Tracing.Exporter, "TRACE_EXPORTER")
+	setString(&c.Tracing.Endpoint, "TRACE_ENDPOINT")
+
+	if value := os.Getenv("CANCELLATION_WINDOW"); value != "" {
+		window, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("config: CANCELLATION_WINDOW: %w", err)
+		}
+		c.Rentals.CancellationWindow = window
+	}
+	if value := os.Getenv("SHUTDOWN_TIMEOUT"); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("config: SHUTDOWN_TIMEOUT: %w", err)
+		}
+		c.ShutdownTimeout = timeout
+	}
+	if value := os.Getenv("OVERTIME_MULTIPLIER"); value != "" {
+		multiplier, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("config: OVERTIME_MULTIPLIER: %w", err)
+		}
+		c.Billing.OvertimeMultiplier = multiplier
+	}
+	return nil
+}
+
+func setString(field *string, key string) {
+	if value := os.Getenv(key); value != "" {
+		*field = value
+	}
+}
+
+// Validate checks every setting the process uses and reports all problems at once. It also
+// loads Rentals.Location and Log.MinLevel.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	check(validateURL("services.user.url", c.Services.User.URL))
+	check(validateURL("services.vehicle.url", c.Services.Vehicle.URL))
+	check(validateURL("services.billing.url", c.Services.Billing.URL))
+
+	if self := c.Self(); self != nil {
+		prefix := "services." + c.Name
+		if _, _, err := net.SplitHostPort(self.ListenAddr); err != nil {
+			check(fmt.Errorf("%s.listen_addr: %q is not a host:port address", prefix, self.ListenAddr))
+		}
+		if _, err := mysql.ParseDSN(self.DSN); err != nil {
+			check(fmt.Errorf("%s.dsn: %v", prefix, err))
+		}
+		if c.ShutdownTimeout <= 0 {
+			check(fmt.Errorf("shutdown_timeout: must be positive, got %s", c.ShutdownTimeout))
+		}
+	}
+
+	location, err := time.LoadLocation(c.Rentals.Timezone)
+	if err != nil || c.Rentals.Timezone == "" {
+		check(fmt.Errorf("rentals.timezone: unknown time zone %q", c.Rentals.Timezone))
+	} else {
+		c.Rentals.Location = location
+	}
+	if c.Rentals.CancellationWindow <= 0 {
+		check(fmt.Errorf("rentals.cancellation_window: must be positive, got %s", c.Rentals.CancellationWindow))
+	}
+	if c.Billing.OvertimeMultiplier < 1 {
+		check(fmt.Errorf("billing.overtime_multiplier: must be at least 1, got %g", c.Billing.OvertimeMultiplier))
+	}
+	if c.Log.Format != "text" && c.Log.Format != "json" {
+		check(fmt.Errorf("log.format: must be text or json, got %q", c.Log.Format))
+	}
+	if err := c.Log.MinLevel.UnmarshalText([]byte(c.Log.Level)); err != nil {
+		check(fmt.Errorf("log.level: must be debug, info, warn or error, got %q", c.Log.Level))
+	}
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		check(validateURL("tracing.endpoint", c.Tracing.Endpoint))
+	default:
+		check(fmt.Errorf("tracing.exporter: must be none, stdout or otlp, got %q", c.Tracing.Exporter))
+	}
+	if c.Name == UserService {
+		check(validateURL("users.public_url", c.Users.PublicURL))
+		if c.Users.LicenceUploadDir == "" {
+			check(errors.New("users.licence_upload_dir: must not be empty"))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("config: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func validateURL(name, value string) error {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s: %q is not an http or https URL", name, value)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// env is every variable Load reads, cleared so the test's environment does not leak in
+var env = []string{
+	"CONFIG_FILE", "DB_DSN", "LISTEN_ADDR", "USER_SERVICE_URL", "VEHICLE_SERVICE_URL", "BILLING_SERVICE_URL",
+	"INTERNAL_TOKEN", "TIMEZONE", "TOKEN_SECRET", "PUBLIC_URL", "MAIL_OUTBOX", "ADMIN_TOKEN",
+	"LICENCE_UPLOAD_DIR", "LOG_FORMAT", "LOG_LEVEL", "TRACE_EXPORTER", "TRACE_ENDPOINT",
+	"CANCELLATION_WINDOW", "SHUTDOWN_TIMEOUT", "OVERTIME_MULTIPLIER",
+}
+
+// setup clears the environment and, if file is set, writes it as the CONFIG_FILE named name
+func setup(t *testing.T, name, file string) {
+	t.Helper()
+	for _, key := range env {
+		t.Setenv(key, "")
+	}
+	if file == "" {
+		return
+	}
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(file), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CONFIG_FILE", path)
+}
+
+func TestDefaultsAreValid(t *testing.T) {
+	setup(t, "", "")
+	for _, name := range []string{UserService, VehicleService, BillingService, Console} {
+		cfg, err := Load(name)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if cfg.Rentals.Location == nil || cfg.Rentals.Location.String() != "Asia/Singapore" {
+			t.Errorf("%s: rentals.timezone was not loaded: %v", name, cfg.Rentals.Location)
+		}
+	}
+}
+
+func TestLoadReadsYAMLAndTOML(t *testing.T) {
+	for name, file := range map[string]string{
+		"config.yaml": `
+internal_token: from-file
+rentals:
+  timezone: Europe/London
+  cancellation_window: 30m
+billing:
+  overtime_multiplier: 2
+services:
+  vehicle:
+    listen_addr: ":9081"
+log:
+  level: debug
+`,
+		"config.toml": `
+internal_token = "from-file"
+
+[rentals]
+timezone = "Europe/London"
+cancellation_window = "30m"
+
+[billing]
+overtime_multiplier = 2.0
+
+[services.vehicle]
+listen_addr = ":9081"
+
+[log]
+level = "debug"
+`,
+	} {
+		setup(t, name, file)
+		cfg, err := Load(VehicleService)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if cfg.InternalToken != "from-file" || cfg.Rentals.Location.String() != "Europe/London" ||
+			cfg.Rentals.CancellationWindow != 30*time.Minute || cfg.Billing.OvertimeMultiplier != 2 ||
+			cfg.Self().ListenAddr != ":9081" || cfg.Log.MinLevel.String() != "DEBUG" {
+			t.Errorf("%s: settings were not read: %+v", name, cfg)
+		}
+		// Settings the file leaves out keep their defaults
+		if cfg.Services.Vehicle.URL != "http://localhost:8081" || cfg.ShutdownTimeout != 15*time.Second {
+			t.Errorf("%s: defaults were lost: %+v", name, cfg)
+		}
+	}
+}
+
+func TestEnvironmentOverridesTheFile(t *testing.T) {
+	setup(t, "config.yaml", `
+internal_token: from-file
+rentals:
+  cancellation_window: 30m
+services:
+  billing:
+    listen_addr: ":9082"
+`)
+	t.Setenv("INTERNAL_TOKEN", "from-env")
+	t.Setenv("CANCELLATION_WINDOW", "45m")
+	t.Setenv("LISTEN_ADDR", ":7082")
+
+	cfg, err := Load(BillingService)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.InternalToken != "from-env" || cfg.Rentals.CancellationWindow != 45*time.Minute || cfg.Services.Billing.ListenAddr != ":7082" {
+		t.Errorf("environment did not take precedence: %+v", cfg)
+	}
+}
+
+func TestInvalidSettingsRefuseStartup(t *testing.T) {
+	for _, tc := range []struct {
+		what    string
+		name    string
+		file    string
+		env     map[string]string
+		wantErr []string
+	}{
+		{what: "every problem reported at once", name: "config.yaml", file: `
+rentals:
+  timezone: Mars/Olympus
+billing:
+  overtime_multiplier: 0.5
+log:
+  format: xml
+`, wantErr: []string{"rentals.timezone", "billing.overtime_multiplier", "log.format"}},
+		{what: "bad environment value", env: map[string]string{"LISTEN_ADDR": "8081", "TRACE_EXPORTER": "otlp", "TRACE_ENDPOINT": "localhost:4318"},
+			wantErr: []string{"services.vehicle.listen_addr", "tracing.endpoint"}},
+		{what: "unparsable duration", env: map[string]string{"SHUTDOWN_TIMEOUT": "soon"}, wantErr: []string{"SHUTDOWN_TIMEOUT"}},
+		{what: "malformed file", name: "config.yaml", file: "rentals: [", wantErr: []string{"config.yaml"}},
+		{what: "unsupported file type", name: "config.json", file: "{}", wantErr: []string{"unsupported file type"}},
+	} {
+		setup(t, tc.name, tc.file)
+		for key, value := range tc.env {
+			t.Setenv(key, value)
+		}
+		cfg, err := Load(VehicleService)
+		if err == nil {
+			t.Errorf("%s: loaded %+v, want an error", tc.what, cfg)
+			continue
+		}
+		for _, want := range tc.wantErr {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("%s: error %q does not mention %s", tc.what, err, want)
+			}
+		}
+	}
+}
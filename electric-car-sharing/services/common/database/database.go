@@ -0,0 +1,51 @@
+// Package database opens a service's MySQL connection
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/XSAM/otelsql"
+	"github.com/go-sql-driver/mysql"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Open connects to MySQL and checks the connection. Each service connects with its own account,
+// which is only granted access to the tables that service owns. Every query is traced as a span
+// of the request that ran it.
+func Open(dsn string) (*sql.DB, error) {
+	db, err := otelsql.Open("mysql", dsn,
+		otelsql.WithAttributes(semconv.DBSystemMySQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitConnPrepare:      true,
+			OmitRows:             true,
+			OmitConnectorConnect: true,
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("database connection error: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("database ping error: %w", err)
+	}
+	return db, nil
+}
+
+// OpenUTC is Open for services that read DATETIME and TIMESTAMP columns into time.Time. Whatever
+// the DSN says, it sets parseTime, reads and writes times as UTC, and sets the session time zone
+// to UTC so the server's own zone cannot shift TIMESTAMP columns.
+func OpenUTC(dsn string) (*sql.DB, error) {
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("database connection error: %w", err)
+	}
+	cfg.ParseTime = true
+	cfg.Loc = time.UTC
+	if cfg.Params == nil {
+		cfg.Params = map[string]string{}
+	}
+	cfg.Params["time_zone"] = "'+00:00'"
+	return Open(cfg.FormatDSN())
+}
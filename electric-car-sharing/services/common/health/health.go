@@ -0,0 +1,178 @@
+// Package health serves the probe endpoints every service exposes. /healthz reports whether the
+// service itself can work: its database is reachable and at the expected schema version.
+// /readyz adds the services it calls, so a load balancer only routes to a service whose
+// dependencies are up.
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Timeout bounds each check so one slow dependency cannot hang the probe
+const Timeout = 2 * time.Second
+
+// Check is one thing a probe verifies. Run returns a short detail to show when it passes.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) (string, error)
+}
+
+// Result is the outcome of one check
+type Result struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMS float64 `json:"latency_ms"`
+	Detail    string  `json:"detail,omitempty"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Report is the body of a probe response
+type Report struct {
+	Service string   `json:"service"`
+	Status  string   `json:"status"`
+	Checks  []Result `json:"checks"`
+}
+
+// Checker runs the checks of one service
+type Checker struct {
+	service    string
+	local      []Check
+	downstream []Check
+}
+
+// New creates a checker for the named service
+func New(service string) *Checker {
+	return &Checker{service: service}
+}
+
+// AddLocal adds a check reported by both /healthz and /readyz
+func (c *Checker) AddLocal(check Check) {
+	c.local = append(c.local, check)
+}
+
+// AddDownstream adds a check reported by /readyz only
+func (c *Checker) AddDownstream(check Check) {
+	c.downstream = append(c.downstream, check)
+}
+
+// Healthz serves the local checks
+func (c *Checker) Healthz() http.HandlerFunc {
+	return c.handler(c.local)
+}
+
+// Readyz serves the local and downstream checks
+func (c *Checker) Readyz() http.HandlerFunc {
+	checks := append(append([]Check{}, c.local...), c.downstream...)
+	return c.handler(checks)
+}
+
+func (c *Checker) handler(checks []Check) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := c.run(r.Context(), checks)
+		code := http.StatusOK
+		if report.Status != "ok" {
+			code = http.StatusServiceUnavailable
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(report)
+	}
+}
+
+// run executes the checks concurrently and collects the results in order
+func (c *Checker) run(ctx context.Context, checks []Check) Report {
+	report := Report{Service: c.service, Status: "ok", Checks: make([]Result, len(checks))}
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, Timeout)
+			defer cancel()
+
+			start := time.Now()
+			detail, err := check.Run(checkCtx)
+			result := Result{
+				Name:      check.Name,
+				Status:    "ok",
+				LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+				Detail:    detail,
+			}
+			if err != nil {
+				slog.WarnContext(ctx, "Health check failed", "check", check.Name, "err", err)
+				result.Status = "failed"
+				result.Detail = ""
+				result.Error = err.Error()
+			}
+			report.Checks[i] = result
+		}(i, check)
+	}
+	wg.Wait()
+
+	for _, result := range report.Checks {
+		if result.Status != "ok" {
+			report.Status = "unavailable"
+		}
+	}
+	return report
+}
+
+// Database checks that the database answers a ping
+func Database(db *sql.DB) Check {
+	return Check{Name: "database", Run: func(ctx context.Context) (string, error) {
+		if err := db.PingContext(ctx); err != nil {
+			return "", err
+		}
+		stats := db.Stats()
+		return fmt.Sprintf("%d open connections, %d in use", stats.OpenConnections, stats.InUse), nil
+	}}
+}
+
+// SchemaVersion checks that the database schema has been migrated to at least want, so the
+// service does not take traffic against tables it does not understand
+func SchemaVersion(db *sql.DB, want int64) Check {
+	return Check{Name: "migrations", Run: func(ctx context.Context) (string, error) {
+		var version sql.NullInt64
+		if err := db.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_migrations").Scan(&version); err != nil {
+			return "", err
+		}
+		if !version.Valid {
+			return "", fmt.Errorf("no migrations applied, want version %d", want)
+		}
+		if version.Int64 < want {
+			return "", fmt.Errorf("schema is at version %d, want %d", version.Int64, want)
+		}
+		return fmt.Sprintf("version %d", version.Int64), nil
+	}}
+}
+
+var client = &http.Client{Timeout: Timeout}
+
+// Service checks that another service's /healthz passes
+func Service(name, baseURL string) Check {
+	url := strings.TrimRight(baseURL, "/") + "/healthz"
+	return Check{Name: name + " service", Run: func(ctx context.Context) (string, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return "", err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("%s answered %s", url, resp.Status)
+		}
+		return "", nil
+	}}
+}
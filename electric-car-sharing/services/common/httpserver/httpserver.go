@@ -0,0 +1,35 @@
+// Package httpserver holds the router and server setup shared by every service
+package httpserver
+
+import (
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/logging"
+	"electric-car-sharing/services/common/tracing"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// NewRouter creates a router that tags every request with an ID, traces it, and answers unknown
+// routes with the JSON error envelope
+func NewRouter() *mux.Router {
+	router := mux.NewRouter()
+	router.Use(apierror.Middleware, tracing.Middleware)
+	router.NotFoundHandler = apierror.NotFoundHandler()
+	router.MethodNotAllowedHandler = apierror.MethodNotAllowedHandler()
+	return router
+}
+
+// NewServer creates a server for handler with timeouts, so a slow client cannot hold a
+// connection open forever. Every request is logged once answered.
+func NewServer(addr string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           logging.Middleware(handler),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+	}
+}
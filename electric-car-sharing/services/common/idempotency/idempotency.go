@@ -0,0 +1,272 @@
+// Package idempotency makes retried requests safe. A client that sends a POST, PUT, PATCH or
+// DELETE with an Idempotency-Key header and gets no answer, e.g. after a timeout, can send the
+// same request again with the same key: the first response is stored per key and user and
+// replayed, rather than the request being handled twice. A key reused for a different request
+// is refused.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/clock"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// Header carries the key chosen by the client, unique per operation it means to perform
+	Header = "Idempotency-Key"
+	// ReplayedHeader is set to true on a stored response sent again
+	ReplayedHeader = "Idempotent-Replayed"
+	// MaxKeyLength is the longest key accepted
+	MaxKeyLength = 255
+	// TTL is how long a response is kept; after it the key may be used for a new request
+	TTL = 24 * time.Hour
+)
+
+// UserFunc finds the user a request is made for, given its body, or 0 if it names none
+type UserFunc func(r *http.Request, body []byte) (int, error)
+
+// Install makes the mutating routes of router idempotent, keeping responses in store. Records
+// are dated by clk and keyed by the user user finds. Install it after openapi.Install so
+// requests that do not match the API description are refused before a key is taken.
+func Install(router *mux.Router, store Store, clk clock.Clock, user UserFunc) {
+	router.Use(Middleware(store, clk, user))
+}
+
+// Middleware answers retried requests with their stored response. Requests without a key, and
+// reads, are passed on untouched. Responses with a 5xx status are not kept, so the request can be
+// retried once the failure is fixed.
+func Middleware(store Store, clk clock.Clock, user UserFunc) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(Header)
+			if key == "" || !mutating(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !validKey(key) {
+				apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest,
+					"Header 'Idempotency-Key' must be 1 to "+strconv.Itoa(MaxKeyLength)+" printable ASCII characters")
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Failed to read request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			userID, err := user(r, body)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "Error finding the user of an idempotent request", "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Internal server error")
+				return
+			}
+			record := Record{Key: key, UserID: userID, Hash: hash(r, body), CreatedAt: clk.Now()}
+			held, reserved, err := store.Reserve(r.Context(), record, record.CreatedAt.Add(-TTL))
+			if err != nil {
+				slog.ErrorContext(r.Context(), "Error reserving idempotency key", "user_id", userID, "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Internal server error")
+				return
+			}
+			if !reserved {
+				replay(w, held, record.Hash)
+				return
+			}
+			handle(w, r, next, store, record)
+		})
+	}
+}
+
+// PurgeExpired returns a background job for lifecycle.Manager.Go that deletes the records past
+// their TTL from store every hour, until ctx is cancelled. A key that is reused deletes its own
+// expired record; this keeps the ones never reused from piling up.
+func PurgeExpired(store Store, clk clock.Clock) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			purged, err := store.Purge(ctx, clk.Now().Add(-TTL))
+			if err != nil {
+				slog.Error("Purging expired idempotency keys failed", "err", err)
+			} else if purged > 0 {
+				slog.Info("Purged expired idempotency keys", "count", purged)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}
+}
+
+// handle passes a request on and stores its response under the reserved record. If the response
+// is not stored the key is released.
+func handle(w http.ResponseWriter, r *http.Request, next http.Handler, store Store, record Record) {
+	// The record outlives a client that hangs up
+	ctx := context.WithoutCancel(r.Context())
+	completed := false
+	defer func() {
+		if !completed {
+			if err := store.Release(ctx, record.Key, record.UserID); err != nil {
+				slog.ErrorContext(ctx, "Error releasing idempotency key", "user_id", record.UserID, "err", err)
+			}
+		}
+	}()
+
+	state := &handling{}
+	before := w.Header().Clone()
+	recorder := &recorder{ResponseWriter: w}
+	next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), stateKey{}, state)))
+	if recorder.status == 0 {
+		recorder.status = http.StatusOK
+	}
+	if recorder.status >= 500 {
+		return
+	}
+
+	record.Status = recorder.status
+	record.Header = http.Header{}
+	for name, values := range w.Header() {
+		if !slices.Equal(before[name], values) {
+			record.Header[name] = values
+		}
+	}
+	if state.withheld && record.Status < 300 {
+		record.Withheld = true
+	} else {
+		record.Body = recorder.body.Bytes()
+	}
+	if err := store.Complete(ctx, record); err != nil {
+		slog.ErrorContext(ctx, "Error storing idempotent response", "user_id", record.UserID, "err", err)
+		return
+	}
+	completed = true
+}
+
+// replay answers a retry with the response stored in held, or refuses it
+func replay(w http.ResponseWriter, held Record, hash string) {
+	switch {
+	case held.Hash != hash:
+		apierror.Write(w, http.StatusUnprocessableEntity, apierror.CodeIdempotencyKeyReused,
+			"This Idempotency-Key was already used for a different request")
+	case held.Status == 0:
+		w.Header().Set("Retry-After", "1")
+		apierror.Write(w, http.StatusConflict, apierror.CodeIdempotencyKeyInUse,
+			"A request with this Idempotency-Key is still being handled")
+	case held.Withheld:
+		apierror.Write(w, http.StatusConflict, apierror.CodeConflict,
+			"This request was already handled and its response is only shown once")
+	default:
+		for name, values := range held.Header {
+			w.Header()[name] = values
+		}
+		w.Header().Set(ReplayedHeader, "true")
+		w.WriteHeader(held.Status)
+		w.Write(held.Body)
+	}
+}
+
+type stateKey struct{}
+
+// handling is what a handler has said about the response to an idempotent request
+type handling struct {
+	withheld bool
+}
+
+// Withhold keeps the successful response to the request with ctx from being stored, for handlers
+// that answer with secrets shown only once, e.g. recovery codes. A retry is refused instead of
+// replayed.
+func Withhold(ctx context.Context) {
+	if state, ok := ctx.Value(stateKey{}).(*handling); ok {
+		state.withheld = true
+	}
+}
+
+// RequestUser is the user a request names: the {id} of a /users/{id} route, the user_id or userid
+// query parameter, or the user_id field of a JSON body
+func RequestUser(r *http.Request, body []byte) (int, error) {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil && strings.Contains(template, "/users/{id") {
+			id, _ := strconv.Atoi(mux.Vars(r)["id"])
+			return id, nil
+		}
+	}
+	query := r.URL.Query()
+	for _, param := range []string{"user_id", "userid"} {
+		if id, err := strconv.Atoi(query.Get(param)); err == nil {
+			return id, nil
+		}
+	}
+	var fields struct {
+		UserID int `json:"user_id"`
+	}
+	if json.Unmarshal(body, &fields) == nil {
+		return fields.UserID, nil
+	}
+	return 0, nil
+}
+
+func mutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func validKey(key string) bool {
+	if len(key) > MaxKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// hash identifies a request by its method, path, query and body
+func hash(r *http.Request, body []byte) string {
+	sum := sha256.New()
+	io.WriteString(sum, r.Method+" "+r.URL.RequestURI()+"\n")
+	sum.Write(body)
+	return hex.EncodeToString(sum.Sum(nil))
+}
+
+// recorder passes a response on while keeping a copy of it
+type recorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *recorder) WriteHeader(code int) {
+	if rec.status == 0 {
+		rec.status = code
+	}
+	rec.ResponseWriter.WriteHeader(code)
+}
+
+func (rec *recorder) Write(p []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	rec.body.Write(p)
+	return rec.ResponseWriter.Write(p)
+}
@@ -0,0 +1,136 @@
+package idempotency
+
+import (
+	"context"
+	"electric-car-sharing/services/common/clock"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// server counts the requests that reach its handler, which answers with status. If release is
+// set, the handler signals started and waits for release to be closed before answering.
+type server struct {
+	router  *mux.Router
+	clock   *clock.Fake
+	handled int
+	status  int
+	started chan struct{}
+	release chan struct{}
+}
+
+func newServer() *server {
+	s := &server{
+		router: mux.NewRouter(),
+		clock:  clock.NewFake(time.Date(2030, time.March, 4, 9, 0, 0, 0, time.UTC)),
+		status: http.StatusCreated,
+	}
+	Install(s.router, NewMemory(), s.clock, RequestUser)
+	s.router.HandleFunc("/users/{id:[0-9]+}/things", func(w http.ResponseWriter, r *http.Request) {
+		s.handled++
+		if s.release != nil {
+			close(s.started)
+			<-s.release
+		}
+		w.WriteHeader(s.status)
+		w.Write([]byte(`{"n":` + strconv.Itoa(s.handled) + `}`))
+	}).Methods("POST", "GET")
+	return s
+}
+
+func (s *server) do(method, path, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(Header, key)
+	}
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRetryIsReplayed(t *testing.T) {
+	s := newServer()
+	first := s.do("POST", "/users/1/things", "k", `{"a":1}`)
+	retry := s.do("POST", "/users/1/things", "k", `{"a":1}`)
+	if s.handled != 1 || retry.Code != http.StatusCreated || retry.Body.String() != first.Body.String() || retry.Header().Get(ReplayedHeader) != "true" {
+		t.Fatalf("handled %d times, retry got %d %q replayed %q", s.handled, retry.Code, retry.Body, retry.Header().Get(ReplayedHeader))
+	}
+
+	if rec := s.do("POST", "/users/1/things", "k", `{"a":2}`); rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("key reused for another body: got %d, want 422", rec.Code)
+	}
+	if rec := s.do("POST", "/users/2/things", "k", `{"a":1}`); rec.Code != http.StatusCreated || s.handled != 2 {
+		t.Errorf("same key for another user: got %d after %d requests, want it handled", rec.Code, s.handled)
+	}
+	s.do("POST", "/users/1/things", "", `{"a":1}`)
+	s.do("GET", "/users/1/things", "k", "")
+	if s.handled != 4 {
+		t.Errorf("requests without a key and reads were not all handled: %d", s.handled)
+	}
+
+	// After the TTL the key is free again
+	s.clock.Advance(TTL + time.Second)
+	if rec := s.do("POST", "/users/1/things", "k", `{"a":2}`); rec.Code != http.StatusCreated || s.handled != 5 {
+		t.Errorf("expired key: got %d after %d requests, want it handled", rec.Code, s.handled)
+	}
+}
+
+func TestServerErrorsAreNotKept(t *testing.T) {
+	s := newServer()
+	s.status = http.StatusServiceUnavailable
+	s.do("POST", "/users/1/things", "k", `{}`)
+	s.status = http.StatusCreated
+	if rec := s.do("POST", "/users/1/things", "k", `{}`); rec.Code != http.StatusCreated || s.handled != 2 {
+		t.Fatalf("retry after a 503: got %d after %d requests, want it handled again", rec.Code, s.handled)
+	}
+}
+
+func TestConcurrentRetryIsRefused(t *testing.T) {
+	s := newServer()
+	s.started, s.release = make(chan struct{}), make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		s.do("POST", "/users/1/things", "k", `{}`)
+		close(done)
+	}()
+	<-s.started
+	if rec := s.do("POST", "/users/1/things", "k", `{}`); rec.Code != http.StatusConflict {
+		t.Errorf("retry while the first request is handled: got %d, want 409", rec.Code)
+	}
+	close(s.release)
+	<-done
+	s.release = nil
+	if rec := s.do("POST", "/users/1/things", "k", `{}`); rec.Header().Get(ReplayedHeader) != "true" {
+		t.Fatalf("retry once the first request finished: got %d, want it replayed", rec.Code)
+	}
+}
+
+func TestInvalidKeyIsRefused(t *testing.T) {
+	s := newServer()
+	for _, key := range []string{strings.Repeat("k", MaxKeyLength+1), "café"} {
+		if rec := s.do("POST", "/users/1/things", key, `{}`); rec.Code != http.StatusBadRequest {
+			t.Errorf("key %q: got %d, want 400", key, rec.Code)
+		}
+	}
+	if s.handled != 0 {
+		t.Errorf("%d requests with invalid keys were handled", s.handled)
+	}
+}
+
+func TestPurgeDeletesExpiredRecords(t *testing.T) {
+	store := NewMemory()
+	now := time.Date(2030, time.March, 4, 9, 0, 0, 0, time.UTC)
+	store.Reserve(context.Background(), Record{Key: "old", CreatedAt: now.Add(-TTL - time.Minute)}, now.Add(-TTL))
+	store.Reserve(context.Background(), Record{Key: "new", CreatedAt: now}, now.Add(-TTL))
+	if purged, err := store.Purge(context.Background(), now.Add(-TTL)); err != nil || purged != 1 {
+		t.Fatalf("got %d purged, %v; want 1", purged, err)
+	}
+	if _, reserved, _ := store.Reserve(context.Background(), Record{Key: "new", CreatedAt: now}, now.Add(-TTL)); reserved {
+		t.Fatal("the record within its TTL was purged")
+	}
+}
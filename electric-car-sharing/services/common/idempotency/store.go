@@ -0,0 +1,178 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Record is the first request made with a key, and its response once there is one
+type Record struct {
+	Key    string
+	UserID int
+	// Hash identifies the request by its method, path, query and body
+	Hash      string
+	CreatedAt time.Time
+	// Status is zero while the request is being handled
+	Status int
+	Header http.Header
+	Body   []byte
+	// Withheld marks a response that was not kept, see Withhold
+	Withheld bool
+}
+
+// Store keeps the requests made with each key, per user
+type Store interface {
+	// Reserve stores record, marking its request as being handled, unless the user's key is
+	// already taken. It returns the record holding the key and whether it is the new one. Records
+	// created before expired no longer hold their key.
+	Reserve(ctx context.Context, record Record, expired time.Time) (Record, bool, error)
+	// Complete stores the response of a reserved request
+	Complete(ctx context.Context, record Record) error
+	// Release forgets a reserved request, so its key can be used again
+	Release(ctx context.Context, key string, userID int) error
+	// Purge deletes the records created before expired and returns how many there were
+	Purge(ctx context.Context, expired time.Time) (int, error)
+}
+
+// MySQL keeps records in a table created by the schema migrations, one per service
+type MySQL struct {
+	db    *sql.DB
+	table string
+}
+
+var _ Store = (*MySQL)(nil)
+
+// NewMySQL creates a store backed by table, e.g. vehicle_idempotency_keys
+func NewMySQL(db *sql.DB, table string) *MySQL {
+	return &MySQL{db: db, table: table}
+}
+
+// Reserve inserts the record unless the key is taken. (idempotency_key, user_id) is the primary
+// key, so of two requests racing for a key only one inserts. The held record is returned without
+// its CreatedAt.
+func (s *MySQL) Reserve(ctx context.Context, record Record, expired time.Time) (Record, bool, error) {
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM "+s.table+" WHERE idempotency_key = ? AND user_id = ? AND created_at < ?",
+		record.Key, record.UserID, expired.UTC()); err != nil {
+		return record, false, err
+	}
+	result, err := s.db.ExecContext(ctx, `
+		INSERT INTO `+s.table+` (idempotency_key, user_id, request_hash, created_at)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE idempotency_key = idempotency_key`,
+		record.Key, record.UserID, record.Hash, record.CreatedAt.UTC().Truncate(time.Second))
+	if err != nil {
+		return record, false, err
+	}
+	if created, _ := result.RowsAffected(); created == 1 {
+		return record, true, nil
+	}
+
+	held := Record{Key: record.Key, UserID: record.UserID}
+	var status sql.NullInt64
+	var header sql.NullString
+	err = s.db.QueryRowContext(ctx, "SELECT request_hash, status_code, response_headers, response_body, withheld FROM "+s.table+
+		" WHERE idempotency_key = ? AND user_id = ?", record.Key, record.UserID).
+		Scan(&held.Hash, &status, &header, &held.Body, &held.Withheld)
+	if err != nil {
+		return held, false, err
+	}
+	held.Status = int(status.Int64)
+	if header.Valid {
+		if err := json.Unmarshal([]byte(header.String), &held.Header); err != nil {
+			return held, false, err
+		}
+	}
+	return held, false, nil
+}
+
+// Complete stores the response
+func (s *MySQL) Complete(ctx context.Context, record Record) error {
+	header, err := json.Marshal(record.Header)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, "UPDATE "+s.table+" SET status_code = ?, response_headers = ?, response_body = ?, withheld = ?"+
+		" WHERE idempotency_key = ? AND user_id = ?", record.Status, string(header), record.Body, record.Withheld, record.Key, record.UserID)
+	return err
+}
+
+// Release deletes the record
+func (s *MySQL) Release(ctx context.Context, key string, userID int) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM "+s.table+" WHERE idempotency_key = ? AND user_id = ?", key, userID)
+	return err
+}
+
+// Purge deletes the expired records
+func (s *MySQL) Purge(ctx context.Context, expired time.Time) (int, error) {
+	result, err := s.db.ExecContext(ctx, "DELETE FROM "+s.table+" WHERE created_at < ?", expired.UTC())
+	if err != nil {
+		return 0, err
+	}
+	purged, err := result.RowsAffected()
+	return int(purged), err
+}
+
+type memoryKey struct {
+	key    string
+	userID int
+}
+
+// Memory keeps records in a map. It is safe for concurrent use and is meant for tests and local
+// development.
+type Memory struct {
+	mu      sync.Mutex
+	records map[memoryKey]Record
+}
+
+var _ Store = (*Memory)(nil)
+
+// NewMemory creates an empty store
+func NewMemory() *Memory {
+	return &Memory{records: map[memoryKey]Record{}}
+}
+
+// Reserve stores the record unless the key is taken
+func (s *Memory) Reserve(ctx context.Context, record Record, expired time.Time) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := memoryKey{record.Key, record.UserID}
+	if held, ok := s.records[id]; ok && !held.CreatedAt.Before(expired) {
+		return held, false, nil
+	}
+	s.records[id] = record
+	return record, true, nil
+}
+
+// Complete stores the response
+func (s *Memory) Complete(ctx context.Context, record Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[memoryKey{record.Key, record.UserID}] = record
+	return nil
+}
+
+// Release deletes the record
+func (s *Memory) Release(ctx context.Context, key string, userID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, memoryKey{key, userID})
+	return nil
+}
+
+// Purge deletes the expired records
+func (s *Memory) Purge(ctx context.Context, expired time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	purged := 0
+	for id, record := range s.records {
+		if record.CreatedAt.Before(expired) {
+			delete(s.records, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
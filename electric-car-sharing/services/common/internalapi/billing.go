@@ -0,0 +1,60 @@
+package internalapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Invoice is an invoice as seen by the other services. CreatedAt is in UTC.
+type Invoice struct {
+	ID           int       `json:"id"`
+	UserID       int       `json:"user_id"`
+	RentalID     int       `json:"rental_id"`
+	Hours        int       `json:"hours"`
+	HoursOverdue int       `json:"hours_overdue"`
+	FinalCost    float64   `json:"final_cost"`
+	PaidStatus   bool      `json:"paid_status"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// RentalCharge describes a completed rental for the billing service to invoice. Billing applies
+// the membership discount and the overtime rate.
+type RentalCharge struct {
+	UserID       int     `json:"user_id"`
+	RentalID     int     `json:"rental_id"`
+	Hours        int     `json:"hours"`
+	HoursOverdue int     `json:"hours_overdue"`
+	CostPerHour  float64 `json:"cost_per_hour"`
+}
+
+// Billing calls the billing service
+type Billing struct {
+	client
+}
+
+// NewBilling creates a client for the billing service at baseURL
+func NewBilling(baseURL, token string) *Billing {
+	return &Billing{newClient("billing", baseURL, token)}
+}
+
+// InvoiceRental creates the invoice for a rental. It is safe to retry: a rental is only ever
+// invoiced once and a repeat call returns the existing invoice.
+func (b *Billing) InvoiceRental(ctx context.Context, charge RentalCharge) (*Invoice, error) {
+	var invoice Invoice
+	if err := b.do(ctx, "POST", "/internal/invoices", charge, &invoice); err != nil {
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// UserInvoices fetches every invoice of the user, oldest first
+func (b *Billing) UserInvoices(ctx context.Context, userID int) ([]Invoice, error) {
+	var resp struct {
+		Invoices []Invoice `json:"invoices"`
+	}
+	if err := b.do(ctx, "GET", fmt.Sprintf("/internal/users/%d/invoices", userID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Invoices, nil
+}
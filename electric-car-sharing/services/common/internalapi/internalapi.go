@@ -0,0 +1,117 @@
+// Package internalapi is how the services talk to each other. Each service owns its tables and
+// exposes the data other services need under /internal/, protected by a shared token.
+package internalapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"electric-car-sharing/services/common/apierror"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// TokenHeader carries the shared secret on internal requests
+const TokenHeader = "X-Internal-Token"
+
+// RequireToken rejects internal requests without the shared token. An empty token leaves the
+// internal routes open, which is only suitable for local development.
+func RequireToken(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(TokenHeader)), []byte(token)) != 1 {
+				apierror.Write(w, http.StatusForbidden, apierror.CodeForbidden, "Internal access required")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ErrUnavailable wraps failures to reach another service, as opposed to errors it returned
+var ErrUnavailable = errors.New("service unavailable")
+
+// IsNotFound reports whether another service answered 404
+func IsNotFound(err error) bool {
+	var apiErr *apierror.Error
+	return errors.As(err, &apiErr) && apiErr.Code == apierror.CodeNotFound
+}
+
+// client makes JSON calls to one service, forwarding the request ID and trace context of the
+// calling request. Each call is traced as a client span named after the method and service.
+type client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+func newClient(service, baseURL, token string) client {
+	transport := otelhttp.NewTransport(http.DefaultTransport,
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + service + " service"
+		}))
+	return client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		http:    &http.Client{Timeout: 5 * time.Second, Transport: transport},
+	}
+}
+
+// do sends the request and decodes a 2xx body into out. Error responses are returned as
+// *apierror.Error so callers can branch on the code.
+func (c client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set(TokenHeader, c.token)
+	if id := apierror.RequestID(ctx); id != "" {
+		req.Header.Set(apierror.RequestIDHeader, id)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %s %s: %v", ErrUnavailable, method, path, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%w: %s %s: %v", ErrUnavailable, method, path, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return apierror.Parse(resp.StatusCode, respBody)
+	}
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("decode %s %s: %w", method, path, err)
+		}
+	}
+	return nil
+}
+
+// WriteUnavailable responds to a failed call to another service made for r, logging the cause
+func WriteUnavailable(w http.ResponseWriter, r *http.Request, service string, err error) {
+	slog.ErrorContext(r.Context(), "Error calling another service", "service", service, "err", err)
+	apierror.Write(w, http.StatusServiceUnavailable, apierror.CodeServiceUnavailable, "The "+service+" service is unavailable, please try again later")
+}
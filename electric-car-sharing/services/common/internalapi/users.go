@@ -0,0 +1,63 @@
+package internalapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Membership is a user's membership tier and the benefits that come with it
+type Membership struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	HourlyRateDiscount int    `json:"hourly_rate_discount"`
+	VIPAccess          bool   `json:"vip_access"`
+}
+
+// StatusActive is the only account status that may rent. The user service defines its account
+// statuses from it, so the services cannot disagree about it.
+const StatusActive = "active"
+
+// RentalProfile is what the other services need to know about a user before renting or billing
+type RentalProfile struct {
+	UserID       int        `json:"user_id"`
+	Status       string     `json:"status"`
+	StatusReason string     `json:"status_reason,omitempty"`
+	Membership   Membership `json:"membership"`
+	// LicenceExpiry is the expiry date (YYYY-MM-DD) of the user's approved licence, or "" if they have none
+	LicenceExpiry string `json:"licence_expiry,omitempty"`
+	// Timezone is the IANA zone the user wants times shown in, or "" for the service default
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Location returns the zone the user wants times shown in, or fallback if they have not chosen
+// one or it cannot be loaded
+func (p *RentalProfile) Location(fallback *time.Location) *time.Location {
+	if p == nil || p.Timezone == "" {
+		return fallback
+	}
+	location, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return fallback
+	}
+	return location
+}
+
+// Users calls the user service
+type Users struct {
+	client
+}
+
+// NewUsers creates a client for the user service at baseURL
+func NewUsers(baseURL, token string) *Users {
+	return &Users{newClient("user", baseURL, token)}
+}
+
+// RentalProfile fetches the user's account status, membership and licence
+func (u *Users) RentalProfile(ctx context.Context, userID int) (*RentalProfile, error) {
+	var profile RentalProfile
+	if err := u.do(ctx, "GET", fmt.Sprintf("/internal/users/%d/rental-profile", userID), nil, &profile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
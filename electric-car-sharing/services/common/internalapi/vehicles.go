@@ -0,0 +1,88 @@
+package internalapi
+
+import (
+	"context"
+	"electric-car-sharing/services/common/paging"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Vehicle is a vehicle as seen by the other services
+type Vehicle struct {
+	ID          int     `json:"id"`
+	Make        string  `json:"make"`
+	Model       string  `json:"model"`
+	Year        int     `json:"year"`
+	Available   bool    `json:"available"`
+	VIPAccess   bool    `json:"vip_access"`
+	CostPerHour float64 `json:"cost_per_hour"`
+}
+
+// RentalActive is the status of a rental that has not been completed or cancelled. The vehicle
+// service defines its rental statuses from it.
+const RentalActive = "active"
+
+// Rental is a user's rental as seen by the other services. Times are in UTC.
+type Rental struct {
+	ID            int       `json:"id"`
+	VehicleID     int       `json:"vehicle_id"`
+	StartDate     time.Time `json:"start_date"`
+	EndDate       time.Time `json:"end_date"`
+	Status        string    `json:"status"`
+	OvertimeHours int       `json:"overtime_hours"`
+}
+
+// Vehicles calls the vehicle service
+type Vehicles struct {
+	client
+}
+
+// NewVehicles creates a client for the vehicle service at baseURL
+func NewVehicles(baseURL, token string) *Vehicles {
+	return &Vehicles{newClient("vehicle", baseURL, token)}
+}
+
+// Vehicle fetches a single vehicle
+func (v *Vehicles) Vehicle(ctx context.Context, vehicleID int) (*Vehicle, error) {
+	var vehicle Vehicle
+	if err := v.do(ctx, "GET", fmt.Sprintf("/internal/vehicles/%d", vehicleID), nil, &vehicle); err != nil {
+		return nil, err
+	}
+	return &vehicle, nil
+}
+
+// RentalsPage fetches a page of the user's rentals. query holds the paging, sort and filter
+// parameters, which are passed on as given.
+func (v *Vehicles) RentalsPage(ctx context.Context, userID int, query url.Values) ([]Rental, paging.Page, error) {
+	var resp struct {
+		Data []Rental    `json:"data"`
+		Page paging.Page `json:"page"`
+	}
+	path := fmt.Sprintf("/internal/users/%d/rentals", userID)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	if err := v.do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, paging.Page{}, err
+	}
+	return resp.Data, resp.Page, nil
+}
+
+// UserRentals fetches every rental of the user, oldest first, a page at a time
+func (v *Vehicles) UserRentals(ctx context.Context, userID int) ([]Rental, error) {
+	query := url.Values{"limit": {strconv.Itoa(paging.MaxLimit)}}
+	var rentals []Rental
+	for {
+		batch, page, err := v.RentalsPage(ctx, userID, query)
+		if err != nil {
+			return nil, err
+		}
+		rentals = append(rentals, batch...)
+		if page.NextCursor == "" {
+			return rentals, nil
+		}
+		query.Set("cursor", page.NextCursor)
+	}
+}
@@ -0,0 +1,180 @@
+// Package lifecycle runs a service's HTTP servers and background jobs and shuts them down in
+// order when the process is asked to stop: servers stop accepting connections and drain their
+// in-flight requests, then background jobs are cancelled and awaited, then resources such as
+// the database pool are closed.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// SignalContext returns a context that is cancelled on SIGINT or SIGTERM
+func SignalContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
+
+type server struct {
+	name     string
+	srv      *http.Server
+	listener net.Listener
+}
+
+type job struct {
+	name string
+	run  func(ctx context.Context)
+}
+
+type closer struct {
+	name  string
+	close func() error
+}
+
+// Manager starts and stops the parts of a service. Register everything before calling Run.
+type Manager struct {
+	drainTimeout time.Duration
+	servers      []server
+	jobs         []job
+	closers      []closer
+}
+
+// New creates a manager that gives servers and jobs drainTimeout to finish when shutting down
+func New(drainTimeout time.Duration) *Manager {
+	return &Manager{drainTimeout: drainTimeout}
+}
+
+// AddServer registers a server that listens on srv.Addr
+func (m *Manager) AddServer(name string, srv *http.Server) {
+	m.servers = append(m.servers, server{name: name, srv: srv})
+}
+
+// AddListener registers a server that serves on an existing listener
+func (m *Manager) AddListener(name string, srv *http.Server, listener net.Listener) {
+	m.servers = append(m.servers, server{name: name, srv: srv, listener: listener})
+}
+
+// Go registers a background job. Its context is cancelled once the servers have drained, and
+// shutdown waits for it to return.
+func (m *Manager) Go(name string, run func(ctx context.Context)) {
+	m.jobs = append(m.jobs, job{name: name, run: run})
+}
+
+// OnShutdown registers a function to run after the servers and jobs have stopped. They run in
+// reverse order of registration, so register the database first and its users after it.
+func (m *Manager) OnShutdown(name string, close func() error) {
+	m.closers = append(m.closers, closer{name: name, close: close})
+}
+
+// Run starts every server and job and blocks until ctx is cancelled or a server fails, then
+// shuts everything down. It returns the server failure, if any, joined with any errors from
+// shutting down.
+func (m *Manager) Run(ctx context.Context) error {
+	var errs []error
+
+	// Bind every listener first so a port conflict is reported before anything starts
+	for i := range m.servers {
+		if m.servers[i].listener != nil {
+			continue
+		}
+		listener, err := net.Listen("tcp", m.servers[i].srv.Addr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: listen: %w", m.servers[i].name, err))
+			break
+		}
+		m.servers[i].listener = listener
+	}
+	if len(errs) > 0 {
+		for _, s := range m.servers {
+			if s.listener != nil {
+				s.listener.Close()
+			}
+		}
+		return errors.Join(append(errs, m.close()...)...)
+	}
+
+	failed := make(chan error, len(m.servers))
+	for _, s := range m.servers {
+		go func(s server) {
+			slog.Info("Listening", "server", s.name, "addr", s.listener.Addr().String())
+			if err := s.srv.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				failed <- fmt.Errorf("%s: %w", s.name, err)
+			}
+		}(s)
+	}
+
+	jobCtx, cancelJobs := context.WithCancel(context.Background())
+	defer cancelJobs()
+	var jobs sync.WaitGroup
+	for _, j := range m.jobs {
+		jobs.Add(1)
+		go func(j job) {
+			defer jobs.Done()
+			j.run(jobCtx)
+		}(j)
+	}
+
+	select {
+	case <-ctx.Done():
+		slog.Info("Shutting down")
+	case err := <-failed:
+		slog.Error("Shutting down", "err", err)
+		errs = append(errs, err)
+	}
+
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), m.drainTimeout)
+	defer cancelDrain()
+
+	// Stop accepting connections and let in-flight requests finish
+	var drained sync.WaitGroup
+	var mu sync.Mutex
+	for _, s := range m.servers {
+		drained.Add(1)
+		go func(s server) {
+			defer drained.Done()
+			if err := s.srv.Shutdown(drainCtx); err != nil {
+				slog.Warn("Server did not drain in time, closing open connections", "server", s.name)
+				s.srv.Close()
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s: drain: %w", s.name, err))
+				mu.Unlock()
+			}
+		}(s)
+	}
+	drained.Wait()
+
+	// Then stop background jobs, which may still be using the resources closed below
+	cancelJobs()
+	jobsDone := make(chan struct{})
+	go func() {
+		jobs.Wait()
+		close(jobsDone)
+	}()
+	select {
+	case <-jobsDone:
+	case <-drainCtx.Done():
+		errs = append(errs, fmt.Errorf("background jobs: %w", drainCtx.Err()))
+	}
+
+	errs = append(errs, m.close()...)
+	return errors.Join(errs...)
+}
+
+// close runs the shutdown functions, last registered first
+func (m *Manager) close() []error {
+	var errs []error
+	for i := len(m.closers) - 1; i >= 0; i-- {
+		if err := m.closers[i].close(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: close: %w", m.closers[i].name, err))
+		}
+	}
+	return errs
+}
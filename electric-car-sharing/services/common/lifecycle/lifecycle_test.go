@@ -0,0 +1,217 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockingServer serves a handler that signals when a request arrives and then waits for
+// release before answering
+func blockingServer(t *testing.T) (srv *http.Server, listener net.Listener, started, release chan struct{}) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	started = make(chan struct{}, 1)
+	release = make(chan struct{})
+	srv = &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+		io.WriteString(w, "done")
+	})}
+	return srv, listener, started, release
+}
+
+type result struct {
+	body string
+	err  error
+}
+
+func get(url string) <-chan result {
+	done := make(chan result, 1)
+	go func() {
+		resp, err := http.Get(url)
+		if err != nil {
+			done <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		done <- result{body: string(body), err: err}
+	}()
+	return done
+}
+
+func run(ctx context.Context, m *Manager) <-chan error {
+	done := make(chan error, 1)
+	go func() { done <- m.Run(ctx) }()
+	return done
+}
+
+func TestInFlightRequestCompletesOnShutdown(t *testing.T) {
+	srv, listener, started, release := blockingServer(t)
+	m := New(5 * time.Second)
+	m.AddListener("test", srv, listener)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := run(ctx, m)
+	request := get("http://" + listener.Addr().String())
+	<-started
+
+	cancel()
+	// The server must stop accepting new connections while the request is still running
+	deadline := time.Now().Add(time.Second)
+	for {
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		if err != nil {
+			break
+		}
+		conn.Close()
+		if time.Now().After(deadline) {
+			t.Fatal("expected listener to close after shutdown started")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case err := <-stopped:
+		t.Fatalf("Run returned before the in-flight request finished: %v", err)
+	default:
+	}
+
+	close(release)
+	if r := <-request; r.err != nil || r.body != "done" {
+		t.Fatalf("expected in-flight request to complete, got %q, %v", r.body, r.err)
+	}
+	if err := <-stopped; err != nil {
+		t.Fatalf("expected clean shutdown, got %v", err)
+	}
+}
+
+func TestDrainTimeoutClosesStuckRequests(t *testing.T) {
+	srv, listener, started, release := blockingServer(t)
+	defer close(release)
+	m := New(50 * time.Millisecond)
+	m.AddListener("test", srv, listener)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := run(ctx, m)
+	request := get("http://" + listener.Addr().String())
+	<-started
+
+	cancel()
+	select {
+	case err := <-stopped:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected drain timeout error, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run did not return after the drain timeout")
+	}
+	if r := <-request; r.err == nil {
+		t.Fatal("expected the stuck request to be cut off")
+	}
+}
+
+func TestShutdownOrder(t *testing.T) {
+	srv, listener, started, release := blockingServer(t)
+	m := New(5 * time.Second)
+	m.AddListener("test", srv, listener)
+
+	var mu sync.Mutex
+	var events []string
+	record := func(event string) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	}
+	handler := srv.Handler
+	srv.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r)
+		record("request finished")
+	})
+	m.Go("job", func(ctx context.Context) {
+		<-ctx.Done()
+		record("job stopped")
+	})
+	m.OnShutdown("database", func() error {
+		record("database closed")
+		return nil
+	})
+	m.OnShutdown("mailer", func() error {
+		record("mailer closed")
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := run(ctx, m)
+	request := get("http://" + listener.Addr().String())
+	<-started
+	cancel()
+
+	// Nothing may stop while a request is in flight
+	time.Sleep(50 * time.Millisecond)
+	mu.Lock()
+	if len(events) != 0 {
+		t.Fatalf("expected nothing to stop before the request finished, got %v", events)
+	}
+	mu.Unlock()
+
+	close(release)
+	<-request
+	if err := <-stopped; err != nil {
+		t.Fatalf("expected clean shutdown, got %v", err)
+	}
+
+	want := []string{"request finished", "job stopped", "mailer closed", "database closed"}
+	if len(events) != len(want) {
+		t.Fatalf("expected %v, got %v", want, events)
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, events)
+		}
+	}
+}
+
+func TestListenFailureStopsEverything(t *testing.T) {
+	taken, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer taken.Close()
+
+	first, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := false
+	m := New(time.Second)
+	m.AddListener("first", &http.Server{Handler: http.NotFoundHandler()}, first)
+	m.AddServer("second", &http.Server{Addr: taken.Addr().String()})
+	m.OnShutdown("database", func() error {
+		closed = true
+		return nil
+	})
+
+	select {
+	case err := <-run(context.Background(), m):
+		if err == nil {
+			t.Fatal("expected the port conflict to be reported")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run did not return after a listener failed")
+	}
+	if _, err := net.Dial("tcp", first.Addr().String()); err == nil {
+		t.Fatal("expected the other listener to be closed")
+	}
+	if !closed {
+		t.Fatal("expected shutdown functions to run")
+	}
+}
@@ -0,0 +1,46 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+func TestRedactHidesSensitiveAttributes(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(&buf, "test", FormatJSON, slog.LevelInfo)
+	logger.With("Email", "kim@example.com").WithGroup("request").Info("Signed in",
+		"user_id", 7,
+		slog.Group("body", "password", "hunter2", slog.Group("two_factor", "code", "123456", "method", "totp")),
+	)
+
+	var record struct {
+		Email   string `json:"Email"`
+		Request struct {
+			UserID int `json:"user_id"`
+			Body   struct {
+				Password  string `json:"password"`
+				TwoFactor struct {
+					Code   string `json:"code"`
+					Method string `json:"method"`
+				} `json:"two_factor"`
+			} `json:"body"`
+		} `json:"request"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("decode %s: %v", buf.String(), err)
+	}
+	for what, got := range map[string]string{
+		"email, whatever its case": record.Email,
+		"grouped password":         record.Request.Body.Password,
+		"code two groups deep":     record.Request.Body.TwoFactor.Code,
+	} {
+		if got != Redacted {
+			t.Errorf("%s was written as %q", what, got)
+		}
+	}
+	if record.Request.UserID != 7 || record.Request.Body.TwoFactor.Method != "totp" {
+		t.Errorf("attributes that are not sensitive were changed: %s", buf.String())
+	}
+}
@@ -0,0 +1,96 @@
+// Package metrics exposes Prometheus metrics on /metrics. Every service gets request latency by
+// route and status and its database pool stats; the handlers packages add their own business
+// counters to the same default registry.
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace prefixes the names of the metrics defined by this project
+const Namespace = "carshare"
+
+var requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: Namespace,
+	Name:      "http_request_duration_seconds",
+	Help:      "Time taken to answer HTTP requests, by service, method, route template and status code.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"service", "method", "route", "status"})
+
+func init() {
+	prometheus.MustRegister(requestDuration)
+}
+
+// Install serves /metrics on router, times every request it routes and, when db is not nil,
+// reports its connection pool. Installing again for the same service replaces the earlier pool,
+// which only happens when tests start the service more than once.
+func Install(router *mux.Router, service string, db *sql.DB) {
+	if db != nil {
+		registerPool(service, db)
+	}
+	router.Use(Middleware(service))
+	router.Handle("/metrics", Handler()).Methods("GET")
+}
+
+func registerPool(service string, db *sql.DB) {
+	collector := collectors.NewDBStatsCollector(db, service)
+	err := prometheus.Register(collector)
+	var registered prometheus.AlreadyRegisteredError
+	if errors.As(err, &registered) {
+		prometheus.Unregister(registered.ExistingCollector)
+		err = prometheus.Register(collector)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Handler serves the default registry in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Middleware records how long each request took. Requests are labelled with the route template,
+// such as /internal/users/{id}/invoices, so IDs in paths do not create a series each. Requests
+// that match no route never reach router middleware and are not recorded.
+func Middleware(service string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(recorder, r)
+
+			requestDuration.WithLabelValues(service, r.Method, routeTemplate(r), strconv.Itoa(recorder.status)).
+				Observe(time.Since(start).Seconds())
+		})
+	}
+}
+
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return "unknown"
+}
+
+// statusRecorder remembers the status code written through it
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	s.status = code
+	s.ResponseWriter.WriteHeader(code)
+}
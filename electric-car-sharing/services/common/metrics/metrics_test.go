@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// observations returns how many requests were recorded under the labels
+func observations(t *testing.T, service, method, route, status string) uint64 {
+	t.Helper()
+	var m dto.Metric
+	observer := requestDuration.WithLabelValues(service, method, route, status)
+	if err := observer.(prometheus.Histogram).Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestMiddlewareLabelsRequestsByRouteTemplate(t *testing.T) {
+	router := mux.NewRouter()
+	Install(router, "middleware-test", nil)
+	router.HandleFunc("/users/{id:[0-9]+}", func(w http.ResponseWriter, r *http.Request) {
+		if mux.Vars(r)["id"] == "404" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}).Methods("GET")
+	internal := router.PathPrefix("/internal").Subrouter()
+	internal.HandleFunc("/users/{id:[0-9]+}/invoices", func(w http.ResponseWriter, r *http.Request) {}).Methods("GET")
+
+	for _, path := range []string{"/users/1", "/users/2", "/users/404", "/internal/users/7/invoices", "/nowhere"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", path, nil))
+	}
+
+	cases := []struct {
+		route, status string
+		want          uint64
+	}{
+		{"/users/{id:[0-9]+}", "200", 2},
+		{"/users/{id:[0-9]+}", "404", 1},
+		{"/internal/users/{id:[0-9]+}/invoices", "200", 1},
+		{"/nowhere", "404", 0},
+	}
+	for _, c := range cases {
+		if got := observations(t, "middleware-test", "GET", c.route, c.status); got != c.want {
+			t.Errorf("%s %s: recorded %d requests, want %d", c.route, c.status, got, c.want)
+		}
+	}
+
+	// The recorded series show up on /metrics
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	want := `carshare_http_request_duration_seconds_count{method="GET",route="/users/{id:[0-9]+}",service="middleware-test",status="200"} 2`
+	if !strings.Contains(recorder.Body.String(), want) {
+		t.Errorf("/metrics does not contain %s", want)
+	}
+}
@@ -0,0 +1,356 @@
+// Package migrate applies numbered schema migrations and records them in the schema_migrations
+// table. A migration is a pair of files, NNNN_name.up.sql and NNNN_name.down.sql, whose number
+// is its version. Seed files (NNNN_name.sql) hold sample data and are kept apart from the
+// schema so production databases can be migrated without them.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Migration is one schema change and how to undo it
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Seed is one file of sample data
+type Seed struct {
+	Name string
+	SQL  string
+}
+
+// Status is whether a migration has been applied
+type Status struct {
+	Migration
+	// AppliedAt is zero for pending migrations
+	AppliedAt time.Time
+}
+
+// ErrNoChange is returned by Down when there is nothing to roll back
+var ErrNoChange = errors.New("no migrations to roll back")
+
+var (
+	migrationFile = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+	seedFile      = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.sql$`)
+)
+
+// Load reads the migrations in the root of fsys, ordered by version. Every migration needs an up
+// file; a missing down file makes that migration irreversible.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := migrationFile.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migrate: %s: expected NNNN_name.up.sql or NNNN_name.down.sql", entry.Name())
+		}
+		version, _ := strconv.ParseInt(match[1], 10, 64)
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migrate: version %d is used by both %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migrate: version %d (%s) has no up migration", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// LoadSeeds reads the seed files in the root of fsys, ordered by number
+func LoadSeeds(fsys fs.FS) ([]Seed, error) {
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	seeds := make([]Seed, 0, len(names))
+	for _, name := range names {
+		if !seedFile.MatchString(name) {
+			return nil, fmt.Errorf("migrate: %s: expected NNNN_name.sql", name)
+		}
+		body, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		seeds = append(seeds, Seed{Name: path.Base(name), SQL: string(body)})
+	}
+	return seeds, nil
+}
+
+// Latest returns the highest version in migrations, or 0 if there are none
+func Latest(migrations []Migration) int64 {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].Version
+}
+
+// Migrator applies migrations to one database
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	// Log is called with a line for every migration applied or rolled back
+	Log func(format string, args ...interface{})
+}
+
+// New creates a migrator for db
+func New(db *sql.DB, migrations []Migration) *Migrator {
+	return &Migrator{db: db, migrations: migrations, Log: func(string, ...interface{}) {}}
+}
+
+// lockName serialises migrators across processes with a MySQL advisory lock
+const lockName = "schema_migrations"
+
+// withLock runs fn on a single connection holding the migration lock, creating the
+// schema_migrations table first if needed
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 30)", lockName).Scan(&locked); err != nil {
+		return fmt.Errorf("migrate: lock: %w", err)
+	}
+	if locked.Int64 != 1 {
+		return errors.New("migrate: another migration is running")
+	}
+	defer conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName)
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT PRIMARY KEY,
+    applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+)`); err != nil {
+		return fmt.Errorf("migrate: create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+// applied returns when each applied version was applied
+func applied(ctx context.Context, conn *sql.Conn) (map[int64]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version, DATE_FORMAT(applied_at, '%Y-%m-%d %H:%i:%s') FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := map[int64]time.Time{}
+	for rows.Next() {
+		var version int64
+		var appliedAt string
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		versions[version], _ = time.Parse("2006-01-02 15:04:05", appliedAt)
+	}
+	return versions, rows.Err()
+}
+
+// Up applies every pending migration in order and returns how many were applied. MySQL commits
+// DDL immediately, so a failing migration is not recorded and must be fixed by hand before
+// running Up again.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	count := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		done, err := applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+			if err := execScript(ctx, conn, migration.Up); err != nil {
+				return fmt.Errorf("migrate: %04d_%s up: %w", migration.Version, migration.Name, err)
+			}
+			if _, err := conn.ExecContext(ctx, "INSERT INTO schema_migrations (version) VALUES (?)", migration.Version); err != nil {
+				return err
+			}
+			m.Log("Applied %04d_%s", migration.Version, migration.Name)
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// Down rolls back the most recently applied steps migrations, newest first
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	count := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		done, err := applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && count < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := done[migration.Version]; !ok {
+				continue
+			}
+			if migration.Down == "" {
+				return fmt.Errorf("migrate: %04d_%s cannot be rolled back", migration.Version, migration.Name)
+			}
+			if err := execScript(ctx, conn, migration.Down); err != nil {
+				return fmt.Errorf("migrate: %04d_%s down: %w", migration.Version, migration.Name, err)
+			}
+			if _, err := conn.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = ?", migration.Version); err != nil {
+				return err
+			}
+			m.Log("Rolled back %04d_%s", migration.Version, migration.Name)
+			count++
+		}
+		if count == 0 {
+			return ErrNoChange
+		}
+		return nil
+	})
+	return count, err
+}
+
+// Status lists every known migration and when it was applied
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		done, err := applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			statuses = append(statuses, Status{Migration: migration, AppliedAt: done[migration.Version]})
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// Seed runs the seed files in order. Seeds must be safe to run more than once.
+func (m *Migrator) Seed(ctx context.Context, seeds []Seed) error {
+	for _, seed := range seeds {
+		if err := execScript(ctx, m.db, seed.SQL); err != nil {
+			return fmt.Errorf("migrate: seed %s: %w", seed.Name, err)
+		}
+		m.Log("Seeded %s", seed.Name)
+	}
+	return nil
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// execScript runs each statement of a SQL file in turn
+func execScript(ctx context.Context, db execer, script string) error {
+	for _, statement := range splitStatements(script) {
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitStatements splits a SQL file on semicolons outside quotes and comments. Statements
+// made only of comments are dropped.
+func splitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	hasCode := false
+	flush := func() {
+		if hasCode {
+			statements = append(statements, strings.TrimSpace(current.String()))
+		}
+		current.Reset()
+		hasCode = false
+	}
+
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+		switch {
+		case c == '-' && strings.HasPrefix(script[i:], "-- "), c == '#':
+			end := strings.IndexByte(script[i:], '\n')
+			if end < 0 {
+				end = len(script) - i
+			}
+			current.WriteString(script[i : i+end])
+			i += end - 1
+		case c == '/' && strings.HasPrefix(script[i:], "/*"):
+			end := strings.Index(script[i+2:], "*/")
+			if end < 0 {
+				end = len(script) - i - 4
+			}
+			current.WriteString(script[i : i+end+4])
+			i += end + 3
+		case c == '\'' || c == '"' || c == '`':
+			// Copy the quoted text, honouring backslash escapes and doubled quotes
+			j := i + 1
+			for j < len(script) {
+				if script[j] == '\\' && c != '`' {
+					j += 2
+					continue
+				}
+				if script[j] == c {
+					if j+1 < len(script) && script[j+1] == c {
+						j += 2
+						continue
+					}
+					break
+				}
+				j++
+			}
+			if j >= len(script) {
+				j = len(script) - 1
+			}
+			current.WriteString(script[i : j+1])
+			hasCode = true
+			i = j
+		case c == ';':
+			flush()
+		default:
+			current.WriteByte(c)
+			if c != ' ' && c != '\t' && c != '\n' && c != '\r' {
+				hasCode = true
+			}
+		}
+	}
+	flush()
+	return statements
+}
@@ -0,0 +1,86 @@
+package migrate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSplitStatements(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{"empty", "", nil},
+		{"statements", "CREATE TABLE a (id INT);\nCREATE TABLE b (id INT);\n", []string{"CREATE TABLE a (id INT)", "CREATE TABLE b (id INT)"}},
+		{"no final semicolon", "SELECT 1;\nSELECT 2", []string{"SELECT 1", "SELECT 2"}},
+		{"empty statements", ";;SELECT 1;\n;", []string{"SELECT 1"}},
+		{"single quotes", "INSERT INTO t VALUES ('a;b'); SELECT 1", []string{"INSERT INTO t VALUES ('a;b')", "SELECT 1"}},
+		{"double quotes", `INSERT INTO t VALUES ("a;b"); SELECT 1`, []string{`INSERT INTO t VALUES ("a;b")`, "SELECT 1"}},
+		{"backticks", "SELECT `a;b` FROM t; SELECT 1", []string{"SELECT `a;b` FROM t", "SELECT 1"}},
+		{"backslash escape", `INSERT INTO t VALUES ('it\'s; fine'); SELECT 1`, []string{`INSERT INTO t VALUES ('it\'s; fine')`, "SELECT 1"}},
+		{"escaped backslash", `INSERT INTO t VALUES ('a\\'); SELECT 1`, []string{`INSERT INTO t VALUES ('a\\')`, "SELECT 1"}},
+		{"doubled quote", "INSERT INTO t VALUES ('it''s; fine'); SELECT 1", []string{"INSERT INTO t VALUES ('it''s; fine')", "SELECT 1"}},
+		{"doubled backtick", "SELECT `a``;b` FROM t; SELECT 1", []string{"SELECT `a``;b` FROM t", "SELECT 1"}},
+		{"backslash in backticks", "SELECT `a\\`; SELECT 1", []string{"SELECT `a\\`", "SELECT 1"}},
+		{"other quote inside", `INSERT INTO t VALUES ('say "hi;"'); SELECT 1`, []string{`INSERT INTO t VALUES ('say "hi;"')`, "SELECT 1"}},
+		{"unterminated quote", "SELECT 'a;b", []string{"SELECT 'a;b"}},
+		{"line comment", "-- a; b\nSELECT 1;", []string{"-- a; b\nSELECT 1"}},
+		{"hash comment", "SELECT 1; # a; b\nSELECT 2;", []string{"SELECT 1", "# a; b\nSELECT 2"}},
+		{"dashes without a space", "SELECT 2--1; SELECT 1", []string{"SELECT 2--1", "SELECT 1"}},
+		{"block comment", "SELECT /* a; b */ 1; SELECT 2", []string{"SELECT /* a; b */ 1", "SELECT 2"}},
+		{"quote in a comment", "-- it's\nSELECT 1; SELECT 2", []string{"-- it's\nSELECT 1", "SELECT 2"}},
+		{"comment only statements", "SELECT 1;\n-- done;\n/* really; */\n", []string{"SELECT 1"}},
+		{"unterminated block comment", "SELECT 1; /* a; b", []string{"SELECT 1"}},
+	} {
+		if got := splitStatements(tc.script); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	file := func(body string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(body)} }
+	fsys := fstest.MapFS{
+		"0010_later.up.sql":     file("UP 10"),
+		"0002_users.up.sql":     file("UP 2"),
+		"0002_users.down.sql":   file("DOWN 2"),
+		"0001_initial.down.sql": file("DOWN 1"),
+		"0001_initial.up.sql":   file("UP 1"),
+		"notes/readme.txt":      file("directories are skipped"),
+	}
+	got, err := Load(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Up and down files pair by version, in version order, and the down file is optional
+	want := []Migration{
+		{Version: 1, Name: "initial", Up: "UP 1", Down: "DOWN 1"},
+		{Version: 2, Name: "users", Up: "UP 2", Down: "DOWN 2"},
+		{Version: 10, Name: "later", Up: "UP 10"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if Latest(got) != 10 {
+		t.Errorf("latest %d, want 10", Latest(got))
+	}
+
+	for _, tc := range []struct {
+		name string
+		fsys fstest.MapFS
+		err  string
+	}{
+		{"no up file", fstest.MapFS{"0001_initial.down.sql": file("DOWN 1")}, "has no up migration"},
+		{"names differ", fstest.MapFS{"0001_initial.up.sql": file("UP 1"), "0001_other.down.sql": file("DOWN 1")}, "is used by both"},
+		{"two names", fstest.MapFS{"0001_initial.up.sql": file("UP 1"), "0001_other.up.sql": file("UP 1")}, "is used by both"},
+		{"not a migration", fstest.MapFS{"0001_initial.sql": file("UP 1")}, "expected NNNN_name.up.sql"},
+		{"upper case name", fstest.MapFS{"0001_Initial.up.sql": file("UP 1")}, "expected NNNN_name.up.sql"},
+	} {
+		if _, err := Load(tc.fsys); err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: got %v, want an error containing %q", tc.name, err, tc.err)
+		}
+	}
+}
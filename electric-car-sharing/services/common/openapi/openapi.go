@@ -0,0 +1,146 @@
+// Package openapi serves a service's OpenAPI document and checks requests against it before
+// they reach the handlers, so malformed parameters and bodies are refused the same way on
+// every route. Routes the document leaves out, such as /internal/ and the probes, are not
+// checked.
+package openapi
+
+import (
+	"context"
+	"electric-car-sharing/services/common/apierror"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gorilla/mux"
+)
+
+// DocumentPath is where Install serves the document
+const DocumentPath = "/openapi.yaml"
+
+// Install serves document on router and refuses requests to the operations it describes that
+// do not match it with 400 invalid_request, naming the rejected parameter or body field in the
+// error's fields. Authentication is left to the handlers.
+func Install(router *mux.Router, document []byte) error {
+	doc, err := openapi3.NewLoader().LoadFromData(document)
+	if err != nil {
+		return fmt.Errorf("openapi: %w", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return fmt.Errorf("openapi: %w", err)
+	}
+
+	router.HandleFunc(DocumentPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write(document)
+	}).Methods("GET")
+	router.Use(Middleware(doc))
+	return nil
+}
+
+// Middleware validates the parameters and body of requests for operations described in doc
+func Middleware(doc *openapi3.T) mux.MiddlewareFunc {
+	options := &openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := findRoute(doc, r)
+			if route == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			// The handlers have always decoded bodies as JSON whatever their type, so callers
+			// that leave Content-Type out keep working
+			if r.Header.Get("Content-Type") == "" && acceptsJSON(route.Operation) {
+				r.Header.Set("Content-Type", "application/json")
+			}
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: mux.Vars(r),
+				Route:      route,
+				Options:    options,
+			}
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				message, fields := describe(err)
+				apierror.WriteError(w, http.StatusBadRequest, &apierror.Error{
+					Code:    apierror.CodeInvalidRequest,
+					Message: message,
+					Fields:  fields,
+				})
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// patternVariable matches a mux path variable with a pattern, such as {id:[0-9]+}
+var patternVariable = regexp.MustCompile(`\{([^{}:]+):[^{}]*\}`)
+
+// findRoute returns the operation in doc for the route mux matched r to, or nil if doc does not
+// describe it. Looking the operation up by route template rather than by URL keeps the
+// document's servers out of it, so it works on any host and port.
+func findRoute(doc *openapi3.T, r *http.Request) *routers.Route {
+	current := mux.CurrentRoute(r)
+	if current == nil {
+		return nil
+	}
+	template, err := current.GetPathTemplate()
+	if err != nil {
+		return nil
+	}
+	path := patternVariable.ReplaceAllString(template, "{$1}")
+	item := doc.Paths.Find(path)
+	if item == nil {
+		return nil
+	}
+	operation := item.GetOperation(r.Method)
+	if operation == nil {
+		return nil
+	}
+	return &routers.Route{Spec: doc, Path: path, PathItem: item, Method: r.Method, Operation: operation}
+}
+
+// acceptsJSON reports whether operation takes a JSON body
+func acceptsJSON(operation *openapi3.Operation) bool {
+	body := operation.RequestBody
+	return body != nil && body.Value != nil && body.Value.Content.Get("application/json") != nil
+}
+
+// describe turns a validation error into a message naming the parameter or body field at fault,
+// and the fields for the envelope: the parameter's name, or the body field's path with its
+// segments joined by dots, mapped to the reason it was rejected
+func describe(err error) (string, map[string]string) {
+	var requestErr *openapi3filter.RequestError
+	if !errors.As(err, &requestErr) {
+		return "request does not match the API description", nil
+	}
+	reason := requestErr.Reason
+	field := ""
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		reason = schemaErr.Reason
+		field = strings.Join(schemaErr.JSONPointer(), ".")
+	} else if reason == "" && requestErr.Err != nil {
+		reason = requestErr.Err.Error()
+	}
+
+	switch {
+	case requestErr.Parameter != nil:
+		return fmt.Sprintf("invalid %s parameter %q: %s", requestErr.Parameter.In, requestErr.Parameter.Name, reason),
+			map[string]string{requestErr.Parameter.Name: reason}
+	case requestErr.RequestBody != nil && field == "":
+		return "invalid request body: " + reason, nil
+	case requestErr.RequestBody != nil:
+		message := "invalid request body: " + reason
+		if !strings.HasPrefix(reason, "property ") {
+			message = fmt.Sprintf("invalid request body: field %q: %s", field, reason)
+		}
+		return message, map[string]string{field: reason}
+	default:
+		return reason, nil
+	}
+}
@@ -0,0 +1,103 @@
+package paging
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Filters reads the filter parameters of a list route. A parameter that is left out reads as the
+// zero value; the first one that cannot be read is reported by Err.
+type Filters struct {
+	query url.Values
+	err   error
+}
+
+// NewFilters reads filters from query
+func NewFilters(query url.Values) *Filters {
+	return &Filters{query: query}
+}
+
+// Err is the message for the caller about the first invalid parameter, or nil
+func (f *Filters) Err() error {
+	return f.err
+}
+
+func (f *Filters) fail(name, want string) {
+	if f.err == nil {
+		f.err = fmt.Errorf("Query parameter '%s' must be %s", name, want)
+	}
+}
+
+// String reads a text parameter
+func (f *Filters) String(name string) string {
+	return f.query.Get(name)
+}
+
+// Int reads a positive integer parameter
+func (f *Filters) Int(name string) int {
+	value := f.query.Get(name)
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		f.fail(name, "a positive integer")
+		return 0
+	}
+	return n
+}
+
+// Float reads a positive number parameter
+func (f *Filters) Float(name string) float64 {
+	value := f.query.Get(name)
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n <= 0 {
+		f.fail(name, "a positive number")
+		return 0
+	}
+	return n
+}
+
+// Bool reads a true or false parameter, returning nil if it is left out
+func (f *Filters) Bool(name string) *bool {
+	value := f.query.Get(name)
+	if value == "" {
+		return nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		f.fail(name, "true or false")
+		return nil
+	}
+	return &b
+}
+
+// Time reads an RFC 3339 date-time parameter
+func (f *Filters) Time(name string) time.Time {
+	value := f.query.Get(name)
+	if value == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		f.fail(name, "an RFC 3339 date-time, e.g. 2030-03-04T09:00:00Z")
+		return time.Time{}
+	}
+	return t
+}
+
+// OneOf reads a parameter that must be one of values
+func (f *Filters) OneOf(name string, values ...string) string {
+	value := f.query.Get(name)
+	if value != "" && !contains(values, value) {
+		f.fail(name, "one of "+strings.Join(values, ", "))
+		return ""
+	}
+	return value
+}
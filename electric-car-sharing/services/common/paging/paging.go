@@ -0,0 +1,247 @@
+// Package paging reads the limit, cursor, sort and filter parameters of list routes and writes
+// the pages they answer with. Paging is keyset-based: a cursor holds the sort key and ID of the
+// last item of a page, and the next page starts after it, so rows added or removed meanwhile do
+// not shift items between pages.
+package paging
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultLimit is the page size when the limit parameter is left out
+	DefaultLimit = 20
+	// MaxLimit is the largest page a caller may ask for
+	MaxLimit = 100
+)
+
+// ErrInvalidCursor is returned for a cursor that was not issued for the list and sort it is used with
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Sort orders a list by a field. Items with equal values are ordered by ID, in the same direction.
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+// String is the sort parameter for s, e.g. -created_at for newest first
+func (s Sort) String() string {
+	if s.Desc {
+		return "-" + s.Field
+	}
+	return s.Field
+}
+
+// Cursor marks the last item of a page
+type Cursor struct {
+	Sort string          `json:"s"`
+	Key  json.RawMessage `json:"k"`
+	ID   int             `json:"id"`
+}
+
+// DecodeKey reads the sort key into key, which must point to a value of the sort field's type
+func (c *Cursor) DecodeKey(key interface{}) error {
+	if err := json.Unmarshal(c.Key, key); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
+
+// Request is the page a caller asked for
+type Request struct {
+	// Limit is the most items to return; zero returns them all
+	Limit int
+	Sort  Sort
+	// After is where the previous page ended, or nil for the first page
+	After *Cursor
+}
+
+// All asks for every item, ordered by ID. It is for internal callers that need the whole list.
+var All = Request{Sort: Sort{Field: "id"}}
+
+// Parse reads the limit, cursor and sort query parameters. fields are the fields the list can be
+// sorted by, and def is its order when sort is left out. The error is a message for the caller.
+func Parse(query url.Values, fields []string, def Sort) (Request, error) {
+	req := Request{Limit: DefaultLimit, Sort: def}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > MaxLimit {
+			return req, fmt.Errorf("Query parameter 'limit' must be between 1 and %d", MaxLimit)
+		}
+		req.Limit = limit
+	}
+
+	if value := query.Get("sort"); value != "" {
+		sort := Sort{Field: strings.TrimPrefix(value, "-"), Desc: strings.HasPrefix(value, "-")}
+		if !contains(fields, sort.Field) {
+			return req, fmt.Errorf("Query parameter 'sort' must be one of %s, optionally prefixed with - for descending order", strings.Join(fields, ", "))
+		}
+		req.Sort = sort
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		cursor, err := decodeCursor(value)
+		if err != nil || cursor.Sort != req.Sort.String() {
+			return req, errors.New("Query parameter 'cursor' is invalid or was issued for another sort order")
+		}
+		req.After = cursor
+	}
+	return req, nil
+}
+
+// Next returns the cursor of a page ending with the item with sort key key and ID id
+func (r Request) Next(key interface{}, id int) string {
+	data, err := json.Marshal(key)
+	if err != nil {
+		// Sort keys are numbers, strings and times
+		panic(fmt.Sprintf("paging: encode sort key %v: %v", key, err))
+	}
+	cursor, _ := json.Marshal(Cursor{Sort: r.Sort.String(), Key: data, ID: id})
+	return base64.RawURLEncoding.EncodeToString(cursor)
+}
+
+// AfterKey decodes the cursor's sort key as a value of the same type as zero: an int, float64,
+// string or time.Time. It returns nil on the first page.
+func (r Request) AfterKey(zero interface{}) (interface{}, error) {
+	if r.After == nil {
+		return nil, nil
+	}
+	switch zero.(type) {
+	case int:
+		var key int
+		err := r.After.DecodeKey(&key)
+		return key, err
+	case float64:
+		var key float64
+		err := r.After.DecodeKey(&key)
+		return key, err
+	case string:
+		var key string
+		err := r.After.DecodeKey(&key)
+		return key, err
+	case time.Time:
+		var key time.Time
+		err := r.After.DecodeKey(&key)
+		return key, err
+	}
+	return nil, fmt.Errorf("paging: unsupported sort key type %T", zero)
+}
+
+// Less reports whether the item with sort key a and ID aID comes before the item with sort key b
+// and ID bID. It is for stores that sort in memory; the keys must be of the same type.
+func (r Request) Less(a interface{}, aID int, b interface{}, bID int) bool {
+	c := compare(a, b)
+	if c == 0 {
+		c = compare(aID, bID)
+	}
+	if r.Sort.Desc {
+		return c > 0
+	}
+	return c < 0
+}
+
+// SQL returns the condition selecting the rows after the cursor, with its arguments, and the
+// ORDER BY and LIMIT clauses, for a table sorted on column. key is the cursor's sort key from
+// AfterKey. The condition is empty on the first page, and the limit fetches one row more than
+// the page holds so the caller can tell whether another page follows.
+func (r Request) SQL(column string, key interface{}) (where string, args []interface{}, order string) {
+	direction, beyond := "ASC", ">"
+	if r.Sort.Desc {
+		direction, beyond = "DESC", "<"
+	}
+	if column == "id" {
+		if r.After != nil {
+			where, args = "id "+beyond+" ?", []interface{}{r.After.ID}
+		}
+		order = " ORDER BY id " + direction
+	} else {
+		if r.After != nil {
+			where = fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?))", column, beyond)
+			args = []interface{}{key, key, r.After.ID}
+		}
+		order = fmt.Sprintf(" ORDER BY %s %s, id %s", column, direction, direction)
+	}
+	if r.Limit > 0 {
+		order += fmt.Sprintf(" LIMIT %d", r.Limit+1)
+	}
+	return where, args, order
+}
+
+// Cut reports how many of the n items fetched for r belong on the page, and whether more follow
+func (r Request) Cut(n int) (int, bool) {
+	if r.Limit > 0 && n > r.Limit {
+		return r.Limit, true
+	}
+	return n, false
+}
+
+// Page describes a page of results
+type Page struct {
+	Limit int    `json:"limit"`
+	Sort  string `json:"sort"`
+	// NextCursor fetches the following page; it is left out on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// Page describes the page answering r, given the cursor of the page after it
+func (r Request) Page(next string) Page {
+	return Page{Limit: r.Limit, Sort: r.Sort.String(), NextCursor: next}
+}
+
+// Write sends items, a slice, as a page of results: {"data": [...], "page": {...}}
+func Write(w http.ResponseWriter, items interface{}, page Page) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		Data interface{} `json:"data"`
+		Page Page        `json:"page"`
+	}{items, page})
+}
+
+func decodeCursor(value string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	if cursor.ID <= 0 || len(cursor.Key) == 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+// compare orders two sort keys of the same type
+func compare(a, b interface{}) int {
+	switch a := a.(type) {
+	case int:
+		return cmp.Compare(a, b.(int))
+	case float64:
+		return cmp.Compare(a, b.(float64))
+	case string:
+		return cmp.Compare(a, b.(string))
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	}
+	panic(fmt.Sprintf("paging: unsupported sort key type %T", a))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
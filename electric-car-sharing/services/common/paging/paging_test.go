@@ -0,0 +1,86 @@
+package paging
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+var fields = []string{"id", "created_at"}
+
+func TestParse(t *testing.T) {
+	req, err := Parse(url.Values{}, fields, Sort{Field: "id"})
+	if err != nil || req.Limit != DefaultLimit || req.Sort.String() != "id" || req.After != nil {
+		t.Fatalf("defaults: got %+v, %v", req, err)
+	}
+
+	req, err = Parse(url.Values{"limit": {"5"}, "sort": {"-created_at"}}, fields, Sort{Field: "id"})
+	if err != nil || req.Limit != 5 || req.Sort != (Sort{Field: "created_at", Desc: true}) {
+		t.Fatalf("limit and sort: got %+v, %v", req, err)
+	}
+
+	for _, query := range []url.Values{
+		{"limit": {"0"}},
+		{"limit": {"101"}},
+		{"limit": {"ten"}},
+		{"sort": {"amount"}},
+		{"cursor": {"not a cursor"}},
+	} {
+		if _, err := Parse(query, fields, Sort{Field: "id"}); err == nil {
+			t.Errorf("%v: got no error", query)
+		}
+	}
+}
+
+func TestCursorContinuesItsOwnSortOnly(t *testing.T) {
+	created := time.Date(2030, time.March, 4, 9, 0, 0, 0, time.UTC)
+	first, _ := Parse(url.Values{"sort": {"-created_at"}}, fields, Sort{Field: "id"})
+	cursor := first.Next(created, 7)
+
+	next, err := Parse(url.Values{"sort": {"-created_at"}, "cursor": {cursor}}, fields, Sort{Field: "id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := next.AfterKey(time.Time{})
+	if err != nil || !key.(time.Time).Equal(created) || next.After.ID != 7 {
+		t.Fatalf("got key %v and ID %d, %v; want %v and 7", key, next.After.ID, err, created)
+	}
+	if _, err := next.AfterKey(0); err != ErrInvalidCursor {
+		t.Errorf("decoding a time key as an int: got %v, want ErrInvalidCursor", err)
+	}
+
+	if _, err := Parse(url.Values{"sort": {"created_at"}, "cursor": {cursor}}, fields, Sort{Field: "id"}); err == nil {
+		t.Error("cursor for -created_at was accepted for created_at")
+	}
+}
+
+func TestSQL(t *testing.T) {
+	req := Request{Limit: 10, Sort: Sort{Field: "final_cost", Desc: true}}
+	where, args, order := req.SQL("final_cost", nil)
+	if where != "" || args != nil || order != " ORDER BY final_cost DESC, id DESC LIMIT 11" {
+		t.Fatalf("first page: got %q %v %q", where, args, order)
+	}
+
+	req.After = &Cursor{ID: 3}
+	where, args, _ = req.SQL("final_cost", 40.0)
+	if where != "(final_cost < ? OR (final_cost = ? AND id < ?))" || len(args) != 3 || args[2] != 3 {
+		t.Fatalf("next page: got %q %v", where, args)
+	}
+
+	req = Request{Sort: Sort{Field: "id"}, After: &Cursor{ID: 3}}
+	where, args, order = req.SQL("id", 3)
+	if where != "id > ?" || len(args) != 1 || order != " ORDER BY id ASC" {
+		t.Fatalf("by ID without a limit: got %q %v %q", where, args, order)
+	}
+}
+
+func TestLessBreaksTiesByID(t *testing.T) {
+	asc := Request{Sort: Sort{Field: "year"}}
+	desc := Request{Sort: Sort{Field: "year", Desc: true}}
+	if !asc.Less(2020, 1, 2020, 6) || !asc.Less(2019, 3, 2020, 1) {
+		t.Error("ascending order is wrong")
+	}
+	if !desc.Less(2020, 6, 2020, 1) || !desc.Less(2023, 5, 2022, 4) {
+		t.Error("descending order is wrong")
+	}
+}
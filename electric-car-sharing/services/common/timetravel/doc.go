@@ -0,0 +1,4 @@
+// Package timetravel lets QA move a service's clock forward, to walk rentals past their end or
+// the cancellation window without waiting. It is only compiled in with -tags timetravel; in
+// normal builds Install returns the real clock and adds no routes.
+package timetravel
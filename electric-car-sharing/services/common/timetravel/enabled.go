@@ -0,0 +1,143 @@
+//go:build timetravel
+
+package timetravel
+
+import (
+	"crypto/subtle"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/clock"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// Enabled reports whether the binary was built with -tags timetravel
+const Enabled = true
+
+// Clock is the wall clock moved forward by an offset operators can change while the service runs
+type Clock struct {
+	mu     sync.Mutex
+	offset time.Duration
+}
+
+// Now returns the wall clock time plus the offset
+func (c *Clock) Now() time.Time {
+	return time.Now().Add(c.Offset())
+}
+
+// Offset returns how far ahead of the wall clock the clock runs
+func (c *Clock) Offset() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.offset
+}
+
+// Install returns a clock operators can move and adds GET and POST /admin/clock to router. The
+// routes require the X-Admin-Token header and refuse every request when adminToken is empty.
+func Install(router *mux.Router, service, adminToken string) clock.Clock {
+	slog.Warn("Time travel is enabled: the clock can be moved with POST /admin/clock. Never run this build in production", "service", service)
+	c := &Clock{}
+	router.HandleFunc("/admin/clock", c.view(adminToken)).Methods("GET")
+	router.HandleFunc("/admin/clock", c.travel(adminToken)).Methods("POST")
+	return c
+}
+
+// view reports the clock's time and offset
+func (c *Clock) view(adminToken string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requireAdmin(w, r, adminToken) {
+			return
+		}
+		c.respond(w)
+	}
+}
+
+// travel moves the clock. The body holds one of "advance", a duration such as "90m" to add to
+// the offset, "offset", a duration to set it to, or "reset": true to return to the wall clock.
+// The clock never runs behind the wall clock.
+func (c *Clock) travel(adminToken string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requireAdmin(w, r, adminToken) {
+			return
+		}
+
+		var reqBody struct {
+			Advance string `json:"advance"`
+			Offset  string `json:"offset"`
+			Reset   bool   `json:"reset"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
+			return
+		}
+
+		given := 0
+		for _, set := range []bool{reqBody.Advance != "", reqBody.Offset != "", reqBody.Reset} {
+			if set {
+				given++
+			}
+		}
+		if given != 1 {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Exactly one of advance, offset or reset is required")
+			return
+		}
+
+		c.mu.Lock()
+		offset := time.Duration(0)
+		switch {
+		case reqBody.Advance != "":
+			d, err := time.ParseDuration(reqBody.Advance)
+			if err != nil {
+				c.mu.Unlock()
+				apierror.WriteFields(w, "Invalid duration", map[string]string{"advance": "must be a duration such as 90m or 26h"})
+				return
+			}
+			offset = c.offset + d
+		case reqBody.Offset != "":
+			d, err := time.ParseDuration(reqBody.Offset)
+			if err != nil {
+				c.mu.Unlock()
+				apierror.WriteFields(w, "Invalid duration", map[string]string{"offset": "must be a duration such as 90m or 26h"})
+				return
+			}
+			offset = d
+		}
+		if offset < 0 {
+			c.mu.Unlock()
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "The clock cannot run behind the wall clock")
+			return
+		}
+		c.offset = offset
+		c.mu.Unlock()
+
+		slog.InfoContext(r.Context(), "Time travel: clock offset set", "offset", offset.String())
+		c.respond(w)
+	}
+}
+
+func (c *Clock) respond(w http.ResponseWriter) {
+	offset := c.Offset()
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"now":    time.Now().Add(offset).UTC().Format(time.RFC3339),
+		"offset": offset.String(),
+	})
+}
+
+// requireAdmin checks the X-Admin-Token header, responding with an error if it does not match
+func requireAdmin(w http.ResponseWriter, r *http.Request, adminToken string) bool {
+	if adminToken == "" {
+		apierror.Write(w, http.StatusForbidden, apierror.CodeAdminRequired, "Admin access is not configured")
+		return false
+	}
+	token := r.Header.Get("X-Admin-Token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+		apierror.Write(w, http.StatusForbidden, apierror.CodeAdminRequired, "Admin access required")
+		return false
+	}
+	return true
+}
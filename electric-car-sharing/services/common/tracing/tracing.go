@@ -0,0 +1,110 @@
+// Package tracing sets up OpenTelemetry tracing. Every service traces the requests it handles,
+// the SQL queries it runs and its calls to the other services, and passes the trace context on
+// in the traceparent header so one rental or payment shows up as a single trace. Spans are
+// written to stdout or to an OTLP/HTTP collector, or dropped when no exporter is configured.
+package tracing
+
+import (
+	"context"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/config"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// flushTimeout bounds how long Setup's shutdown function waits for buffered spans to be sent
+const flushTimeout = 5 * time.Second
+
+// Setup installs the trace context propagator and a tracer provider exporting spans as cfg
+// says, tagged with the service name. The returned function flushes and stops the provider.
+// With no exporter, spans are not recorded but incoming trace context is still passed on.
+func Setup(service string, cfg config.Tracing) (func() error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case ExporterNone:
+		return func() error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		exporter, err = otlptracehttp.New(context.Background(), otlptracehttp.WithEndpointURL(cfg.Endpoint))
+	default:
+		return nil, fmt.Errorf("tracing: unknown exporter %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tracing: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(service))),
+	)
+	otel.SetTracerProvider(provider)
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+		defer cancel()
+		return provider.Shutdown(ctx)
+	}, nil
+}
+
+// quietPaths are polled by probes and scrapers and are not traced
+var quietPaths = map[string]bool{"/healthz": true, "/health": true, "/readyz": true, "/metrics": true}
+
+// Middleware starts a server span for every routed request, continuing the trace of the caller
+// if the request carries one. Spans are named after the method and route template, so
+// /users/42 and /users/43 share a name. It runs after apierror.Middleware so the span can be
+// tagged with the request ID.
+func Middleware(next http.Handler) http.Handler {
+	tagged := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		span := trace.SpanFromContext(r.Context())
+		span.SetAttributes(attribute.String("request_id", apierror.RequestID(r.Context())))
+		if route := routeTemplate(r); route != "" {
+			span.SetAttributes(semconv.HTTPRoute(route))
+		}
+		next.ServeHTTP(w, r)
+	})
+	return otelhttp.NewHandler(tagged, "",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			if route := routeTemplate(r); route != "" {
+				return r.Method + " " + route
+			}
+			return r.Method
+		}),
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return !quietPaths[r.URL.Path]
+		}),
+	)
+}
+
+// routeTemplate returns the template of the route matched for r, or "" if there is none
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return ""
+}
@@ -0,0 +1,153 @@
+package accounts
+
+import (
+	"database/sql"
+	"electric-car-sharing/services/common/internalapi"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Account statuses. Only active accounts may log in or rent.
+const (
+	StatusActive    = internalapi.StatusActive
+	StatusSuspended = "suspended"
+	StatusBanned    = "banned"
+	StatusClosed    = "closed"
+)
+
+// Events reported about an account that can trigger automatic suspension
+const (
+	EventChargeback   = "chargeback"
+	EventDamageReport = "damage_report"
+)
+
+// Damage reports within the window that suspend an account
+const (
+	DamageReportThreshold = 3
+	DamageReportWindow    = 90 * 24 * time.Hour
+)
+
+// ActorSystem is recorded in the audit trail for automatic status changes
+const ActorSystem = "system"
+
+var (
+	ErrUserNotFound  = errors.New("user not found")
+	ErrInvalidStatus = errors.New("invalid account status")
+	ErrInvalidEvent  = errors.New("invalid account event")
+	// ErrAccountDeleted refuses to change the status of an account erased at its owner's request
+	ErrAccountDeleted = errors.New("account has been deleted")
+)
+
+// ValidStatus reports whether status is one of the known account statuses
+func ValidStatus(status string) bool {
+	switch status {
+	case StatusActive, StatusSuspended, StatusBanned, StatusClosed:
+		return true
+	}
+	return false
+}
+
+// SetStatus changes the account status and records the change in account_status_audit.
+// It returns the previous status.
+func SetStatus(db *sql.DB, userID int, status, reason, actor string) (string, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	previous, err := SetStatusTx(tx, userID, status, reason, actor)
+	if err != nil {
+		return "", err
+	}
+	return previous, tx.Commit()
+}
+
+// SetStatusTx is SetStatus within an existing transaction. Deletion is final, so the status of a
+// deleted account cannot be changed.
+func SetStatusTx(tx *sql.Tx, userID int, status, reason, actor string) (string, error) {
+	if !ValidStatus(status) {
+		return "", ErrInvalidStatus
+	}
+
+	var previous string
+	var deleted bool
+	err := tx.QueryRow("SELECT status, deleted_at IS NOT NULL FROM users WHERE id = ? FOR UPDATE", userID).Scan(&previous, &deleted)
+	if err == sql.ErrNoRows {
+		return "", ErrUserNotFound
+	} else if err != nil {
+		return "", fmt.Errorf("fetch account status: %w", err)
+	}
+	if deleted {
+		return "", ErrAccountDeleted
+	}
+
+	now := time.Now().UTC()
+	updateQuery := "UPDATE users SET status = ?, status_reason = ?, status_changed_at = ? WHERE id = ?"
+	if _, err := tx.Exec(updateQuery, status, reason, now, userID); err != nil {
+		return "", fmt.Errorf("update account status: %w", err)
+	}
+
+	auditQuery := `
+		INSERT INTO account_status_audit (user_id, old_status, new_status, reason, actor, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	if _, err := tx.Exec(auditQuery, userID, previous, status, reason, actor, now); err != nil {
+		return "", fmt.Errorf("record status change: %w", err)
+	}
+	return previous, nil
+}
+
+// RecordEvent stores an account event and applies the automatic suspension rules: a chargeback
+// suspends immediately, and repeated damage reports suspend once they reach the threshold.
+// Only active accounts are suspended. It reports whether the account was suspended.
+func RecordEvent(db *sql.DB, userID int, event, detail string) (bool, error) {
+	if event != EventChargeback && event != EventDamageReport {
+		return false, ErrInvalidEvent
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var status string
+	err = tx.QueryRow("SELECT status FROM users WHERE id = ? FOR UPDATE", userID).Scan(&status)
+	if err == sql.ErrNoRows {
+		return false, ErrUserNotFound
+	} else if err != nil {
+		return false, fmt.Errorf("fetch account status: %w", err)
+	}
+
+	now := time.Now().UTC()
+	insertQuery := "INSERT INTO account_events (user_id, event, detail, created_at) VALUES (?, ?, ?, ?)"
+	if _, err := tx.Exec(insertQuery, userID, event, detail, now); err != nil {
+		return false, fmt.Errorf("record account event: %w", err)
+	}
+
+	var reason string
+	switch event {
+	case EventChargeback:
+		reason = "Automatic suspension after a payment chargeback"
+	case EventDamageReport:
+		var reports int
+		countQuery := "SELECT COUNT(*) FROM account_events WHERE user_id = ? AND event = ? AND created_at >= ?"
+		if err := tx.QueryRow(countQuery, userID, EventDamageReport, now.Add(-DamageReportWindow)).Scan(&reports); err != nil {
+			return false, fmt.Errorf("count damage reports: %w", err)
+		}
+		if reports >= DamageReportThreshold {
+			reason = fmt.Sprintf("Automatic suspension after %d damage reports", reports)
+		}
+	}
+
+	suspended := false
+	if reason != "" && status == StatusActive {
+		if _, err := SetStatusTx(tx, userID, StatusSuspended, reason, ActorSystem); err != nil {
+			return false, err
+		}
+		suspended = true
+	}
+	return suspended, tx.Commit()
+}
@@ -0,0 +1,216 @@
+package user_handlers
+
+import (
+	"context"
+	"database/sql"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/user-service/accounts"
+	"electric-car-sharing/services/user-service/mailer"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// accountStatusMessage explains to the user why their account cannot be used
+func accountStatusMessage(status, reason string) string {
+	message := "Account is " + status
+	if status == accounts.StatusClosed {
+		message = "Account has been closed"
+	}
+	if reason != "" {
+		message += ": " + reason
+	}
+	return message
+}
+
+// notifyStatusChange emails the user when their account is restricted or restored
+func notifyStatusChange(ctx context.Context, db *sql.DB, m mailer.Mailer, userID int, status, reason string) {
+	var name, email string
+	if err := db.QueryRowContext(ctx, "SELECT name, email FROM users WHERE id = ?", userID).Scan(&name, &email); err != nil {
+		slog.ErrorContext(ctx, "Error fetching user for status notification", "user_id", userID, "err", err)
+		return
+	}
+
+	body := fmt.Sprintf("Hi %s,\n\nYour account is now %s.", name, status)
+	if reason != "" {
+		body += "\nReason: " + reason
+	}
+	if status != accounts.StatusActive {
+		body += "\n\nYou will not be able to log in or rent vehicles. Contact support if you believe this is a mistake."
+	}
+	if err := m.Send(mailer.Message{To: email, Subject: "Your account status has changed", Body: body}); err != nil {
+		slog.ErrorContext(ctx, "Error sending status notification", "user_id", userID, "err", err)
+	}
+}
+
+// SetAccountStatus lets operators activate, suspend, ban or close an account. The operator's
+// name is taken from the X-Admin-Actor header for the audit trail.
+func SetAccountStatus(db *sql.DB, m mailer.Mailer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requireAdmin(w, r) {
+			return
+		}
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		var reqBody struct {
+			Status string `json:"status"`
+			Reason string `json:"reason"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
+			return
+		}
+		if !accounts.ValidStatus(reqBody.Status) {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "status must be active, suspended, banned or closed")
+			return
+		}
+		reqBody.Reason = strings.TrimSpace(reqBody.Reason)
+		if reqBody.Status != accounts.StatusActive && reqBody.Reason == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "A reason is required when restricting an account")
+			return
+		}
+
+		actor := strings.TrimSpace(r.Header.Get("X-Admin-Actor"))
+		if actor == "" {
+			actor = "admin"
+		}
+
+		previous, err := accounts.SetStatus(db, userID, reqBody.Status, reqBody.Reason, actor)
+		if errors.Is(err, accounts.ErrUserNotFound) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
+			return
+		} else if errors.Is(err, accounts.ErrAccountDeleted) {
+			apierror.Write(w, http.StatusConflict, apierror.CodeConflict, "Account has been deleted and cannot be changed")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error setting status", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to update account status")
+			return
+		}
+		if previous != reqBody.Status {
+			notifyStatusChange(r.Context(), db, m, userID, reqBody.Status, reqBody.Reason)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message":         "Account status updated",
+			"user_id":         userID,
+			"previous_status": previous,
+			"status":          reqBody.Status,
+		})
+	}
+}
+
+// AccountStatusHistory lets operators view the audit trail of status changes for a user
+func AccountStatusHistory(db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requireAdmin(w, r) {
+			return
+		}
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		query := `
+			SELECT old_status, new_status, reason, actor, created_at
+			FROM account_status_audit
+			WHERE user_id = ?
+			ORDER BY id
+		`
+		rows, err := db.Query(query, userID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching status history", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch status history")
+			return
+		}
+		defer rows.Close()
+
+		history := []map[string]interface{}{}
+		for rows.Next() {
+			var oldStatus, newStatus, actor, createdAt string
+			var reason sql.NullString
+			if err := rows.Scan(&oldStatus, &newStatus, &reason, &actor, &createdAt); err != nil {
+				slog.ErrorContext(r.Context(), "Error scanning status history", "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch status history")
+				return
+			}
+			history = append(history, map[string]interface{}{
+				"old_status": oldStatus,
+				"new_status": newStatus,
+				"reason":     nullableStringToString(reason),
+				"actor":      actor,
+				"created_at": createdAt,
+			})
+		}
+		if err := rows.Err(); err != nil {
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch status history")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"user_id": userID,
+			"history": history,
+		})
+	}
+}
+
+// ReportAccountEvent records an event such as a chargeback or damage report against a user and
+// applies the automatic suspension rules. It is an admin route: operators report the events,
+// since no service tracks chargebacks or damage itself.
+func ReportAccountEvent(db *sql.DB, m mailer.Mailer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requireAdmin(w, r) {
+			return
+		}
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		var reqBody struct {
+			Event  string `json:"event"`
+			Detail string `json:"detail"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
+			return
+		}
+
+		suspended, err := accounts.RecordEvent(db, userID, reqBody.Event, reqBody.Detail)
+		switch {
+		case errors.Is(err, accounts.ErrInvalidEvent):
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "event must be chargeback or damage_report")
+			return
+		case errors.Is(err, accounts.ErrUserNotFound):
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
+			return
+		case err != nil:
+			slog.ErrorContext(r.Context(), "Error recording account event", "event", reqBody.Event, "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to record account event")
+			return
+		}
+
+		if suspended {
+			var reason sql.NullString
+			db.QueryRow("SELECT status_reason FROM users WHERE id = ?", userID).Scan(&reason)
+			notifyStatusChange(r.Context(), db, m, userID, accounts.StatusSuspended, nullableStringToString(reason))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message":   "Account event recorded",
+			"user_id":   userID,
+			"event":     reqBody.Event,
+			"suspended": suspended,
+		})
+	}
+}
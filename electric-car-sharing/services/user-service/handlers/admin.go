@@ -0,0 +1,25 @@
+package user_handlers
+
+import (
+	"crypto/subtle"
+	"electric-car-sharing/services/common/apierror"
+	"net/http"
+)
+
+// AdminToken is the shared secret operators send in the X-Admin-Token header. Admin routes are
+// disabled while it is empty.
+var AdminToken = ""
+
+// requireAdmin checks the admin token, responding with an error if the caller is not an operator
+func requireAdmin(w http.ResponseWriter, r *http.Request) bool {
+	if AdminToken == "" {
+		apierror.Write(w, http.StatusForbidden, apierror.CodeAdminRequired, "Admin access is not configured")
+		return false
+	}
+	token := r.Header.Get("X-Admin-Token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(AdminToken)) != 1 {
+		apierror.Write(w, http.StatusForbidden, apierror.CodeAdminRequired, "Admin access required")
+		return false
+	}
+	return true
+}
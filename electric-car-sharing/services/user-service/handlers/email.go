@@ -0,0 +1,189 @@
+package user_handlers
+
+import (
+	"database/sql"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/user-service/loginguard"
+	"electric-car-sharing/services/user-service/mailer"
+	"electric-car-sharing/services/user-service/repository"
+	"electric-car-sharing/services/user-service/tokens"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const changeEmailTTL = 24 * time.Hour
+
+// normaliseEmail trims the address and checks it is a plain address without a display name
+func normaliseEmail(raw string) (string, bool) {
+	email := strings.TrimSpace(raw)
+	parsed, err := mail.ParseAddress(email)
+	if err != nil || parsed.Address != email {
+		return "", false
+	}
+	return email, true
+}
+
+// RequestEmailChange starts an email change. The new address is held in users.pending_email and a
+// confirmation link is sent to it; the current address keeps working for login until the link is
+// used. The current address is told about the request so an account takeover does not go unnoticed.
+func RequestEmailChange(users repository.Users, db *sql.DB, guard *loginguard.Guard, m mailer.Mailer, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		var reqBody struct {
+			Password string `json:"password"`
+			NewEmail string `json:"new_email"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
+			return
+		}
+		if reqBody.Password == "" || reqBody.NewEmail == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "password and new_email are required")
+			return
+		}
+		newEmail, ok := normaliseEmail(reqBody.NewEmail)
+		if !ok {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "new_email is not a valid email address")
+			return
+		}
+
+		user, ok := checkPassword(w, r, users, db, guard, m, signer, userID, reqBody.Password)
+		if !ok {
+			return
+		}
+		if strings.EqualFold(newEmail, user.Email) {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "new_email is the same as the current email")
+			return
+		}
+
+		// Checked again when the change is confirmed, since the address may be taken in between
+		if _, err := users.GetByEmail(r.Context(), newEmail); err == nil {
+			apierror.Write(w, http.StatusConflict, apierror.CodeEmailTaken, "Email is already in use")
+			return
+		} else if !errors.Is(err, repository.ErrNotFound) {
+			slog.ErrorContext(r.Context(), "Error checking email availability", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to request email change")
+			return
+		}
+
+		if err := users.SetPendingEmail(r.Context(), userID, newEmail); err != nil {
+			slog.ErrorContext(r.Context(), "Error storing pending email", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to request email change")
+			return
+		}
+
+		// Issuing revokes any earlier change link, so only the latest pending_email can be confirmed
+		token, err := signer.Issue(db, userID, tokens.PurposeChangeEmail, changeEmailTTL)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error issuing email change token", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to request email change")
+			return
+		}
+
+		link := fmt.Sprintf("%s/confirm-email-change?token=%s", PublicURL, url.QueryEscape(token))
+		err = m.Send(mailer.Message{
+			To:      newEmail,
+			Subject: "Confirm your new email address",
+			Body: fmt.Sprintf("Hi %s,\n\nOpen the link below within 24 hours to start using this address for your account:\n%s\n\nConfirmation code: %s",
+				user.Name, link, token),
+		})
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error sending email change confirmation", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to send confirmation email")
+			return
+		}
+
+		err = m.Send(mailer.Message{
+			To:      user.Email,
+			Subject: "Email change requested",
+			Body: fmt.Sprintf("Hi %s,\n\nA request was made to change your account email to %s. This address stays active until the change is confirmed.\n\n"+
+				"If you did not ask for this, reset your password straight away.", user.Name, newEmail),
+		})
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error notifying old address of email change", "user_id", userID, "err", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(map[string]string{
+			"message":       "Check your new email address to confirm the change",
+			"pending_email": newEmail,
+		})
+	}
+}
+
+// ConfirmEmailChange switches the account to the pending email. Like VerifyEmail the token is read
+// from the query string or a JSON body.
+func ConfirmEmailChange(users repository.Users, db *sql.DB, m mailer.Mailer, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("token")
+		if token == "" && r.Body != nil {
+			var reqBody struct {
+				Token string `json:"token"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err == nil {
+				token = reqBody.Token
+			}
+		}
+		if token == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "token is required")
+			return
+		}
+
+		userID, err := signer.Consume(db, token, tokens.PurposeChangeEmail)
+		if err != nil {
+			tokenError(r.Context(), w, err)
+			return
+		}
+
+		user, err := users.Get(r.Context(), userID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching pending email", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to change email")
+			return
+		}
+		if user.PendingEmail == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "No email change is pending")
+			return
+		}
+
+		// The new address was confirmed by following the link, so it is verified
+		if err := users.ConfirmPendingEmail(r.Context(), userID); err != nil {
+			if errors.Is(err, repository.ErrEmailTaken) {
+				apierror.Write(w, http.StatusConflict, apierror.CodeEmailTaken, "Email is already in use")
+				return
+			}
+			slog.ErrorContext(r.Context(), "Error changing email", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to change email")
+			return
+		}
+
+		err = m.Send(mailer.Message{
+			To:      user.Email,
+			Subject: "Your email address has been changed",
+			Body: fmt.Sprintf("Hi %s,\n\nYour account email is now %s and this address can no longer be used to log in.\n\n"+
+				"If you did not make this change, contact support immediately.", user.Name, user.PendingEmail),
+		})
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error notifying old address of email change", "user_id", userID, "err", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message": "Email changed successfully",
+			"user_id": userID,
+			"email":   user.PendingEmail,
+		})
+	}
+}
@@ -1,70 +1,74 @@
 package user_handlers
 
 import (
+	"context"
 	"database/sql"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/paging"
+	"electric-car-sharing/services/user-service/accounts"
+	"electric-car-sharing/services/user-service/loginguard"
+	"electric-car-sharing/services/user-service/mailer"
 	"electric-car-sharing/services/user-service/models"
+	"electric-car-sharing/services/user-service/profile"
+	"electric-car-sharing/services/user-service/repository"
+	"electric-car-sharing/services/user-service/tokens"
 	"encoding/json"
-	"log"
+	"errors"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 )
 
-// CreateUser handles user creation
-func CreateUser(db *sql.DB) http.HandlerFunc {
+// CreateUser handles user creation and sends the email verification link. db is only used for
+// the verification token.
+func CreateUser(users repository.Users, db *sql.DB, m mailer.Mailer, signer *tokens.Signer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var newUser models.User
 		err := json.NewDecoder(r.Body).Decode(&newUser)
 		if err != nil {
-			http.Error(w, "Invalid input", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid input")
 			return
 		}
 
 		// Validate user details
 		if newUser.Name == "" || newUser.Email == "" || newUser.Password == "" {
-			http.Error(w, "All fields are required", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "All fields are required")
 			return
 		}
 
 		// Encrypt the password
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newUser.Password), bcrypt.DefaultCost)
 		if err != nil {
-			log.Printf("Error hashing password: %v", err)
-			http.Error(w, "Failed to process password", http.StatusInternalServerError)
+			slog.ErrorContext(r.Context(), "Error hashing password", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to process password")
 			return
 		}
 
-		// Insert user into the database
-		query := "INSERT INTO users (name, email, password, membership_id) VALUES (?, ?, ?, ?)"
-        result, err := db.Exec(query, newUser.Name, newUser.Email, string(hashedPassword), 1) // Default membership ID = 1 (Basic)
-		if err != nil {
-			http.Error(w, "Failed to create user", http.StatusInternalServerError)
+		// Insert the user and their empty profile
+		created, err := users.Create(r.Context(), models.User{Name: newUser.Name, Email: newUser.Email, Password: string(hashedPassword)})
+		if errors.Is(err, repository.ErrEmailTaken) {
+			apierror.Write(w, http.StatusConflict, apierror.CodeEmailTaken, "Email is already in use")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error creating user", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to create user")
 			return
 		}
+		newUser.ID = created.ID
 
-        // Get the last inserted ID
-        id, err := result.LastInsertId()
-        if err != nil {
-            log.Printf("Error retrieving last inserted ID: %v", err)
-            http.Error(w, "Failed to retrieve user ID", http.StatusInternalServerError)
-            return
-        }
-		// Set the user ID
-		newUser.ID = int(id)
-
-		// Insert corresponding user details with NULL values
-		detailsQuery := "INSERT INTO user_details (id, address, phone_number, gender) VALUES (?, NULL, NULL, NULL)"
-		_, err = db.Exec(detailsQuery, newUser.ID)
-		if err != nil {
-			log.Printf("Error inserting user details: %v", err) // Log the error for debugging
-			http.Error(w, "Failed to create user details", http.StatusInternalServerError)
-			return
+		// The account stays unverified until the link is used; a failed send can be retried via /resend-verification
+		if err := sendVerificationEmail(db, m, signer, newUser.ID, newUser.Name, newUser.Email); err != nil {
+			slog.ErrorContext(r.Context(), "Error sending verification email", "user_id", newUser.ID, "err", err)
 		}
 
         // Prepare the response structure
         response := map[string]interface{}{
-            "message": "New user created",
+            "message": "New user created. Check your email to verify your address",
             "user": map[string]interface{}{
                 "id":       newUser.ID,
                 "name":     newUser.Name,
@@ -74,74 +78,66 @@ func CreateUser(db *sql.DB) http.HandlerFunc {
         }
 
 		// Respond with the created user
+		w.Header().Set("Location", "/api/v2/users/"+strconv.Itoa(newUser.ID))
 		w.WriteHeader(http.StatusCreated)
 		json.NewEncoder(w).Encode(response)
 	}
 }
 
 //fetch user details
-func ViewDetails(db *sql.DB) http.HandlerFunc {
+func ViewDetails(users repository.Users) http.HandlerFunc {
     return func(w http.ResponseWriter, r *http.Request) {
-        // Parse and validate the user_id from the query parameters
-        userID := r.URL.Query().Get("user_id")
-        if userID == "" {
-            http.Error(w, "User ID is required", http.StatusBadRequest)
+        id, ok := parseUserID(w, r)
+        if !ok {
             return
         }
 
-        // Convert userID to an integer
-        id, err := strconv.Atoi(userID)
+        details, err := users.Details(r.Context(), id)
+        if errors.Is(err, repository.ErrNotFound) {
+            slog.DebugContext(r.Context(), "User not found", "user_id", id)
+            apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
+            return
+        } else if err != nil {
+            slog.ErrorContext(r.Context(), "Error retrieving user details", "user_id", id, "err", err)
+            apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to retrieve user details")
+            return
+        }
+        user, err := users.Get(r.Context(), id)
         if err != nil {
-            log.Printf("Invalid User ID: %s", userID)
-            http.Error(w, "Invalid User ID", http.StatusBadRequest)
+            slog.ErrorContext(r.Context(), "Error retrieving user", "user_id", id, "err", err)
+            apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to retrieve user details")
             return
         }
 
-        log.Printf("Executing query for user_id: %d", id)
-
-        // Query the database for user details
-        // Query the database for user details
-        query := `
-            SELECT users.id, users.name, users.email, user_details.address, user_details.phone_number, user_details.gender
-            FROM users
-            LEFT JOIN user_details ON users.id = user_details.id
-            WHERE users.id = ?
-        `
-        log.Printf("Query: %s", query)
-
-        var user struct {
-            ID          int    `json:"user_id"`
-            Name        string `json:"name"`
-            Email       string `json:"email"`
-            Address     string `json:"address"`
-            PhoneNumber string `json:"phone_number"`
-            Gender      string `json:"gender"`
+        response := struct {
+            ID             int              `json:"user_id"`
+            Name           string           `json:"name"`
+            Email          string           `json:"email"`
+            Address        string           `json:"address"`
+            AddressDetails *profile.Address `json:"address_details"`
+            PhoneNumber    string           `json:"phone_number"`
+            Gender         string           `json:"gender"`
+            Timezone       string           `json:"timezone"`
+        }{
+            ID:          user.ID,
+            Name:        user.Name,
+            Email:       user.Email,
+            Address:     details.Address,
+            PhoneNumber: details.PhoneNumber,
+            Gender:      details.Gender,
+            Timezone:    details.Timezone,
         }
-
-        // Use sql.NullString for nullable fields
-        var address, phoneNumber, gender sql.NullString
-
-        err = db.QueryRow(query, id).Scan(&user.ID, &user.Name, &user.Email, &address, &phoneNumber, &gender)
-        if err == sql.ErrNoRows {
-            log.Printf("No user found with user_id: %d", id)
-            http.Error(w, "User not found", http.StatusNotFound)
-            return
-        } else if err != nil {
-            log.Printf("Error retrieving user details for user_id %d: %v", id, err)
-            http.Error(w, "Failed to retrieve user details", http.StatusInternalServerError)
-            return
+        if details.Street != "" {
+            response.AddressDetails = &profile.Address{
+                Street:     details.Street,
+                Unit:       details.Unit,
+                PostalCode: details.PostalCode,
+            }
         }
 
-        // Assign nullable fields to user struct, handling NULL values
-        user.Address = nullableStringToString(address)
-        user.PhoneNumber = nullableStringToString(phoneNumber)
-        user.Gender = nullableStringToString(gender)
-
-        log.Printf("Retrieved user details: %+v", user)
-
         // Respond with the user details
         w.Header().Set("Content-Type", "application/json")
-        json.NewEncoder(w).Encode(user)
+        json.NewEncoder(w).Encode(response)
     }
 }
 
@@ -153,54 +149,118 @@ func nullableStringToString(ns sql.NullString) string {
     return ""
 }
 
-func UpdateDetails(db *sql.DB) http.HandlerFunc {
-    return func(w http.ResponseWriter, r *http.Request) {
-        // Parse and validate the user_id from the query parameters
-        userID := r.URL.Query().Get("user_id")
-        if userID == "" {
-            http.Error(w, "User ID is required", http.StatusBadRequest)
-            return
-        }
-
-        // Convert userID to an integer
-        id, err := strconv.Atoi(userID)
-        if err != nil {
-            http.Error(w, "Invalid User ID", http.StatusBadRequest)
-            return
-        }
+// UpdateDetails validates and applies a partial update to the user's profile. Fields left out of
+// the body are unchanged and fields sent as null are cleared. The phone number is stored in E.164
+// form, the address must be a structured Singapore address and the timezone an IANA zone name.
+func UpdateDetails(users repository.Users) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
 
-        // Parse the request body for user details to be updated
-        var updateData struct {
-            Address     string `json:"address,omitempty"`
-            PhoneNumber string `json:"phone_number,omitempty"`
-            Gender      string `json:"gender,omitempty"`
-        }
+		// Decode into raw values so a missing field can be told apart from an explicit null
+		var updateData map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
+			return
+		}
 
-        // Decode the JSON request body into the updateData struct
-        err = json.NewDecoder(r.Body).Decode(&updateData)
-        if err != nil {
-            http.Error(w, "Invalid request body", http.StatusBadRequest)
-            return
-        }
+		errs := profile.FieldErrors{}
+		for field := range updateData {
+			if field != "address" && field != "phone_number" && field != "gender" && field != "timezone" {
+				errs[field] = "unknown field"
+			}
+		}
 
-        // Build the SQL query for updating the user's details
-        query := `UPDATE user_details SET`
-        var args []interface{}
-        if updateData.Address != "" {
-            query += " address = ?,"
-            args = append(args, updateData.Address)
-        }
-        if updateData.PhoneNumber != "" {
-            query += " phone_number = ?,"
-            args = append(args, updateData.PhoneNumber)
-        }
-        if updateData.Gender != "" {
-            query += " gender = ?,"
-            args = append(args, updateData.Gender)
-        }
+		// Validate every field first, collecting the changes to apply to the stored profile
+		var changes []func(*models.Details)
+		for _, field := range []string{"address", "phone_number", "gender", "timezone"} {
+			raw, present := updateData[field]
+			if !present {
+				continue
+			}
+			isNull := string(raw) == "null"
+			switch field {
+			case "address":
+				if isNull {
+					changes = append(changes, func(d *models.Details) {
+						d.Address, d.Street, d.Unit, d.PostalCode = "", "", "", ""
+					})
+					continue
+				}
+				var address profile.Address
+				if err := json.Unmarshal(raw, &address); err != nil {
+					errs["address"] = "address must be an object with street, unit and postal_code"
+					continue
+				}
+				address, addressErrs := profile.NormaliseAddress(address)
+				if len(addressErrs) > 0 {
+					for k, v := range addressErrs {
+						errs[k] = v
+					}
+					continue
+				}
+				changes = append(changes, func(d *models.Details) {
+					d.Address, d.Street, d.Unit, d.PostalCode = address.Format(), address.Street, address.Unit, address.PostalCode
+				})
+			case "phone_number":
+				if isNull {
+					changes = append(changes, func(d *models.Details) { d.PhoneNumber = "" })
+					continue
+				}
+				var phone string
+				if err := json.Unmarshal(raw, &phone); err != nil {
+					errs[field] = "phone_number must be a string"
+					continue
+				}
+				normalised, err := profile.NormalisePhone(phone)
+				if err != nil {
+					errs[field] = err.Error()
+					continue
+				}
+				changes = append(changes, func(d *models.Details) { d.PhoneNumber = normalised })
+			case "gender":
+				if isNull {
+					changes = append(changes, func(d *models.Details) { d.Gender = "" })
+					continue
+				}
+				var gender string
+				if err := json.Unmarshal(raw, &gender); err != nil {
+					errs[field] = "gender must be a string"
+					continue
+				}
+				normalised, err := profile.NormaliseGender(gender)
+				if err != nil {
+					errs[field] = err.Error()
+					continue
+				}
+				changes = append(changes, func(d *models.Details) { d.Gender = normalised })
+			case "timezone":
+				if isNull {
+					changes = append(changes, func(d *models.Details) { d.Timezone = "" })
+					continue
+				}
+				var timezone string
+				if err := json.Unmarshal(raw, &timezone); err != nil {
+					errs[field] = "timezone must be a string"
+					continue
+				}
+				normalised, err := profile.NormaliseTimezone(timezone)
+				if err != nil {
+					errs[field] = err.Error()
+					continue
+				}
+				changes = append(changes, func(d *models.Details) { d.Timezone = normalised })
+			}
+		}
+		if len(errs) > 0 {
+			apierror.WriteFields(w, "Validation failed", errs)
+			return
+		}
 
 		// If no fields to update, respond with a message
-		if len(args) == 0 {
+		if len(changes) == 0 {
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(map[string]string{
@@ -208,95 +268,80 @@ func UpdateDetails(db *sql.DB) http.HandlerFunc {
 			})
 			return
 		}
-        // Remove the last comma from the query string
-        query = query[:len(query)-1] // Remove the trailing comma
-        query += " WHERE id = ?"
-        args = append(args, id)
-
-        // Execute the update query
-        res, err := db.Exec(query, args...)
-        if err != nil {
-            http.Error(w, "Failed to update user details", http.StatusInternalServerError)
-            return
-        }
-
-        // Check if any rows were updated
-        rowsAffected, err := res.RowsAffected()
-        if err != nil {
-            http.Error(w, "Failed to check affected rows", http.StatusInternalServerError)
-            return
-        }
 
+		// The changes are applied to the stored profile as it is saved, so a concurrent update of
+		// other fields is kept
+		changed, err := users.UpdateDetails(r.Context(), id, func(details *models.Details) {
+			for _, change := range changes {
+				change(details)
+			}
+		})
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error updating details", "user_id", id, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to update user details")
+			return
+		}
 
-        if rowsAffected == 0 {
-            w.Header().Set("Content-Type", "application/json")
-            w.WriteHeader(http.StatusOK)
-            json.NewEncoder(w).Encode(map[string]string{
-                "message": "No changes made; details already up-to-date",
-            })
-            return
-        }
+		if !changed {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]string{
+				"message": "No changes made; details already up-to-date",
+			})
+			return
+		}
 
-        // Respond with success
-        w.Header().Set("Content-Type", "application/json")
-        w.WriteHeader(http.StatusOK)
-        json.NewEncoder(w).Encode(map[string]string{
-            "message": "User details updated successfully",
-        })
-    }
+		// Respond with success
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]string{
+			"message": "User details updated successfully",
+		})
+	}
 }
 
 
 // UpdateMembership handles updating a user's membership
-func UpdateMembership(db *sql.DB) http.HandlerFunc {
+func UpdateMembership(users repository.Users) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Extract user_id and membership_id from the request
 		type RequestBody struct {
 			MembershipID int `json:"membership_id"`
 		}
 
-		// Parse query parameters for user ID
-		userIDStr := r.URL.Query().Get("user_id")
-		if userIDStr == "" {
-			http.Error(w, "user_id is required", http.StatusBadRequest)
-			return
-		}
-
-		// Convert user_id to an integer
-		userID, err := strconv.Atoi(userIDStr)
-		if err != nil {
-			http.Error(w, "Invalid user_id", http.StatusBadRequest)
+		userID, ok := parseUserID(w, r)
+		if !ok {
 			return
 		}
 
 		// Parse JSON body for membership_id
 		var reqBody RequestBody
-		err = json.NewDecoder(r.Body).Decode(&reqBody)
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
 		if err != nil {
-			http.Error(w, "Invalid input", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid input")
 			return
 		}
 
 		if reqBody.MembershipID <= 0 {
-			http.Error(w, "membership_id must be a positive integer", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "membership_id must be a positive integer")
 			return
 		}
 
 		// Check the current membership ID for the user
-		var currentMembershipID int
-		query := "SELECT membership_id FROM users WHERE id = ?"
-		err = db.QueryRow(query, userID).Scan(&currentMembershipID)
-		if err != nil {
-			if err == sql.ErrNoRows {
-				http.Error(w, "User not found", http.StatusNotFound)
-			} else {
-				http.Error(w, "Failed to fetch current membership", http.StatusInternalServerError)
-			}
+		user, err := users.Get(r.Context(), userID)
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
+			return
+		} else if err != nil {
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch current membership")
 			return
 		}
 
 		// If the current membership ID is the same as the new one, return success
-		if currentMembershipID == reqBody.MembershipID {
+		if user.MembershipID == reqBody.MembershipID {
 			response := map[string]string{
 				"message": "Membership ID is already set to the same value",
 			}
@@ -304,11 +349,14 @@ func UpdateMembership(db *sql.DB) http.HandlerFunc {
 			json.NewEncoder(w).Encode(response)
 			return
 		}
-		// Update the membership in the database
-		query = "UPDATE users SET membership_id = ? WHERE id = ?"
-		_, err = db.Exec(query, reqBody.MembershipID, userID)
-		if err != nil {
-			http.Error(w, "Failed to update membership", http.StatusInternalServerError)
+		// Update the membership
+		err = users.SetMembership(r.Context(), userID, reqBody.MembershipID)
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "Membership not found")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error updating membership", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to update membership")
 			return
 		}
 
@@ -321,46 +369,43 @@ func UpdateMembership(db *sql.DB) http.HandlerFunc {
 		}
 }
 
-func ViewMembership(db *sql.DB) http.HandlerFunc {
+// ViewMembership shows the user's membership tier
+func ViewMembership(users repository.Users, memberships repository.Memberships) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Parse the user_id from the query parameters
-		userID := r.URL.Query().Get("user_id")
-		if userID == "" {
-			http.Error(w, "User ID is required", http.StatusBadRequest)
+		userID, ok := parseUserID(w, r)
+		if !ok {
 			return
 		}
 
-		// Query the database for membership details
-		query := `
-			SELECT users.id, users.name, memberships.id AS membership_id, memberships.name AS membership_name
-			FROM users
-			LEFT JOIN memberships ON users.membership_id = memberships.id
-			WHERE users.id = ?
-		`
-		var user struct {
-			ID             int    `json:"user_id"`
-			Name           string `json:"name"`
-			MembershipID   int    `json:"membership_id,omitempty"`
-			MembershipName string `json:"membership_name,omitempty"`
-		}
-
-		err := db.QueryRow(query, userID).Scan(&user.ID, &user.Name, &user.MembershipID, &user.MembershipName)
-		if err == sql.ErrNoRows {
-			http.Error(w, "User not found", http.StatusNotFound)
+		user, err := users.Get(r.Context(), userID)
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
 			return
 		} else if err != nil {
-			http.Error(w, "Failed to retrieve membership details", http.StatusInternalServerError)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to retrieve membership details")
+			return
+		}
+		membership, err := memberships.Get(r.Context(), user.MembershipID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching membership", "membership_id", user.MembershipID, "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to retrieve membership details")
 			return
 		}
 
 		// Respond with the membership details
 		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(user)
+		json.NewEncoder(w).Encode(struct {
+			ID             int    `json:"user_id"`
+			Name           string `json:"name"`
+			MembershipID   int    `json:"membership_id,omitempty"`
+			MembershipName string `json:"membership_name,omitempty"`
+		}{user.ID, user.Name, membership.ID, membership.Name})
 	}
 }
 
-// Login handles user login and returns the user ID if successful
-func Login(db *sql.DB) http.HandlerFunc {
+// Login handles user login and returns the user ID if successful. Failed attempts are throttled
+// per account and per IP by the guard, and repeated failures lock the account.
+func Login(users repository.Users, db *sql.DB, guard *loginguard.Guard, m mailer.Mailer, signer *tokens.Signer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var loginData struct {
 			Email    string `json:"email"`
@@ -370,33 +415,76 @@ func Login(db *sql.DB) http.HandlerFunc {
 		// Parse the login data
 		err := json.NewDecoder(r.Body).Decode(&loginData)
 		if err != nil {
-			http.Error(w, "Invalid input", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid input")
 			return
 		}
 
 		// Validate the input
 		if loginData.Email == "" || loginData.Password == "" {
-			http.Error(w, "Email and password are required", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Email and password are required")
 			return
 		}
 
-        // Query the database for the user's hashed password
-        query := "SELECT id, password FROM users WHERE email = ?"
-        var userID int
-        var hashedPassword string
-        err = db.QueryRow(query, loginData.Email).Scan(&userID, &hashedPassword)
-        if err == sql.ErrNoRows {
-            http.Error(w, "Invalid credentials", http.StatusUnauthorized)
+		// Refuse throttled or locked attempts before doing any password work
+		ip := clientIP(r)
+		if refuseThrottled(w, r, db, guard, 0, loginData.Email, ip) {
+			return
+		}
+
+        // Look up the user's hashed password
+        user, err := users.GetByEmail(r.Context(), loginData.Email)
+        if errors.Is(err, repository.ErrNotFound) {
+            guard.Fail(loginData.Email, ip)
+            recordFailedLogin(r.Context(), db, 0, loginData.Email, ip, "unknown_email")
+            apierror.Write(w, http.StatusUnauthorized, apierror.CodeInvalidCredentials, "Invalid credentials")
             return
         } else if err != nil {
-            http.Error(w, "Error verifying credentials", http.StatusInternalServerError)
+            apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Error verifying credentials")
             return
         }
+        userID := user.ID
 		
 		// Compare the provided password with the hashed password
-		err = bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(loginData.Password))
+		err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(loginData.Password))
+		if err != nil {
+			failAttempt(r.Context(), db, guard, m, signer, user, ip, "bad_password")
+			apierror.Write(w, http.StatusUnauthorized, apierror.CodeInvalidCredentials, "Invalid credentials")
+			return
+		}
+		guard.Succeed(loginData.Email)
+
+		// Suspended, banned and closed accounts cannot log in even with the right password
+		if user.Status != accounts.StatusActive {
+			apierror.Write(w, http.StatusForbidden, apierror.CodeAccountInactive, accountStatusMessage(user.Status, user.StatusReason))
+			return
+		}
+
+		// Only verified accounts may log in
+		if !user.EmailVerified {
+			apierror.Write(w, http.StatusForbidden, apierror.CodeEmailNotVerified, "Email address has not been verified")
+			return
+		}
+
+		// Accounts with two-factor enabled get a short-lived challenge to exchange for the user ID
+		// at /login/2fa instead of the user ID itself
+		enabled, err := twoFactorEnabled(db, userID)
 		if err != nil {
-			http.Error(w, "Invalid credentials", http.StatusUnauthorized)
+			slog.ErrorContext(r.Context(), "Error checking two-factor status", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Error verifying credentials")
+			return
+		}
+		if enabled {
+			challenge, err := signer.Issue(db, userID, tokens.PurposeLoginChallenge, loginChallengeTTL)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "Error issuing login challenge", "user_id", userID, "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Error verifying credentials")
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"two_factor_required": true,
+				"challenge_token":     challenge,
+			})
 			return
 		}
 
@@ -406,25 +494,16 @@ func Login(db *sql.DB) http.HandlerFunc {
 	}
 }
 
-func UpdatePassword(db *sql.DB) http.HandlerFunc {
+func UpdatePassword(users repository.Users) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Ensure the request body exists
 		if r.Body == nil {
-			http.Error(w, "Request body is required", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Request body is required")
 			return
 		}
 
-		// Parse the user_id from the query parameters
-		userID := r.URL.Query().Get("user_id")
-		if userID == "" {
-			http.Error(w, "User ID is required", http.StatusBadRequest)
-			return
-		}
-
-		// Convert userID to an integer
-		id, err := strconv.Atoi(userID)
-		if err != nil || id <= 0 {
-			http.Error(w, "Invalid User ID", http.StatusBadRequest)
+		id, ok := parseUserID(w, r)
+		if !ok {
 			return
 		}
 
@@ -436,53 +515,49 @@ func UpdatePassword(db *sql.DB) http.HandlerFunc {
 
 		// Parse the request body
 		var reqBody RequestBody
-		err = json.NewDecoder(r.Body).Decode(&reqBody)
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
 		if err != nil {
-			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
 			return
 		}
 
 		// Validate the input
 		if reqBody.OldPassword == "" || reqBody.NewPassword == "" {
-			http.Error(w, "Old and new passwords are required", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Old and new passwords are required")
 			return
 		}
 
 		// Fetch the current password for the user
-		var currentPasswordHash string
-		query := "SELECT password FROM users WHERE id = ?"
-		err = db.QueryRow(query, id).Scan(&currentPasswordHash)
+		user, err := users.Get(r.Context(), id)
 		if err != nil {
-			if err == sql.ErrNoRows {
-				http.Error(w, "User not found", http.StatusNotFound)
+			if errors.Is(err, repository.ErrNotFound) {
+				apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
 			} else {
-				log.Printf("Error fetching password for user %d: %v", id, err)
-				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				slog.ErrorContext(r.Context(), "Error fetching password", "user_id", id, "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Internal server error")
 			}
 			return
 		}
 
 		// Compare the old password with the stored password
-		err = bcrypt.CompareHashAndPassword([]byte(currentPasswordHash), []byte(reqBody.OldPassword))
+		err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(reqBody.OldPassword))
 		if err != nil {
-			http.Error(w, "Invalid credentials", http.StatusUnauthorized)
+			apierror.Write(w, http.StatusUnauthorized, apierror.CodeInvalidCredentials, "Invalid credentials")
 			return
 		}
 
 		// Hash the new password
 		newPasswordHash, err := bcrypt.GenerateFromPassword([]byte(reqBody.NewPassword), bcrypt.DefaultCost)
 		if err != nil {
-			log.Printf("Error hashing new password for user %d: %v", id, err)
-			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			slog.ErrorContext(r.Context(), "Error hashing new password", "user_id", id, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Internal server error")
 			return
 		}
 
-		// Update the password in the database
-		updateQuery := "UPDATE users SET password = ? WHERE id = ?"
-		_, err = db.Exec(updateQuery, newPasswordHash, id)
-		if err != nil {
-			log.Printf("Error updating password for user %d: %v", id, err)
-			http.Error(w, "Failed to update password", http.StatusInternalServerError)
+		// Store the new password hash
+		if err := users.SetPassword(r.Context(), id, string(newPasswordHash)); err != nil {
+			slog.ErrorContext(r.Context(), "Error updating password", "user_id", id, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to update password")
 			return
 		}
 
@@ -494,84 +569,70 @@ func UpdatePassword(db *sql.DB) http.HandlerFunc {
 	}
 }
 
-// ViewAllRentals displays all rentals made by a specific user
-func ViewAllRentals(db *sql.DB) http.HandlerFunc {
+// Location is the time zone rental times are shown in for users who have not chosen their own
+var Location = time.UTC
+
+// detailsLocation returns the zone the user chose in their profile, or Location
+func detailsLocation(ctx context.Context, users repository.Users, userID int) *time.Location {
+	details, err := users.Details(ctx, userID)
+	if err != nil {
+		slog.WarnContext(ctx, "Error fetching time zone, using the default", "user_id", userID, "err", err)
+		return Location
+	}
+	if details.Timezone == "" {
+		return Location
+	}
+	location, err := time.LoadLocation(details.Timezone)
+	if err != nil {
+		return Location
+	}
+	return location
+}
+
+// ViewAllRentals lists a page of a specific user's rentals, with times in their time zone. The
+// paging, sort and filter parameters are passed on to the vehicle service, which owns rentals.
+func ViewAllRentals(users repository.Users, vehicles *internalapi.Vehicles) http.HandlerFunc {
     return func(w http.ResponseWriter, r *http.Request) {
-        // Parse user ID from query parameters
-        userIDParam := r.URL.Query().Get("user_id")
-        if userIDParam == "" {
-            http.Error(w, "Query parameter 'user_id' is required", http.StatusBadRequest)
+        userID, ok := parseUserID(w, r)
+        if !ok {
             return
         }
 
-        // Convert user ID to an integer
-        userID, err := strconv.Atoi(userIDParam)
-        if err != nil || userID <= 0 {
-            http.Error(w, "Query parameter 'user_id' must be a positive integer", http.StatusBadRequest)
-            return
+        // Rentals belong to the vehicle service
+        query := url.Values{}
+        for _, name := range []string{"limit", "cursor", "sort", "status", "from", "to"} {
+            if value := r.URL.Query().Get(name); value != "" {
+                query.Set(name, value)
+            }
         }
-
-        // Query to get all rentals for the user
-        query := `
-            SELECT id, vehicle_id, start_date, end_date, status, overtime_hours
-            FROM rentals
-            WHERE user_id = ?
-        `
-        rows, err := db.Query(query, userID)
-        if err != nil {
-            http.Error(w, "Failed to fetch rentals: "+err.Error(), http.StatusInternalServerError)
+        userRentals, page, err := vehicles.RentalsPage(r.Context(), userID, query)
+        var apiErr *apierror.Error
+        if errors.As(err, &apiErr) && apiErr.Code == apierror.CodeInvalidRequest {
+            apierror.Write(w, http.StatusBadRequest, apiErr.Code, apiErr.Message)
+            return
+        } else if err != nil {
+            internalapi.WriteUnavailable(w, r, "vehicle", err)
             return
         }
-        defer rows.Close()
 
         // Create a slice to hold rental records
-        var rentals []map[string]interface{}
-
-        // Iterate over the result set
-        for rows.Next() {
-            var rental struct {
-                ID            int
-                VehicleID     int
-                StartDate     string
-                EndDate       string
-                Status        string
-                OvertimeHours int
-            }
-
-            // Scan the row into the rental struct
-            err := rows.Scan(&rental.ID, &rental.VehicleID, &rental.StartDate, &rental.EndDate, &rental.Status, &rental.OvertimeHours)
-            if err != nil {
-                http.Error(w, "Failed to scan rental record: "+err.Error(), http.StatusInternalServerError)
-                return
-            }
-
-            // Add the rental record to the rentals slice
+        rentals := []map[string]interface{}{}
+        location := Location
+        if len(userRentals) > 0 {
+            location = detailsLocation(r.Context(), users, userID)
+        }
+        for _, rental := range userRentals {
             rentals = append(rentals, map[string]interface{}{
                 "id":             rental.ID,
                 "vehicle_id":     rental.VehicleID,
-                "start_date":     rental.StartDate,
-                "end_date":       rental.EndDate,
+                "start_date":     rental.StartDate.In(location).Format(time.RFC3339),
+                "end_date":       rental.EndDate.In(location).Format(time.RFC3339),
                 "status":         rental.Status,
                 "overtime_hours": rental.OvertimeHours,
             })
         }
 
-        // Check for errors during iteration
-        if err := rows.Err(); err != nil {
-            http.Error(w, "Error reading rental rows: "+err.Error(), http.StatusInternalServerError)
-            return
-        }
-
-        // Respond with the rental data
-        w.Header().Set("Content-Type", "application/json")
-        if len(rentals) == 0 {
-            json.NewEncoder(w).Encode(map[string]interface{}{
-                "message": "No rentals found for the user",
-            })
-        } else {
-            json.NewEncoder(w).Encode(map[string]interface{}{
-                "rentals": rentals,
-            })
-        }
+        // Respond with the page of rentals
+        paging.Write(w, rentals, page)
     }
 }
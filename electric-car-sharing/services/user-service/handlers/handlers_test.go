@@ -0,0 +1,192 @@
+package user_handlers
+
+import (
+	"context"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/user-service/models"
+	"electric-car-sharing/services/user-service/repository"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// service is the user service's routes running against the in-memory store. Routes that still
+// need the database are not exercised here.
+type service struct {
+	t      *testing.T
+	router *mux.Router
+	store  *repository.Memory
+}
+
+func newService(t *testing.T) *service {
+	s := &service{t: t, router: mux.NewRouter(), store: repository.NewMemory()}
+	Register(s.router, Dependencies{Users: s.store.Users(), Memberships: s.store.Memberships()})
+	return s
+}
+
+// user adds a user with the given password and returns their ID as a path segment
+func (s *service) user(name, email, password string) string {
+	s.t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	user, err := s.store.Users().Create(context.Background(), models.User{Name: name, Email: email, Password: string(hash)})
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	return strconv.Itoa(user.ID)
+}
+
+// do sends the request and checks the status, decoding the response into a map
+func (s *service) do(method, path, body string, status int) map[string]interface{} {
+	s.t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	if rec.Code != status {
+		s.t.Fatalf("%s %s: got %d, want %d: %s", method, path, rec.Code, status, rec.Body)
+	}
+	var resp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+	return resp
+}
+
+// errorCode returns the code of an error response
+func errorCode(resp map[string]interface{}) string {
+	e, _ := resp["error"].(map[string]interface{})
+	code, _ := e["code"].(string)
+	return code
+}
+
+func TestUpdateDetails(t *testing.T) {
+	s := newService(t)
+	id := s.user("Ada", "ada@example.com", "secret")
+	path := "/api/v2/users/" + id
+
+	s.do("PATCH", path, `{"phone_number":"9123 4567","gender":"female","timezone":"Asia/Singapore",
+		"address":{"street":"1 Raffles Place","unit":"10-01","postal_code":"048616"}}`, http.StatusOK)
+	details := s.do("GET", path, "", http.StatusOK)
+	want := map[string]interface{}{
+		"name":         "Ada",
+		"email":        "ada@example.com",
+		"address":      "1 Raffles Place, #10-01, Singapore 048616",
+		"phone_number": "+6591234567",
+		"gender":       "Female",
+		"timezone":     "Asia/Singapore",
+	}
+	for field, value := range want {
+		if details[field] != value {
+			t.Errorf("%s: got %v, want %v", field, details[field], value)
+		}
+	}
+
+	// Fields left out are kept and fields sent as null are cleared
+	s.do("PATCH", path, `{"gender":null}`, http.StatusOK)
+	details = s.do("GET", path, "", http.StatusOK)
+	if details["gender"] != "" || details["phone_number"] != "+6591234567" {
+		t.Errorf("after clearing gender: %v", details)
+	}
+
+	resp := s.do("PATCH", path, `{"gender":null}`, http.StatusOK)
+	if resp["message"] != "No changes made; details already up-to-date" {
+		t.Errorf("repeated update: %v", resp)
+	}
+}
+
+func TestUpdateDetailsConcurrently(t *testing.T) {
+	s := newService(t)
+	id := s.user("Ada", "ada@example.com", "secret")
+
+	// Updates of different fields at the same time all keep each other's changes
+	updates := []string{`{"phone_number":"9123 4567"}`, `{"gender":"female"}`, `{"timezone":"Asia/Singapore"}`}
+	var wg sync.WaitGroup
+	for _, body := range updates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := httptest.NewRecorder()
+			s.router.ServeHTTP(rec, httptest.NewRequest("PATCH", "/api/v2/users/"+id, strings.NewReader(body)))
+			if rec.Code != http.StatusOK {
+				t.Errorf("PATCH %s: got %d: %s", body, rec.Code, rec.Body)
+			}
+		}()
+	}
+	wg.Wait()
+
+	details := s.do("GET", "/api/v2/users/"+id, "", http.StatusOK)
+	if details["phone_number"] != "+6591234567" || details["gender"] != "Female" || details["timezone"] != "Asia/Singapore" {
+		t.Errorf("after concurrent updates: %v", details)
+	}
+}
+
+func TestUpdateDetailsRefusals(t *testing.T) {
+	s := newService(t)
+	id := s.user("Ada", "ada@example.com", "secret")
+
+	resp := s.do("PATCH", "/api/v2/users/"+id, `{"phone_number":"123","timezone":"Mars/Olympus","nickname":"A"}`, http.StatusUnprocessableEntity)
+	fields, _ := resp["error"].(map[string]interface{})["fields"].(map[string]interface{})
+	for _, field := range []string{"phone_number", "timezone", "nickname"} {
+		if _, ok := fields[field]; !ok {
+			t.Errorf("no error for %s: %v", field, resp)
+		}
+	}
+	// Nothing is saved when any field is refused
+	s.do("PATCH", "/api/v2/users/"+id, `{"gender":"male","timezone":"Mars/Olympus"}`, http.StatusUnprocessableEntity)
+	if details := s.do("GET", "/api/v2/users/"+id, "", http.StatusOK); details["gender"] != "" {
+		t.Errorf("gender saved from a refused update: %v", details)
+	}
+
+	resp = s.do("PATCH", "/api/v2/users/99", `{"gender":"male"}`, http.StatusNotFound)
+	if errorCode(resp) != apierror.CodeNotFound {
+		t.Errorf("unknown user: %v", resp)
+	}
+}
+
+func TestUpdatePassword(t *testing.T) {
+	s := newService(t)
+	id := s.user("Ada", "ada@example.com", "secret")
+	path := "/api/v2/users/" + id + "/password"
+
+	resp := s.do("PUT", path, `{"old_password":"wrong","new_password":"better"}`, http.StatusUnauthorized)
+	if errorCode(resp) != apierror.CodeInvalidCredentials {
+		t.Errorf("wrong old password: %v", resp)
+	}
+	s.do("PUT", path, `{"old_password":"secret","new_password":"better"}`, http.StatusOK)
+
+	user, _ := s.store.Users().Get(context.Background(), 1)
+	if bcrypt.CompareHashAndPassword([]byte(user.Password), []byte("better")) != nil {
+		t.Error("new password was not stored")
+	}
+	s.do("PUT", path, `{"old_password":"secret","new_password":"again"}`, http.StatusUnauthorized)
+}
+
+func TestMembership(t *testing.T) {
+	s := newService(t)
+	id := s.user("Ada", "ada@example.com", "secret")
+	path := "/api/v2/users/" + id + "/membership"
+
+	if resp := s.do("GET", path, "", http.StatusOK); resp["membership_name"] != "Basic" {
+		t.Errorf("new user's membership: %v", resp)
+	}
+	s.do("PUT", path, `{"membership_id":3}`, http.StatusOK)
+	if resp := s.do("GET", path, "", http.StatusOK); resp["membership_name"] != "VIP" {
+		t.Errorf("after upgrading: %v", resp)
+	}
+	resp := s.do("PUT", path, `{"membership_id":9}`, http.StatusNotFound)
+	if errorCode(resp) != apierror.CodeNotFound {
+		t.Errorf("unknown membership: %v", resp)
+	}
+
+	// The v1 route answers from the same handler
+	if resp := s.do("GET", "/view-membership?user_id="+id, "", http.StatusOK); resp["membership_name"] != "VIP" {
+		t.Errorf("v1 membership: %v", resp)
+	}
+}
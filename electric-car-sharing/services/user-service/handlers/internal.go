@@ -0,0 +1,57 @@
+package user_handlers
+
+import (
+	"database/sql"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/user-service/models"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// RentalProfile is the internal endpoint the vehicle and billing services use to check a user's
+// account status, membership, licence and preferred time zone without reading the user tables
+// themselves
+func RentalProfile(db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := strconv.Atoi(mux.Vars(r)["id"])
+		if err != nil || userID <= 0 {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "User ID must be a positive integer")
+			return
+		}
+
+		var profile internalapi.RentalProfile
+		var statusReason, licenceExpiry, timezone sql.NullString
+		query := `
+			SELECT users.id, users.status, users.status_reason, memberships.id, memberships.name,
+				memberships.hourly_rate_discount, memberships.vip_access,
+				(SELECT MAX(expiry_date) FROM driver_licences WHERE user_id = users.id AND status = ?),
+				user_details.timezone
+			FROM users
+			JOIN memberships ON users.membership_id = memberships.id
+			LEFT JOIN user_details ON user_details.id = users.id
+			WHERE users.id = ?
+		`
+		err = db.QueryRow(query, models.LicenceApproved, userID).Scan(&profile.UserID, &profile.Status, &statusReason,
+			&profile.Membership.ID, &profile.Membership.Name, &profile.Membership.HourlyRateDiscount,
+			&profile.Membership.VIPAccess, &licenceExpiry, &timezone)
+		if err == sql.ErrNoRows {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found or membership not set")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching rental profile", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch rental profile")
+			return
+		}
+		profile.StatusReason = statusReason.String
+		profile.LicenceExpiry = licenceExpiry.String
+		profile.Timezone = timezone.String
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(profile)
+	}
+}
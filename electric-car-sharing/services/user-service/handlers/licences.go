@@ -0,0 +1,405 @@
+package user_handlers
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/user-service/mailer"
+	"electric-car-sharing/services/user-service/models"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// LicenceUploadDir is where uploaded licence images are stored
+var LicenceUploadDir = "uploads/licences"
+
+const (
+	maxLicenceImageSize = 5 << 20
+	licenceDateLayout   = "2006-01-02"
+)
+
+// Accepted licence image types and the extension they are stored with
+var licenceImageTypes = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"application/pdf": ".pdf",
+}
+
+var (
+	licenceNumberPattern = regexp.MustCompile(`^[A-Z0-9-]{4,32}$`)
+	countryCodePattern   = regexp.MustCompile(`^[A-Z]{2}$`)
+	licenceSelectColumns = "id, user_id, licence_number, issuing_country, expiry_date, status, review_note, submitted_at, reviewed_at"
+)
+
+// scanLicence reads a driver_licences row selected with licenceSelectColumns
+func scanLicence(row interface{ Scan(...interface{}) error }) (models.DriverLicence, error) {
+	var licence models.DriverLicence
+	var reviewNote, reviewedAt sql.NullString
+	err := row.Scan(&licence.ID, &licence.UserID, &licence.LicenceNumber, &licence.IssuingCountry,
+		&licence.ExpiryDate, &licence.Status, &reviewNote, &licence.SubmittedAt, &reviewedAt)
+	licence.ReviewNote = nullableStringToString(reviewNote)
+	licence.ReviewedAt = nullableStringToString(reviewedAt)
+	return licence, err
+}
+
+// saveLicenceImage validates the uploaded image and writes it under LicenceUploadDir, returning its path
+func saveLicenceImage(file io.Reader) (string, error) {
+	data, err := io.ReadAll(io.LimitReader(file, maxLicenceImageSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(data) > maxLicenceImageSize {
+		return "", fmt.Errorf("image must be at most %d MB", maxLicenceImageSize>>20)
+	}
+	ext, ok := licenceImageTypes[http.DetectContentType(data)]
+	if !ok {
+		return "", fmt.Errorf("image must be a JPEG, PNG or PDF")
+	}
+
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(LicenceUploadDir, 0o750); err != nil {
+		return "", err
+	}
+	path := filepath.Join(LicenceUploadDir, hex.EncodeToString(raw)+ext)
+	if err := os.WriteFile(path, data, 0o640); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// parseLicenceID reads the positive licence ID from the {id} path variable, responding with an
+// error if it is invalid
+func parseLicenceID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	licenceID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || licenceID <= 0 {
+		apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid licence ID")
+		return 0, false
+	}
+	return licenceID, true
+}
+
+// SubmitLicence accepts a multipart form with licence_number, issuing_country, expiry_date
+// (YYYY-MM-DD) and an image file, and queues the licence for operator review
+func SubmitLicence(db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		if err := r.ParseMultipartForm(maxLicenceImageSize); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid multipart form")
+			return
+		}
+
+		licenceNumber := strings.ToUpper(strings.TrimSpace(r.FormValue("licence_number")))
+		country := strings.ToUpper(strings.TrimSpace(r.FormValue("issuing_country")))
+		expiryStr := strings.TrimSpace(r.FormValue("expiry_date"))
+
+		if !licenceNumberPattern.MatchString(licenceNumber) {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "licence_number must be 4-32 letters, digits or dashes")
+			return
+		}
+		if !countryCodePattern.MatchString(country) {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "issuing_country must be a two-letter ISO country code")
+			return
+		}
+		expiry, err := time.Parse(licenceDateLayout, expiryStr)
+		if err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "expiry_date must be in YYYY-MM-DD format")
+			return
+		}
+		if !expiry.After(time.Now()) {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Licence has already expired")
+			return
+		}
+
+		file, _, err := r.FormFile("image")
+		if err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "image is required")
+			return
+		}
+		defer file.Close()
+
+		// Only one submission can wait for review at a time
+		var pendingExists bool
+		pendingQuery := "SELECT EXISTS (SELECT 1 FROM driver_licences WHERE user_id = ? AND status = 'pending')"
+		if err := db.QueryRow(pendingQuery, userID).Scan(&pendingExists); err != nil {
+			slog.ErrorContext(r.Context(), "Error checking pending licences", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to submit licence")
+			return
+		}
+		if pendingExists {
+			apierror.Write(w, http.StatusConflict, apierror.CodeLicencePending, "A licence is already awaiting review")
+			return
+		}
+
+		imagePath, err := saveLicenceImage(file)
+		if err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid image: "+err.Error())
+			return
+		}
+
+		query := `
+			INSERT INTO driver_licences (user_id, licence_number, issuing_country, expiry_date, image_path, status)
+			VALUES (?, ?, ?, ?, ?, 'pending')
+		`
+		result, err := db.Exec(query, userID, licenceNumber, country, expiry.Format(licenceDateLayout), imagePath)
+		if err != nil {
+			os.Remove(imagePath)
+			slog.ErrorContext(r.Context(), "Error inserting licence", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to submit licence")
+			return
+		}
+		licenceID, _ := result.LastInsertId()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message":    "Licence submitted for review",
+			"licence_id": licenceID,
+			"status":     models.LicencePending,
+		})
+	}
+}
+
+// ViewLicence returns the user's most recent licence submission
+func ViewLicence(db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		query := "SELECT " + licenceSelectColumns + " FROM driver_licences WHERE user_id = ? ORDER BY id DESC LIMIT 1"
+		licence, err := scanLicence(db.QueryRow(query, userID))
+		if err == sql.ErrNoRows {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "No licence submitted")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching licence", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch licence")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(licence)
+	}
+}
+
+// ListLicences lets operators list licence submissions, by default those awaiting review
+func ListLicences(db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requireAdmin(w, r) {
+			return
+		}
+
+		status := r.URL.Query().Get("status")
+		if status == "" {
+			status = models.LicencePending
+		}
+		if status != models.LicencePending && status != models.LicenceApproved && status != models.LicenceRejected {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "status must be pending, approved or rejected")
+			return
+		}
+
+		query := "SELECT " + licenceSelectColumns + " FROM driver_licences WHERE status = ? ORDER BY submitted_at"
+		rows, err := db.Query(query, status)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error listing licences", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to list licences")
+			return
+		}
+		defer rows.Close()
+
+		licences := []models.DriverLicence{}
+		for rows.Next() {
+			licence, err := scanLicence(rows)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "Error scanning licence", "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to list licences")
+				return
+			}
+			licences = append(licences, licence)
+		}
+		if err := rows.Err(); err != nil {
+			slog.ErrorContext(r.Context(), "Error reading licence rows", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to list licences")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(licences)
+	}
+}
+
+// LicenceImage lets operators view the uploaded image for a submission
+func LicenceImage(db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requireAdmin(w, r) {
+			return
+		}
+
+		licenceID, ok := parseLicenceID(w, r)
+		if !ok {
+			return
+		}
+
+		var imagePath string
+		err := db.QueryRow("SELECT image_path FROM driver_licences WHERE id = ?", licenceID).Scan(&imagePath)
+		if err == sql.ErrNoRows {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "Licence not found")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching licence", "licence_id", licenceID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch licence image")
+			return
+		}
+
+		http.ServeFile(w, r, imagePath)
+	}
+}
+
+// ReviewLicence lets operators approve or reject a pending licence. The user is emailed the outcome.
+func ReviewLicence(db *sql.DB, m mailer.Mailer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requireAdmin(w, r) {
+			return
+		}
+		licenceID, ok := parseLicenceID(w, r)
+		if !ok {
+			return
+		}
+
+		var reqBody struct {
+			Decision string `json:"decision"`
+			Note     string `json:"note"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
+			return
+		}
+
+		var status string
+		switch reqBody.Decision {
+		case "approve":
+			status = models.LicenceApproved
+		case "reject":
+			status = models.LicenceRejected
+			if strings.TrimSpace(reqBody.Note) == "" {
+				apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "A note explaining the rejection is required")
+				return
+			}
+		default:
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "decision must be approve or reject")
+			return
+		}
+
+		// Only pending submissions can be reviewed, so two operators cannot both decide
+		query := "UPDATE driver_licences SET status = ?, review_note = ?, reviewed_at = ? WHERE id = ? AND status = 'pending'"
+		res, err := db.Exec(query, status, reqBody.Note, time.Now().UTC(), licenceID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error reviewing licence", "licence_id", licenceID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to review licence")
+			return
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to check affected rows")
+			return
+		}
+		if rowsAffected == 0 {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "Licence not found or already reviewed")
+			return
+		}
+
+		var name, email string
+		userQuery := "SELECT u.name, u.email FROM users u JOIN driver_licences l ON l.user_id = u.id WHERE l.id = ?"
+		if err := db.QueryRow(userQuery, licenceID).Scan(&name, &email); err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching owner", "licence_id", licenceID, "err", err)
+		} else {
+			body := fmt.Sprintf("Hi %s,\n\nYour driver's licence has been approved. You can now rent vehicles.", name)
+			if status == models.LicenceRejected {
+				body = fmt.Sprintf("Hi %s,\n\nYour driver's licence could not be approved: %s\n\nPlease submit it again.", name, reqBody.Note)
+			}
+			if err := m.Send(mailer.Message{To: email, Subject: "Your driver's licence review", Body: body}); err != nil {
+				slog.ErrorContext(r.Context(), "Error sending licence review email", "licence_id", licenceID, "err", err)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message":    "Licence reviewed",
+			"licence_id": licenceID,
+			"status":     status,
+		})
+	}
+}
+
+// NotifyExpiringLicences emails users whose approved licence expires within the given window.
+// Each licence is only notified once. It returns the number of users notified.
+func NotifyExpiringLicences(db *sql.DB, m mailer.Mailer, within time.Duration) (int, error) {
+	now := time.Now()
+	query := `
+		SELECT l.id, l.expiry_date, u.name, u.email
+		FROM driver_licences l
+		JOIN users u ON u.id = l.user_id
+		WHERE l.status = 'approved' AND l.expiry_notified_at IS NULL AND l.expiry_date BETWEEN ? AND ?
+	`
+	rows, err := db.Query(query, now.Format(licenceDateLayout), now.Add(within).Format(licenceDateLayout))
+	if err != nil {
+		return 0, err
+	}
+
+	type expiring struct {
+		id                      int
+		expiryDate, name, email string
+	}
+	var licences []expiring
+	for rows.Next() {
+		var l expiring
+		if err := rows.Scan(&l.id, &l.expiryDate, &l.name, &l.email); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		licences = append(licences, l)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	notified := 0
+	for _, l := range licences {
+		err := m.Send(mailer.Message{
+			To:      l.email,
+			Subject: "Your driver's licence is about to expire",
+			Body: fmt.Sprintf("Hi %s,\n\nYour driver's licence on file expires on %s. "+
+				"Submit your renewed licence before then to keep renting vehicles.", l.name, l.expiryDate),
+		})
+		if err != nil {
+			slog.Error("Error sending expiry notice", "licence_id", l.id, "err", err)
+			continue
+		}
+		if _, err := db.Exec("UPDATE driver_licences SET expiry_notified_at = ? WHERE id = ?", now.UTC(), l.id); err != nil {
+			return notified, err
+		}
+		notified++
+	}
+	return notified, nil
+}
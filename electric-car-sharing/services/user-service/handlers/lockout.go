@@ -0,0 +1,134 @@
+package user_handlers
+
+import (
+	"context"
+	"database/sql"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/user-service/loginguard"
+	"electric-car-sharing/services/user-service/mailer"
+	"electric-car-sharing/services/user-service/models"
+	"electric-car-sharing/services/user-service/tokens"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const unlockAccountTTL = 24 * time.Hour
+
+// clientIP returns the remote address of the request without the port
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// recordFailedLogin writes an audit record for a failed or refused login. userID is 0 when the
+// email does not belong to an account.
+func recordFailedLogin(ctx context.Context, db *sql.DB, userID int, email, ip, reason string) {
+	var user sql.NullInt64
+	if userID > 0 {
+		user = sql.NullInt64{Int64: int64(userID), Valid: true}
+	}
+	query := "INSERT INTO failed_logins (user_id, email, ip_address, reason) VALUES (?, ?, ?, ?)"
+	if _, err := db.ExecContext(ctx, query, user, email, ip, reason); err != nil {
+		slog.ErrorContext(ctx, "Error recording failed login", "err", err)
+	}
+}
+
+// refuseThrottled answers an attempt the guard holds back for the email or IP, with 423 if the
+// account is locked and 429 otherwise, and reports whether it did. userID is 0 when the email does
+// not belong to an account.
+func refuseThrottled(w http.ResponseWriter, r *http.Request, db *sql.DB, guard *loginguard.Guard, userID int, email, ip string) bool {
+	decision := guard.Check(email, ip)
+	if decision.Allowed {
+		return false
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+	if decision.Locked {
+		recordFailedLogin(r.Context(), db, userID, email, ip, "locked")
+		apierror.Write(w, http.StatusLocked, apierror.CodeAccountLocked, "Account is temporarily locked. Check your email for an unlock link")
+	} else {
+		recordFailedLogin(r.Context(), db, userID, email, ip, "throttled")
+		apierror.Write(w, http.StatusTooManyRequests, apierror.CodeRateLimited, "Too many failed login attempts. Try again later")
+	}
+	return true
+}
+
+// failAttempt records a wrong password or code for the user and mails them an unlock link if it
+// locked the account
+func failAttempt(ctx context.Context, db *sql.DB, guard *loginguard.Guard, m mailer.Mailer, signer *tokens.Signer, user models.User, ip, reason string) {
+	recordFailedLogin(ctx, db, user.ID, user.Email, ip, reason)
+	if guard.Fail(user.Email, ip) {
+		sendUnlockEmail(ctx, db, m, signer, user.ID, user.Name, user.Email)
+	}
+}
+
+// sendUnlockEmail tells the owner their account was locked and mails a link that lifts the lock
+func sendUnlockEmail(ctx context.Context, db *sql.DB, m mailer.Mailer, signer *tokens.Signer, userID int, name, email string) {
+	token, err := signer.Issue(db, userID, tokens.PurposeUnlockAccount, unlockAccountTTL)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error issuing unlock token", "user_id", userID, "err", err)
+		return
+	}
+	link := fmt.Sprintf("%s/unlock-account?token=%s", PublicURL, url.QueryEscape(token))
+	err = m.Send(mailer.Message{
+		To:      email,
+		Subject: "Your account has been locked",
+		Body: fmt.Sprintf("Hi %s,\n\nWe locked your account after too many failed login attempts. "+
+			"If this was you, open the link below to unlock it now, or reset your password if you have forgotten it. "+
+			"If it was not you, consider changing your password.\n%s\n\nUnlock code: %s", name, link, token),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Error sending unlock email", "user_id", userID, "err", err)
+	}
+}
+
+// UnlockAccount lifts a login lockout using the token from the lockout email
+func UnlockAccount(db *sql.DB, guard *loginguard.Guard, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("token")
+		if token == "" && r.Body != nil {
+			var reqBody struct {
+				Token string `json:"token"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err == nil {
+				token = reqBody.Token
+			}
+		}
+		if token == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "token is required")
+			return
+		}
+
+		userID, err := signer.Consume(db, token, tokens.PurposeUnlockAccount)
+		if err != nil {
+			tokenError(r.Context(), w, err)
+			return
+		}
+
+		var email string
+		err = db.QueryRow("SELECT email FROM users WHERE id = ?", userID).Scan(&email)
+		if err == sql.ErrNoRows {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching email", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to unlock account")
+			return
+		}
+		guard.Unlock(email)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"message": "Account unlocked successfully",
+		})
+	}
+}
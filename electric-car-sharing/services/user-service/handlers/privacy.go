@@ -0,0 +1,314 @@
+package user_handlers
+
+import (
+	"context"
+	"database/sql"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/user-service/accounts"
+	"electric-car-sharing/services/user-service/loginguard"
+	"electric-car-sharing/services/user-service/mailer"
+	"electric-car-sharing/services/user-service/repository"
+	"electric-car-sharing/services/user-service/tokens"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+)
+
+// queryRecords runs a query and returns every row as a map keyed by column name, for the data export
+func queryRecords(db *sql.DB, query string, args ...interface{}) ([]map[string]interface{}, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	records := []map[string]interface{}{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+
+		record := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			// Text columns and timestamps come back as bytes
+			if b, ok := values[i].([]byte); ok {
+				record[column] = string(b)
+			} else {
+				record[column] = values[i]
+			}
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// exportProfile returns the user's account and profile for the data export, with unset fields
+// as null
+func exportProfile(ctx context.Context, users repository.Users, memberships repository.Memberships, userID int) (map[string]interface{}, error) {
+	user, err := users.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	details, err := users.Details(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var membership interface{}
+	if m, err := memberships.Get(ctx, user.MembershipID); err == nil {
+		membership = m.Name
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+
+	orNull := func(s string) interface{} {
+		if s == "" {
+			return nil
+		}
+		return s
+	}
+	return map[string]interface{}{
+		"id":                  user.ID,
+		"name":                user.Name,
+		"email":               user.Email,
+		"pending_email":       orNull(user.PendingEmail),
+		"email_verified":      user.EmailVerified,
+		"status":              user.Status,
+		"status_reason":       orNull(user.StatusReason),
+		"membership":          membership,
+		"address":             orNull(details.Address),
+		"address_street":      orNull(details.Street),
+		"address_unit":        orNull(details.Unit),
+		"address_postal_code": orNull(details.PostalCode),
+		"phone_number":        orNull(details.PhoneNumber),
+		"gender":              orNull(details.Gender),
+		"timezone":            orNull(details.Timezone),
+	}, nil
+}
+
+// ExportData returns everything held about the user as a downloadable JSON archive: profile,
+// licences, rentals, invoices, payments and account security history. Rentals and invoices are
+// fetched from the vehicle and billing services.
+func ExportData(users repository.Users, memberships repository.Memberships, db *sql.DB,
+	vehicles *internalapi.Vehicles, billing *internalapi.Billing) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		profile, err := exportProfile(r.Context(), users, memberships, userID)
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error exporting profile", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to export data")
+			return
+		}
+
+		sections := []struct {
+			name  string
+			query string
+		}{
+			{"licences", "SELECT id, licence_number, issuing_country, expiry_date, status, review_note, submitted_at, reviewed_at FROM driver_licences WHERE user_id = ? ORDER BY id"},
+			{"account_status_history", "SELECT old_status, new_status, reason, actor, created_at FROM account_status_audit WHERE user_id = ? ORDER BY id"},
+			{"failed_logins", "SELECT ip_address, reason, created_at FROM failed_logins WHERE user_id = ? ORDER BY id"},
+		}
+
+		archive := map[string]interface{}{
+			"exported_at": time.Now().UTC().Format(time.RFC3339),
+			"profile":     profile,
+		}
+		for _, section := range sections {
+			records, err := queryRecords(db, section.query, userID)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "Error exporting section", "section", section.name, "user_id", userID, "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to export data")
+				return
+			}
+			archive[section.name] = records
+		}
+
+		rentals, err := vehicles.UserRentals(r.Context(), userID)
+		if err != nil {
+			internalapi.WriteUnavailable(w, r, "vehicle", err)
+			return
+		}
+		invoices, err := billing.UserInvoices(r.Context(), userID)
+		if err != nil {
+			internalapi.WriteUnavailable(w, r, "billing", err)
+			return
+		}
+
+		// Payments are not stored separately; a paid invoice is the record of its payment
+		payments := []map[string]interface{}{}
+		for _, invoice := range invoices {
+			if invoice.PaidStatus {
+				payments = append(payments, map[string]interface{}{
+					"invoice_id":  invoice.ID,
+					"amount":      invoice.FinalCost,
+					"invoiced_at": invoice.CreatedAt,
+				})
+			}
+		}
+		// Rental and invoice times stay in UTC, like exported_at
+		archive["rentals"] = rentals
+		archive["invoices"] = invoices
+		archive["payments"] = payments
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"user-%d-export.json\"", userID))
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(archive)
+	}
+}
+
+// DeleteAccount erases the user's personal data at their request. Rentals and invoices are kept
+// for accounting and stay linked to the anonymised account row, so the row itself is never deleted.
+func DeleteAccount(users repository.Users, db *sql.DB, guard *loginguard.Guard, m mailer.Mailer, signer *tokens.Signer,
+	vehicles *internalapi.Vehicles, billing *internalapi.Billing) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		var reqBody struct {
+			Password string `json:"password"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || reqBody.Password == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "password is required")
+			return
+		}
+		user, ok := checkPassword(w, r, users, db, guard, m, signer, userID, reqBody.Password)
+		if !ok {
+			return
+		}
+		email := user.Email
+
+		// Outstanding rentals and invoices have to be settled before the account can go
+		rentals, err := vehicles.UserRentals(r.Context(), userID)
+		if err != nil {
+			internalapi.WriteUnavailable(w, r, "vehicle", err)
+			return
+		}
+		invoices, err := billing.UserInvoices(r.Context(), userID)
+		if err != nil {
+			internalapi.WriteUnavailable(w, r, "billing", err)
+			return
+		}
+
+		var activeRental, unpaidInvoice bool
+		for _, rental := range rentals {
+			activeRental = activeRental || rental.Status == internalapi.RentalActive
+		}
+		for _, invoice := range invoices {
+			unpaidInvoice = unpaidInvoice || !invoice.PaidStatus
+		}
+		if activeRental {
+			apierror.Write(w, http.StatusConflict, apierror.CodeRentalInProgress, "Complete or cancel your active rental before deleting your account")
+			return
+		}
+		if unpaidInvoice {
+			apierror.Write(w, http.StatusConflict, apierror.CodeUnpaidInvoices, "Pay your outstanding invoices before deleting your account")
+			return
+		}
+
+		// Licence images live on disk and are removed once the rows are gone
+		var imagePaths []string
+		rows, err := db.Query("SELECT image_path FROM driver_licences WHERE user_id = ?", userID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching licence images", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to delete account")
+			return
+		}
+		for rows.Next() {
+			var path string
+			if err := rows.Scan(&path); err == nil {
+				imagePaths = append(imagePaths, path)
+			}
+		}
+		rows.Close()
+
+		tx, err := db.Begin()
+		if err != nil {
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to begin transaction")
+			return
+		}
+		defer tx.Rollback()
+
+		// Closed first, since the status of a deleted account can no longer be changed
+		if _, err := accounts.SetStatusTx(tx, userID, accounts.StatusClosed, "Deleted at the user's request", fmt.Sprintf("user:%d", userID)); err != nil {
+			slog.ErrorContext(r.Context(), "Error closing account", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to delete account")
+			return
+		}
+
+		now := time.Now().UTC()
+		statements := []struct {
+			query string
+			args  []interface{}
+		}{
+			{"UPDATE users SET name = 'Deleted User', email = ?, password = '', pending_email = NULL, email_verified = FALSE, deleted_at = ? WHERE id = ?",
+				[]interface{}{fmt.Sprintf("deleted-%d@deleted.invalid", userID), now, userID}},
+			{"UPDATE user_details SET address = NULL, address_street = NULL, address_unit = NULL, address_postal_code = NULL, " +
+				"phone_number = NULL, gender = NULL, timezone = NULL WHERE id = ?", []interface{}{userID}},
+			{"UPDATE failed_logins SET email = '', ip_address = '' WHERE user_id = ? OR email = ?", []interface{}{userID, email}},
+			{"DELETE FROM driver_licences WHERE user_id = ?", []interface{}{userID}},
+			{"DELETE FROM user_recovery_codes WHERE user_id = ?", []interface{}{userID}},
+			{"DELETE FROM user_totp WHERE user_id = ?", []interface{}{userID}},
+			{"DELETE FROM user_tokens WHERE user_id = ?", []interface{}{userID}},
+			// Stored responses to the user's retried requests hold their details too
+			{"DELETE FROM user_idempotency_keys WHERE user_id = ?", []interface{}{userID}},
+		}
+		for _, statement := range statements {
+			if _, err := tx.Exec(statement.query, statement.args...); err != nil {
+				slog.ErrorContext(r.Context(), "Error anonymising user", "user_id", userID, "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to delete account")
+				return
+			}
+		}
+		if err := tx.Commit(); err != nil {
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to commit transaction")
+			return
+		}
+
+		for _, path := range imagePaths {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				slog.ErrorContext(r.Context(), "Error removing licence image", "user_id", userID, "err", err)
+			}
+		}
+
+		// The old address is only used for this final confirmation
+		err = m.Send(mailer.Message{
+			To:      email,
+			Subject: "Your account has been deleted",
+			Body: fmt.Sprintf("Hi %s,\n\nYour account and personal data have been deleted. "+
+				"Records of past rentals and invoices are kept without your personal details, as required for accounting.", user.Name),
+		})
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error sending deletion confirmation", "user_id", userID, "err", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"message": "Account deleted. Personal data has been erased",
+		})
+	}
+}
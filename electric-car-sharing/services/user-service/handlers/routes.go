@@ -0,0 +1,173 @@
+package user_handlers
+
+import (
+	"database/sql"
+	"electric-car-sharing/services/common/compat"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/paging"
+	"electric-car-sharing/services/user-service/loginguard"
+	"electric-car-sharing/services/user-service/mailer"
+	"electric-car-sharing/services/user-service/repository"
+	"electric-car-sharing/services/user-service/tokens"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// Dependencies are what the user service's routes need
+type Dependencies struct {
+	// DB serves the tables not yet behind a repository: tokens, two-factor, licences and audit
+	DB          *sql.DB
+	Users       repository.Users
+	Memberships repository.Memberships
+	Mailer      mailer.Mailer
+	Signer      *tokens.Signer
+	Guard       *loginguard.Guard
+	Vehicles    *internalapi.Vehicles
+	Billing     *internalapi.Billing
+	// InternalToken is required from callers of the /internal/ routes; empty accepts anyone
+	InternalToken string
+}
+
+// Register adds the user service's routes to router: the resource routes under /api/v2, the v1
+// routes they replace and the /internal/ routes. The probes are left to main.
+func Register(router *mux.Router, deps Dependencies) {
+	db, users, m, signer, guard := deps.DB, deps.Users, deps.Mailer, deps.Signer, deps.Guard
+
+	createUser := CreateUser(users, db, m, signer)
+	verifyEmail := VerifyEmail(db, signer)
+	resendVerification := ResendVerification(db, m, signer)
+	requestPasswordReset := RequestPasswordReset(db, m, signer)
+	resetPassword := ResetPassword(db, guard, signer)
+	unlockAccount := UnlockAccount(db, guard, signer)
+	confirmEmailChange := ConfirmEmailChange(users, db, m, signer)
+	login := Login(users, db, guard, m, signer)
+	loginTwoFactor := LoginTwoFactor(users, db, guard, m, signer)
+	viewDetails := ViewDetails(users)
+	updateDetails := UpdateDetails(users)
+	deleteAccount := DeleteAccount(users, db, guard, m, signer, deps.Vehicles, deps.Billing)
+	updatePassword := UpdatePassword(users)
+	viewMembership := ViewMembership(users, deps.Memberships)
+	updateMembership := UpdateMembership(users)
+	viewRentals := ViewAllRentals(users, deps.Vehicles)
+	submitLicence := SubmitLicence(db)
+	viewLicence := ViewLicence(db)
+	exportData := ExportData(users, deps.Memberships, db, deps.Vehicles, deps.Billing)
+	requestEmailChange := RequestEmailChange(users, db, guard, m, signer)
+	enrollTwoFactor := EnrollTwoFactor(users, db, guard, m, signer)
+	confirmTwoFactor := ConfirmTwoFactor(users, db, guard, m, signer)
+	disableTwoFactor := DisableTwoFactor(users, db, guard, m, signer)
+	regenerateRecoveryCodes := RegenerateRecoveryCodes(users, db, guard, m, signer)
+	listLicences := ListLicences(db)
+	licenceImage := LicenceImage(db)
+	reviewLicence := ReviewLicence(db, m)
+	setAccountStatus := SetAccountStatus(db, m)
+	accountStatusHistory := AccountStatusHistory(db)
+	reportAccountEvent := ReportAccountEvent(db, m)
+
+	v2 := router.PathPrefix("/api/v2").Subrouter()
+	v2.HandleFunc("/users", createUser).Methods("POST")
+	v2.HandleFunc("/email-verifications", verifyEmail).Methods("POST")
+	v2.HandleFunc("/verification-emails", resendVerification).Methods("POST")
+	v2.HandleFunc("/password-resets", requestPasswordReset).Methods("POST")
+	v2.HandleFunc("/password-resets/confirmation", resetPassword).Methods("POST")
+	v2.HandleFunc("/account-unlocks", unlockAccount).Methods("POST")
+	v2.HandleFunc("/email-changes/confirmation", confirmEmailChange).Methods("POST")
+	v2.HandleFunc("/sessions", login).Methods("POST")
+	v2.HandleFunc("/sessions/two-factor", loginTwoFactor).Methods("POST")
+	v2.HandleFunc("/users/{id:[0-9]+}", viewDetails).Methods("GET")
+	v2.HandleFunc("/users/{id:[0-9]+}", updateDetails).Methods("PATCH")
+	v2.HandleFunc("/users/{id:[0-9]+}", deleteAccount).Methods("DELETE")
+	v2.HandleFunc("/users/{id:[0-9]+}/password", updatePassword).Methods("PUT")
+	v2.HandleFunc("/users/{id:[0-9]+}/membership", viewMembership).Methods("GET")
+	v2.HandleFunc("/users/{id:[0-9]+}/membership", updateMembership).Methods("PUT")
+	v2.HandleFunc("/users/{id:[0-9]+}/rentals", viewRentals).Methods("GET")
+	v2.HandleFunc("/users/{id:[0-9]+}/licences", submitLicence).Methods("POST")
+	v2.HandleFunc("/users/{id:[0-9]+}/licences/latest", viewLicence).Methods("GET")
+	v2.HandleFunc("/users/{id:[0-9]+}/export", exportData).Methods("GET")
+	v2.HandleFunc("/users/{id:[0-9]+}/email-change", requestEmailChange).Methods("POST")
+	v2.HandleFunc("/users/{id:[0-9]+}/two-factor", enrollTwoFactor).Methods("POST")
+	v2.HandleFunc("/users/{id:[0-9]+}/two-factor", disableTwoFactor).Methods("DELETE")
+	v2.HandleFunc("/users/{id:[0-9]+}/two-factor/confirmation", confirmTwoFactor).Methods("POST")
+	v2.HandleFunc("/users/{id:[0-9]+}/two-factor/recovery-codes", regenerateRecoveryCodes).Methods("POST")
+
+	// Operator routes, protected by the X-Admin-Token header
+	v2.HandleFunc("/admin/licences", listLicences).Methods("GET")
+	v2.HandleFunc("/admin/licences/{id:[0-9]+}/image", licenceImage).Methods("GET")
+	v2.HandleFunc("/admin/licences/{id:[0-9]+}/review", reviewLicence).Methods("POST")
+	v2.HandleFunc("/admin/users/{id:[0-9]+}/status", setAccountStatus).Methods("PUT")
+	v2.HandleFunc("/admin/users/{id:[0-9]+}/status-history", accountStatusHistory).Methods("GET")
+	v2.HandleFunc("/admin/users/{id:[0-9]+}/events", reportAccountEvent).Methods("POST")
+
+	// The links in emails are opened in a browser with the token in the query, so they stay
+	// where they are rather than moving under /api/v2
+	router.HandleFunc("/verify-email", verifyEmail).Methods("GET")
+	router.HandleFunc("/unlock-account", unlockAccount).Methods("GET")
+	router.HandleFunc("/confirm-email-change", confirmEmailChange).Methods("GET")
+
+	// The deprecated v1 routes, answered by their v2 handlers
+	userID := compat.QueryToPath("user_id", "id")
+	v1 := func(path, method, successor string, handler http.HandlerFunc, rewrites ...compat.Rewrite) {
+		route := compat.Route{Successor: successor, Rewrites: rewrites}
+		router.HandleFunc(path, compat.Handler(route, handler)).Methods(method)
+	}
+	v1("/create-user", "POST", "/api/v2/users", createUser)
+	v1("/verify-email", "POST", "/api/v2/email-verifications", verifyEmail)
+	v1("/resend-verification", "POST", "/api/v2/verification-emails", resendVerification)
+	v1("/request-password-reset", "POST", "/api/v2/password-resets", requestPasswordReset)
+	v1("/reset-password", "POST", "/api/v2/password-resets/confirmation", resetPassword)
+	v1("/unlock-account", "POST", "/api/v2/account-unlocks", unlockAccount)
+	v1("/confirm-email-change", "POST", "/api/v2/email-changes/confirmation", confirmEmailChange)
+	v1("/login", "POST", "/api/v2/sessions", login)
+	v1("/login/2fa", "POST", "/api/v2/sessions/two-factor", loginTwoFactor)
+	v1("/view-details", "GET", "/api/v2/users/{id}", viewDetails, userID)
+	v1("/update-details", "PATCH", "/api/v2/users/{id}", updateDetails, userID)
+	v1("/update-details", "POST", "/api/v2/users/{id}", updateDetails, userID)
+	v1("/delete-account", "POST", "/api/v2/users/{id}", deleteAccount, userID)
+	v1("/update-password", "POST", "/api/v2/users/{id}/password", updatePassword, userID)
+	v1("/view-membership", "GET", "/api/v2/users/{id}/membership", viewMembership, userID)
+	v1("/update-membership", "PUT", "/api/v2/users/{id}/membership", updateMembership, userID)
+	// v1 did not page the rental list, so it reads every page in the largest size
+	router.HandleFunc("/view-rentals", compat.Handler(compat.Route{
+		Successor: "/api/v2/users/{id}/rentals",
+		Rewrites:  []compat.Rewrite{userID, compat.SetQuery("limit", strconv.Itoa(paging.MaxLimit))},
+		Body:      v1Rentals,
+		AllPages:  true,
+	}, viewRentals)).Methods("GET")
+	v1("/licence", "POST", "/api/v2/users/{id}/licences", submitLicence, userID)
+	v1("/licence", "GET", "/api/v2/users/{id}/licences/latest", viewLicence, userID)
+	v1("/export-data", "GET", "/api/v2/users/{id}/export", exportData, userID)
+	v1("/change-email", "POST", "/api/v2/users/{id}/email-change", requestEmailChange, userID)
+	v1("/2fa/enroll", "POST", "/api/v2/users/{id}/two-factor", enrollTwoFactor, userID)
+	v1("/2fa/confirm", "POST", "/api/v2/users/{id}/two-factor/confirmation", confirmTwoFactor, userID)
+	v1("/2fa/disable", "POST", "/api/v2/users/{id}/two-factor", disableTwoFactor, userID)
+	v1("/2fa/recovery-codes", "POST", "/api/v2/users/{id}/two-factor/recovery-codes", regenerateRecoveryCodes, userID)
+	v1("/admin/licences", "GET", "/api/v2/admin/licences", listLicences)
+	v1("/admin/licences/image", "GET", "/api/v2/admin/licences/{id}/image", licenceImage, compat.QueryToPath("licence_id", "id"))
+	v1("/admin/licences/review", "POST", "/api/v2/admin/licences/{id}/review", reviewLicence, compat.BodyToPath("licence_id", "id"))
+	v1("/admin/users/status", "POST", "/api/v2/admin/users/{id}/status", setAccountStatus, compat.BodyToPath("user_id", "id"))
+	v1("/admin/users/status-history", "GET", "/api/v2/admin/users/{id}/status-history", accountStatusHistory, userID)
+	v1("/admin/users/events", "POST", "/api/v2/admin/users/{id}/events", reportAccountEvent, compat.BodyToPath("user_id", "id"))
+
+	// Routes for the vehicle and billing services, protected by the X-Internal-Token header
+	internal := router.PathPrefix("/internal").Subrouter()
+	internal.Use(internalapi.RequireToken(deps.InternalToken))
+	internal.HandleFunc("/users/{id:[0-9]+}/rental-profile", RentalProfile(db)).Methods("GET")
+}
+
+// v1Rentals turns a page of rentals into the v1 body: the rentals without paging, or a message if
+// there are none
+func v1Rentals(body []byte) ([]byte, error) {
+	var page struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, err
+	}
+	if len(page.Data) == 0 {
+		return json.Marshal(map[string]string{"message": "No rentals found for the user"})
+	}
+	return json.Marshal(map[string]interface{}{"rentals": page.Data})
+}
@@ -0,0 +1,454 @@
+package user_handlers
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/idempotency"
+	"electric-car-sharing/services/user-service/loginguard"
+	"electric-car-sharing/services/user-service/mailer"
+	"electric-car-sharing/services/user-service/models"
+	"electric-car-sharing/services/user-service/repository"
+	"electric-car-sharing/services/user-service/tokens"
+	"electric-car-sharing/services/user-service/totp"
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	totpIssuer        = "ElectricCarSharing"
+	recoveryCodeCount = 10
+	loginChallengeTTL = 5 * time.Minute
+)
+
+// parseUserID reads the positive user ID from the {id} path variable, responding with an error if
+// it is missing or invalid. The v1 routes move it there from the user_id query parameter.
+func parseUserID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	userIDStr := mux.Vars(r)["id"]
+	if userIDStr == "" {
+		apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "User ID is required")
+		return 0, false
+	}
+	userID, err := strconv.Atoi(userIDStr)
+	if err != nil || userID <= 0 {
+		apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid User ID")
+		return 0, false
+	}
+	return userID, true
+}
+
+// checkPassword verifies the user's current password, responding with an error if it does not match.
+// Wrong passwords are throttled and lock the account like failed logins, so these checks cannot be
+// used to guess it. A right one does not clear the failures, since a second factor may follow.
+// It returns the user on success.
+func checkPassword(w http.ResponseWriter, r *http.Request, users repository.Users, db *sql.DB, guard *loginguard.Guard,
+	m mailer.Mailer, signer *tokens.Signer, userID int, password string) (models.User, bool) {
+	user, err := users.Get(r.Context(), userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
+		return user, false
+	} else if err != nil {
+		slog.ErrorContext(r.Context(), "Error fetching password", "user_id", userID, "err", err)
+		apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Internal server error")
+		return user, false
+	}
+
+	ip := clientIP(r)
+	if refuseThrottled(w, r, db, guard, userID, user.Email, ip) {
+		return user, false
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)) != nil {
+		failAttempt(r.Context(), db, guard, m, signer, user, ip, "bad_password")
+		apierror.Write(w, http.StatusUnauthorized, apierror.CodeInvalidCredentials, "Invalid credentials")
+		return user, false
+	}
+	return user, true
+}
+
+// twoFactorEnabled reports whether the user has completed two-factor enrolment
+func twoFactorEnabled(db *sql.DB, userID int) (bool, error) {
+	var enabled bool
+	err := db.QueryRow("SELECT enabled FROM user_totp WHERE user_id = ?", userID).Scan(&enabled)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return enabled, err
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
+
+// replaceRecoveryCodes discards the user's recovery codes and returns a fresh set. Only hashes are stored.
+func replaceRecoveryCodes(db *sql.DB, userID int) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	alphabet := base32.StdEncoding.WithPadding(base32.NoPadding)
+	for i := range codes {
+		raw := make([]byte, 7)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, err
+		}
+		encoded := strings.ToLower(alphabet.EncodeToString(raw))[:10]
+		codes[i] = encoded[:5] + "-" + encoded[5:]
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM user_recovery_codes WHERE user_id = ?", userID); err != nil {
+		return nil, err
+	}
+	for _, code := range codes {
+		if _, err := tx.Exec("INSERT INTO user_recovery_codes (user_id, code_hash) VALUES (?, ?)", userID, hashRecoveryCode(code)); err != nil {
+			return nil, err
+		}
+	}
+	return codes, tx.Commit()
+}
+
+// verifySecondFactor accepts either a current TOTP code or an unused recovery code. Each TOTP step
+// and each recovery code can only be used once.
+func verifySecondFactor(db *sql.DB, userID int, code string) (bool, error) {
+	var secret string
+	var lastUsedStep int64
+	err := db.QueryRow("SELECT secret, last_used_step FROM user_totp WHERE user_id = ?", userID).Scan(&secret, &lastUsedStep)
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if step, ok := totp.Validate(secret, code, time.Now()); ok {
+		if step <= lastUsedStep {
+			return false, nil
+		}
+		query := "UPDATE user_totp SET last_used_step = ? WHERE user_id = ? AND last_used_step < ?"
+		res, err := db.Exec(query, step, userID, step)
+		if err != nil {
+			return false, err
+		}
+		rowsAffected, err := res.RowsAffected()
+		return rowsAffected == 1, err
+	}
+
+	query := "UPDATE user_recovery_codes SET used_at = ? WHERE user_id = ? AND code_hash = ? AND used_at IS NULL"
+	res, err := db.Exec(query, time.Now().UTC(), userID, hashRecoveryCode(code))
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := res.RowsAffected()
+	return rowsAffected == 1, err
+}
+
+// checkSecondFactor verifies a TOTP or recovery code for the user, responding with an error if it
+// is refused. Wrong codes are throttled and lock the account like failed logins, and an accepted
+// one clears the failures. failure is the message for an unexpected error.
+func checkSecondFactor(w http.ResponseWriter, r *http.Request, db *sql.DB, guard *loginguard.Guard,
+	m mailer.Mailer, signer *tokens.Signer, user models.User, code, failure string) bool {
+	ip := clientIP(r)
+	if refuseThrottled(w, r, db, guard, user.ID, user.Email, ip) {
+		return false
+	}
+	valid, err := verifySecondFactor(db, user.ID, code)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Error verifying second factor", "user_id", user.ID, "err", err)
+		apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, failure)
+		return false
+	}
+	if !valid {
+		failAttempt(r.Context(), db, guard, m, signer, user, ip, "bad_2fa_code")
+		apierror.Write(w, http.StatusUnauthorized, apierror.CodeInvalidCode, "Invalid code")
+		return false
+	}
+	guard.Succeed(user.Email)
+	return true
+}
+
+// EnrollTwoFactor starts two-factor enrolment and returns the secret and provisioning URI for an
+// authenticator app. Two-factor is not enforced until the first code is confirmed.
+func EnrollTwoFactor(users repository.Users, db *sql.DB, guard *loginguard.Guard, m mailer.Mailer, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		var reqBody struct {
+			Password string `json:"password"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || reqBody.Password == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "password is required")
+			return
+		}
+		user, ok := checkPassword(w, r, users, db, guard, m, signer, userID, reqBody.Password)
+		if !ok {
+			return
+		}
+
+		enabled, err := twoFactorEnabled(db, userID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error checking two-factor status", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to start two-factor enrolment")
+			return
+		}
+		if enabled {
+			apierror.Write(w, http.StatusConflict, apierror.CodeTwoFactorEnabled, "Two-factor authentication is already enabled")
+			return
+		}
+
+		secret, err := totp.GenerateSecret()
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error generating TOTP secret", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to start two-factor enrolment")
+			return
+		}
+
+		// Restarting enrolment replaces any pending secret
+		query := `
+			INSERT INTO user_totp (user_id, secret, enabled, last_used_step) VALUES (?, ?, FALSE, 0)
+			ON DUPLICATE KEY UPDATE secret = VALUES(secret), enabled = FALSE, last_used_step = 0
+		`
+		if _, err := db.Exec(query, userID, secret); err != nil {
+			slog.ErrorContext(r.Context(), "Error storing TOTP secret", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to start two-factor enrolment")
+			return
+		}
+
+		// The secret is not kept for retries
+		idempotency.Withhold(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"message":          "Add this account to your authenticator app, then confirm with a code",
+			"secret":           secret,
+			"provisioning_uri": totp.ProvisioningURI(totpIssuer, user.Email, secret),
+		})
+	}
+}
+
+// ConfirmTwoFactor enables two-factor authentication once the user proves their app generates
+// valid codes, and returns a one-time list of recovery codes. Wrong codes are throttled like
+// failed logins.
+func ConfirmTwoFactor(users repository.Users, db *sql.DB, guard *loginguard.Guard, m mailer.Mailer, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		var reqBody struct {
+			Code string `json:"code"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || reqBody.Code == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "code is required")
+			return
+		}
+
+		user, err := users.Get(r.Context(), userID)
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching user", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to confirm two-factor authentication")
+			return
+		}
+		ip := clientIP(r)
+		if refuseThrottled(w, r, db, guard, userID, user.Email, ip) {
+			return
+		}
+
+		var secret string
+		var enabled bool
+		err = db.QueryRow("SELECT secret, enabled FROM user_totp WHERE user_id = ?", userID).Scan(&secret, &enabled)
+		if err == sql.ErrNoRows {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "Two-factor enrolment has not been started")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching TOTP secret", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to confirm two-factor authentication")
+			return
+		}
+		if enabled {
+			apierror.Write(w, http.StatusConflict, apierror.CodeTwoFactorEnabled, "Two-factor authentication is already enabled")
+			return
+		}
+
+		step, valid := totp.Validate(secret, reqBody.Code, time.Now())
+		if !valid {
+			failAttempt(r.Context(), db, guard, m, signer, user, ip, "bad_2fa_code")
+			apierror.Write(w, http.StatusUnauthorized, apierror.CodeInvalidCode, "Invalid code")
+			return
+		}
+		guard.Succeed(user.Email)
+
+		query := "UPDATE user_totp SET enabled = TRUE, last_used_step = ?, enabled_at = ? WHERE user_id = ?"
+		if _, err := db.Exec(query, step, time.Now().UTC(), userID); err != nil {
+			slog.ErrorContext(r.Context(), "Error enabling two-factor", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to confirm two-factor authentication")
+			return
+		}
+
+		codes, err := replaceRecoveryCodes(db, userID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error generating recovery codes", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to generate recovery codes")
+			return
+		}
+
+		// Recovery codes are only stored hashed, so they are not kept for retries either
+		idempotency.Withhold(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message":        "Two-factor authentication enabled. Store these recovery codes somewhere safe",
+			"recovery_codes": codes,
+		})
+	}
+}
+
+// DisableTwoFactor turns two-factor authentication off. Both the password and a second factor are
+// required, and wrong ones are throttled like failed logins.
+func DisableTwoFactor(users repository.Users, db *sql.DB, guard *loginguard.Guard, m mailer.Mailer, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		var reqBody struct {
+			Password string `json:"password"`
+			Code     string `json:"code"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || reqBody.Password == "" || reqBody.Code == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "password and code are required")
+			return
+		}
+		user, ok := checkPassword(w, r, users, db, guard, m, signer, userID, reqBody.Password)
+		if !ok {
+			return
+		}
+		if !checkSecondFactor(w, r, db, guard, m, signer, user, reqBody.Code, "Failed to disable two-factor authentication") {
+			return
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to begin transaction")
+			return
+		}
+		defer tx.Rollback()
+		if _, err := tx.Exec("DELETE FROM user_recovery_codes WHERE user_id = ?", userID); err != nil {
+			slog.ErrorContext(r.Context(), "Error deleting recovery codes", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to disable two-factor authentication")
+			return
+		}
+		if _, err := tx.Exec("DELETE FROM user_totp WHERE user_id = ?", userID); err != nil {
+			slog.ErrorContext(r.Context(), "Error deleting TOTP secret", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to disable two-factor authentication")
+			return
+		}
+		if err := tx.Commit(); err != nil {
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to commit transaction")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"message": "Two-factor authentication disabled",
+		})
+	}
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes. Like disabling two-factor it needs both the
+// password and a second factor, and wrong ones are throttled like failed logins.
+func RegenerateRecoveryCodes(users repository.Users, db *sql.DB, guard *loginguard.Guard, m mailer.Mailer, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := parseUserID(w, r)
+		if !ok {
+			return
+		}
+
+		var reqBody struct {
+			Password string `json:"password"`
+			Code     string `json:"code"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || reqBody.Password == "" || reqBody.Code == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "password and code are required")
+			return
+		}
+		user, ok := checkPassword(w, r, users, db, guard, m, signer, userID, reqBody.Password)
+		if !ok {
+			return
+		}
+		if !checkSecondFactor(w, r, db, guard, m, signer, user, reqBody.Code, "Failed to regenerate recovery codes") {
+			return
+		}
+
+		codes, err := replaceRecoveryCodes(db, userID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error generating recovery codes", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to regenerate recovery codes")
+			return
+		}
+
+		idempotency.Withhold(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message":        "Recovery codes regenerated. Previous codes no longer work",
+			"recovery_codes": codes,
+		})
+	}
+}
+
+// LoginTwoFactor completes a login for an account with two-factor enabled, using the challenge
+// token returned by Login and either a TOTP code or a recovery code
+func LoginTwoFactor(users repository.Users, db *sql.DB, guard *loginguard.Guard, m mailer.Mailer, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var reqBody struct {
+			ChallengeToken string `json:"challenge_token"`
+			Code           string `json:"code"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid input")
+			return
+		}
+		if reqBody.ChallengeToken == "" || reqBody.Code == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "challenge_token and code are required")
+			return
+		}
+
+		// The challenge is single-use, so a wrong code means starting the login again
+		userID, err := signer.Consume(db, reqBody.ChallengeToken, tokens.PurposeLoginChallenge)
+		if err != nil {
+			tokenError(r.Context(), w, err)
+			return
+		}
+
+		user, err := users.Get(r.Context(), userID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching email", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Error verifying credentials")
+			return
+		}
+		if !checkSecondFactor(w, r, db, guard, m, signer, user, reqBody.Code, "Error verifying credentials") {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]int{"user_id": userID})
+	}
+}
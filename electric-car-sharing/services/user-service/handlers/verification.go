@@ -0,0 +1,233 @@
+package user_handlers
+
+import (
+	"context"
+	"database/sql"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/user-service/loginguard"
+	"electric-car-sharing/services/user-service/mailer"
+	"electric-car-sharing/services/user-service/tokens"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PublicURL is the base URL used to build links in emails sent to users
+var PublicURL = "http://localhost:8080"
+
+const (
+	verifyEmailTTL   = 24 * time.Hour
+	resetPasswordTTL = time.Hour
+)
+
+// sendVerificationEmail issues a verification token and mails the link to the user
+func sendVerificationEmail(db *sql.DB, m mailer.Mailer, signer *tokens.Signer, userID int, name, email string) error {
+	token, err := signer.Issue(db, userID, tokens.PurposeVerifyEmail, verifyEmailTTL)
+	if err != nil {
+		return err
+	}
+	link := fmt.Sprintf("%s/verify-email?token=%s", PublicURL, url.QueryEscape(token))
+	return m.Send(mailer.Message{
+		To:      email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm your email address by opening the link below within 24 hours:\n%s\n\nVerification code: %s",
+			name, link, token),
+	})
+}
+
+// tokenError responds with the message matching a token verification failure
+func tokenError(ctx context.Context, w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, tokens.ErrExpired):
+		apierror.Write(w, http.StatusBadRequest, apierror.CodeTokenExpired, "Token has expired")
+	case errors.Is(err, tokens.ErrUsed):
+		apierror.Write(w, http.StatusBadRequest, apierror.CodeTokenUsed, "Token has already been used")
+	case errors.Is(err, tokens.ErrInvalid):
+		apierror.Write(w, http.StatusBadRequest, apierror.CodeTokenInvalid, "Invalid token")
+	default:
+		slog.ErrorContext(ctx, "Error consuming token", "err", err)
+		apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to verify token")
+	}
+}
+
+// VerifyEmail marks the user's email as verified. The token is read from the query string so the
+// emailed link works, or from a JSON body for clients such as the console.
+func VerifyEmail(db *sql.DB, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("token")
+		if token == "" && r.Body != nil {
+			var reqBody struct {
+				Token string `json:"token"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err == nil {
+				token = reqBody.Token
+			}
+		}
+		if token == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "token is required")
+			return
+		}
+
+		userID, err := signer.Consume(db, token, tokens.PurposeVerifyEmail)
+		if err != nil {
+			tokenError(r.Context(), w, err)
+			return
+		}
+
+		_, err = db.Exec("UPDATE users SET email_verified = TRUE WHERE id = ?", userID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error verifying email", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to verify email")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message": "Email verified successfully",
+			"user_id": userID,
+		})
+	}
+}
+
+// ResendVerification sends a fresh verification link. It responds the same way whether or not
+// the email is registered so it cannot be used to discover accounts.
+func ResendVerification(db *sql.DB, m mailer.Mailer, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var reqBody struct {
+			Email string `json:"email"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || reqBody.Email == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "email is required")
+			return
+		}
+
+		var userID int
+		var name string
+		var verified bool
+		query := "SELECT id, name, email_verified FROM users WHERE email = ?"
+		err := db.QueryRow(query, reqBody.Email).Scan(&userID, &name, &verified)
+		if err != nil && err != sql.ErrNoRows {
+			slog.ErrorContext(r.Context(), "Error looking up user for verification resend", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to resend verification email")
+			return
+		}
+		if err == nil && !verified {
+			if err := sendVerificationEmail(db, m, signer, userID, name, reqBody.Email); err != nil {
+				slog.ErrorContext(r.Context(), "Error sending verification email", "user_id", userID, "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to resend verification email")
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(map[string]string{
+			"message": "If the account exists and is unverified, a verification email has been sent",
+		})
+	}
+}
+
+// RequestPasswordReset emails a password reset link. Like ResendVerification it never reveals
+// whether the email is registered.
+func RequestPasswordReset(db *sql.DB, m mailer.Mailer, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var reqBody struct {
+			Email string `json:"email"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || reqBody.Email == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "email is required")
+			return
+		}
+
+		var userID int
+		var name string
+		err := db.QueryRow("SELECT id, name FROM users WHERE email = ?", reqBody.Email).Scan(&userID, &name)
+		if err != nil && err != sql.ErrNoRows {
+			slog.ErrorContext(r.Context(), "Error looking up user for password reset", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to request password reset")
+			return
+		}
+		if err == nil {
+			token, err := signer.Issue(db, userID, tokens.PurposeResetPassword, resetPasswordTTL)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "Error issuing reset token", "user_id", userID, "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to request password reset")
+				return
+			}
+			err = m.Send(mailer.Message{
+				To:      reqBody.Email,
+				Subject: "Reset your password",
+				Body: fmt.Sprintf("Hi %s,\n\nUse the code below to choose a new password within the next hour. "+
+					"If you did not ask for this, you can ignore this email.\n\nReset code: %s", name, token),
+			})
+			if err != nil {
+				slog.ErrorContext(r.Context(), "Error sending reset email", "user_id", userID, "err", err)
+				apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to request password reset")
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(map[string]string{
+			"message": "If the account exists, a password reset email has been sent",
+		})
+	}
+}
+
+// ResetPassword sets a new password using a reset token instead of the old password. It also
+// lifts any login lockout, since the owner has just proven access to the email.
+func ResetPassword(db *sql.DB, guard *loginguard.Guard, signer *tokens.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var reqBody struct {
+			Token       string `json:"token"`
+			NewPassword string `json:"new_password"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
+			return
+		}
+		if reqBody.Token == "" || reqBody.NewPassword == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "token and new_password are required")
+			return
+		}
+
+		// Hash first so a hashing failure does not burn the token
+		newPasswordHash, err := bcrypt.GenerateFromPassword([]byte(reqBody.NewPassword), bcrypt.DefaultCost)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Error hashing new password", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Internal server error")
+			return
+		}
+
+		userID, err := signer.Consume(db, reqBody.Token, tokens.PurposeResetPassword)
+		if err != nil {
+			tokenError(r.Context(), w, err)
+			return
+		}
+
+		// Receiving the reset email also proves ownership of the address
+		updateQuery := "UPDATE users SET password = ?, email_verified = TRUE WHERE id = ?"
+		if _, err := db.Exec(updateQuery, string(newPasswordHash), userID); err != nil {
+			slog.ErrorContext(r.Context(), "Error resetting password", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to reset password")
+			return
+		}
+
+		var email string
+		if err := db.QueryRow("SELECT email FROM users WHERE id = ?", userID).Scan(&email); err == nil {
+			guard.Unlock(email)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"message": "Password reset successfully",
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package loginguard
+
+import (
+	"context"
+	"electric-car-sharing/services/common/clock"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Policy configures how failed logins are throttled
+type Policy struct {
+	// FreeAttempts is how many failures are allowed before backoff starts
+	FreeAttempts int
+	// BaseBackoff is the wait after the first throttled failure; it doubles on every further failure
+	BaseBackoff time.Duration
+	// MaxBackoff caps the exponential backoff
+	MaxBackoff time.Duration
+	// LockoutThreshold is the number of account failures that locks the account
+	LockoutThreshold int
+	// LockoutDuration is how long a locked account stays locked unless unlocked by email
+	LockoutDuration time.Duration
+	// IPFreeAttempts is how many failures a single IP may make across all accounts before backoff
+	IPFreeAttempts int
+	// ResetAfter forgets failures once no new failure has happened for this long
+	ResetAfter time.Duration
+}
+
+// DefaultPolicy is used by the user service
+var DefaultPolicy = Policy{
+	FreeAttempts:     3,
+	BaseBackoff:      time.Second,
+	MaxBackoff:       5 * time.Minute,
+	LockoutThreshold: 10,
+	LockoutDuration:  30 * time.Minute,
+	IPFreeAttempts:   20,
+	ResetAfter:       time.Hour,
+}
+
+// Decision is the outcome of checking whether a login attempt may proceed
+type Decision struct {
+	Allowed bool
+	// Locked is set when the account itself is locked out
+	Locked bool
+	// RetryAfter is how long the caller must wait before trying again
+	RetryAfter time.Duration
+}
+
+type record struct {
+	failures    int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+// Guard tracks failed logins per account and per IP in memory. Records are forgotten once they
+// have gone quiet for ResetAfter: when their key is next looked up, or by Sweep.
+type Guard struct {
+	policy Policy
+	clock  clock.Clock
+
+	mu       sync.Mutex
+	accounts map[string]*record
+	ips      map[string]*record
+}
+
+// New creates a Guard with the given policy, reading the time from clk so backoff and lockout can
+// be tested without sleeping
+func New(policy Policy, clk clock.Clock) *Guard {
+	return &Guard{
+		policy:   policy,
+		clock:    clk,
+		accounts: make(map[string]*record),
+		ips:      make(map[string]*record),
+	}
+}
+
+// Check reports whether a login attempt for the email from the IP may be evaluated
+func (g *Guard) Check(email, ip string) Decision {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.clock.Now()
+	account := g.current(g.accounts, normalise(email), now)
+	if account != nil && now.Before(account.lockedUntil) {
+		return Decision{Locked: true, RetryAfter: account.lockedUntil.Sub(now)}
+	}
+
+	wait := g.wait(account, g.policy.FreeAttempts, now)
+	if ipWait := g.wait(g.current(g.ips, ip, now), g.policy.IPFreeAttempts, now); ipWait > wait {
+		wait = ipWait
+	}
+	if wait > 0 {
+		return Decision{RetryAfter: wait}
+	}
+	return Decision{Allowed: true}
+}
+
+// Fail records a failed attempt and reports whether it caused the account to be locked
+func (g *Guard) Fail(email, ip string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.clock.Now()
+	g.fail(g.ips, ip, now)
+	account := g.fail(g.accounts, normalise(email), now)
+	if account.failures >= g.policy.LockoutThreshold && !now.Before(account.lockedUntil) {
+		account.lockedUntil = now.Add(g.policy.LockoutDuration)
+		return true
+	}
+	return false
+}
+
+// Succeed clears the account's failure history after a successful login
+func (g *Guard) Succeed(email string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.accounts, normalise(email))
+}
+
+// Unlock clears a lockout, used when the owner follows the unlock link
+func (g *Guard) Unlock(email string) {
+	g.Succeed(email)
+}
+
+// Sweep forgets every account and IP whose record has gone quiet, and returns how many there were
+func (g *Guard) Sweep() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.clock.Now()
+	swept := 0
+	for _, records := range []map[string]*record{g.accounts, g.ips} {
+		for key, rec := range records {
+			if g.stale(rec, now) {
+				delete(records, key)
+				swept++
+			}
+		}
+	}
+	return swept
+}
+
+// Evict returns a background job that runs Sweep every interval until ctx is cancelled, so the
+// records of accounts and IPs that never try again do not stay in memory
+func (g *Guard) Evict(interval time.Duration) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if swept := g.Sweep(); swept > 0 {
+				slog.Debug("Forgot quiet failed login records", "count", swept)
+			}
+		}
+	}
+}
+
+// current returns the record for key, dropping it once it has gone quiet
+func (g *Guard) current(records map[string]*record, key string, now time.Time) *record {
+	rec, ok := records[key]
+	if !ok {
+		return nil
+	}
+	if g.stale(rec, now) {
+		delete(records, key)
+		return nil
+	}
+	return rec
+}
+
+// stale reports whether a record has seen no failure for ResetAfter and no longer locks its account
+func (g *Guard) stale(rec *record, now time.Time) bool {
+	return now.Sub(rec.lastFailure) > g.policy.ResetAfter && !now.Before(rec.lockedUntil)
+}
+
+func (g *Guard) fail(records map[string]*record, key string, now time.Time) *record {
+	rec := g.current(records, key, now)
+	if rec == nil {
+		rec = &record{}
+		records[key] = rec
+	}
+	rec.failures++
+	rec.lastFailure = now
+	return rec
+}
+
+// wait returns how long remains of the exponential backoff for a record
+func (g *Guard) wait(rec *record, freeAttempts int, now time.Time) time.Duration {
+	if rec == nil || rec.failures < freeAttempts {
+		return 0
+	}
+	backoff := g.policy.BaseBackoff
+	for i := freeAttempts; i < rec.failures && backoff < g.policy.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > g.policy.MaxBackoff {
+		backoff = g.policy.MaxBackoff
+	}
+	if remaining := rec.lastFailure.Add(backoff).Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+func normalise(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
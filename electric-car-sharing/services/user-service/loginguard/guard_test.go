@@ -0,0 +1,177 @@
+package loginguard
+
+import (
+	"electric-car-sharing/services/common/clock"
+	"fmt"
+	"testing"
+	"time"
+)
+
+var testPolicy = Policy{
+	FreeAttempts:     3,
+	BaseBackoff:      time.Second,
+	MaxBackoff:       time.Minute,
+	LockoutThreshold: 6,
+	LockoutDuration:  15 * time.Minute,
+	IPFreeAttempts:   5,
+	ResetAfter:       time.Hour,
+}
+
+func newTestGuard() (*Guard, *clock.Fake) {
+	clk := clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	return New(testPolicy, clk), clk
+}
+
+func TestFreeAttemptsAreNotThrottled(t *testing.T) {
+	guard, _ := newTestGuard()
+	for i := 0; i < testPolicy.FreeAttempts-1; i++ {
+		guard.Fail("a@example.com", "10.0.0.1")
+	}
+	if d := guard.Check("a@example.com", "10.0.0.1"); !d.Allowed {
+		t.Fatalf("expected attempt to be allowed, got %+v", d)
+	}
+}
+
+func TestBackoffDoublesAfterFreeAttempts(t *testing.T) {
+	guard, clk := newTestGuard()
+	for i := 0; i < testPolicy.FreeAttempts; i++ {
+		guard.Fail("a@example.com", "10.0.0.1")
+	}
+
+	d := guard.Check("a@example.com", "10.0.0.1")
+	if d.Allowed || d.RetryAfter != time.Second {
+		t.Fatalf("expected 1s backoff, got %+v", d)
+	}
+
+	clk.Advance(time.Second)
+	if d := guard.Check("a@example.com", "10.0.0.1"); !d.Allowed {
+		t.Fatalf("expected attempt to be allowed after backoff, got %+v", d)
+	}
+
+	guard.Fail("a@example.com", "10.0.0.1")
+	if d := guard.Check("a@example.com", "10.0.0.1"); d.RetryAfter != 2*time.Second {
+		t.Fatalf("expected 2s backoff, got %+v", d)
+	}
+}
+
+func TestBackoffIsCapped(t *testing.T) {
+	policy := testPolicy
+	policy.LockoutThreshold = 100
+	guard := New(policy, clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+
+	for i := 0; i < 20; i++ {
+		guard.Fail("a@example.com", fmt.Sprintf("10.0.0.%d", i))
+	}
+	if d := guard.Check("a@example.com", "10.0.1.1"); d.RetryAfter != policy.MaxBackoff {
+		t.Fatalf("expected backoff capped at %s, got %+v", policy.MaxBackoff, d)
+	}
+}
+
+func TestLockoutAfterThreshold(t *testing.T) {
+	guard, clk := newTestGuard()
+	locked := false
+	for i := 0; i < testPolicy.LockoutThreshold; i++ {
+		// Use a fresh IP every time so only the account counter matters
+		locked = guard.Fail("a@example.com", fmt.Sprintf("10.0.0.%d", i))
+	}
+	if !locked {
+		t.Fatal("expected the final failure to lock the account")
+	}
+
+	d := guard.Check("a@example.com", "10.0.1.1")
+	if !d.Locked || d.RetryAfter != testPolicy.LockoutDuration {
+		t.Fatalf("expected account to be locked for %s, got %+v", testPolicy.LockoutDuration, d)
+	}
+
+	// A further failure while locked must not report a new lockout
+	if guard.Fail("a@example.com", "10.0.1.1") {
+		t.Fatal("expected no new lockout while already locked")
+	}
+
+	clk.Advance(testPolicy.LockoutDuration)
+	if d := guard.Check("a@example.com", "10.0.1.1"); d.Locked {
+		t.Fatalf("expected lockout to expire, got %+v", d)
+	}
+}
+
+func TestUnlockClearsLockout(t *testing.T) {
+	guard, _ := newTestGuard()
+	for i := 0; i < testPolicy.LockoutThreshold; i++ {
+		guard.Fail("a@example.com", fmt.Sprintf("10.0.0.%d", i))
+	}
+	guard.Unlock("A@Example.com")
+	if d := guard.Check("a@example.com", "10.0.1.1"); !d.Allowed {
+		t.Fatalf("expected unlocked account to be allowed, got %+v", d)
+	}
+}
+
+func TestIPBackoffAcrossAccounts(t *testing.T) {
+	guard, _ := newTestGuard()
+	for i := 0; i < testPolicy.IPFreeAttempts; i++ {
+		guard.Fail(fmt.Sprintf("user%d@example.com", i), "10.0.0.1")
+	}
+
+	if d := guard.Check("fresh@example.com", "10.0.0.1"); d.Allowed || d.Locked {
+		t.Fatalf("expected IP to be throttled, got %+v", d)
+	}
+	if d := guard.Check("fresh@example.com", "10.0.0.2"); !d.Allowed {
+		t.Fatalf("expected other IPs to be unaffected, got %+v", d)
+	}
+}
+
+func TestSuccessResetsAccountButNotIP(t *testing.T) {
+	guard, _ := newTestGuard()
+	for i := 0; i < testPolicy.IPFreeAttempts; i++ {
+		guard.Fail("a@example.com", "10.0.0.1")
+	}
+	guard.Succeed("a@example.com")
+
+	if d := guard.Check("a@example.com", "10.0.0.2"); !d.Allowed {
+		t.Fatalf("expected account history to be cleared, got %+v", d)
+	}
+	if d := guard.Check("a@example.com", "10.0.0.1"); d.Allowed {
+		t.Fatalf("expected IP history to be kept, got %+v", d)
+	}
+}
+
+func TestFailuresResetAfterQuietPeriod(t *testing.T) {
+	guard, clk := newTestGuard()
+	for i := 0; i < testPolicy.LockoutThreshold-1; i++ {
+		guard.Fail("a@example.com", fmt.Sprintf("10.0.0.%d", i))
+	}
+
+	clk.Advance(testPolicy.ResetAfter + time.Second)
+	if guard.Fail("a@example.com", "10.0.1.1") {
+		t.Fatal("expected old failures to be forgotten")
+	}
+	if d := guard.Check("a@example.com", "10.0.1.2"); !d.Allowed {
+		t.Fatalf("expected a single recent failure to be allowed, got %+v", d)
+	}
+}
+
+func TestSweepForgetsQuietRecords(t *testing.T) {
+	// The lockout outlasts ResetAfter, so the locked account must survive the sweep
+	policy := testPolicy
+	policy.LockoutDuration = 2 * testPolicy.ResetAfter
+	clk := clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	guard := New(policy, clk)
+	for i := 0; i < policy.LockoutThreshold; i++ {
+		guard.Fail("locked@example.com", "10.0.0.1")
+	}
+	guard.Fail("quiet@example.com", "10.0.0.2")
+	if swept := guard.Sweep(); swept != 0 {
+		t.Fatalf("expected recent records to be kept, swept %d", swept)
+	}
+
+	clk.Advance(policy.ResetAfter + time.Second)
+	// quiet@example.com and both IPs
+	if swept := guard.Sweep(); swept != 3 {
+		t.Fatalf("expected 3 quiet records to be swept, swept %d", swept)
+	}
+	if len(guard.accounts) != 1 || len(guard.ips) != 0 {
+		t.Fatalf("expected only the locked account to be kept, got %d accounts and %d IPs", len(guard.accounts), len(guard.ips))
+	}
+	if d := guard.Check("locked@example.com", "10.0.0.3"); !d.Locked {
+		t.Fatalf("expected the account to stay locked, got %+v", d)
+	}
+}
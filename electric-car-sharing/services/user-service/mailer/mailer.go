@@ -0,0 +1,66 @@
+package mailer
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Message is a single outgoing email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers messages to users. Implementations must be safe for concurrent use.
+type Mailer interface {
+	Send(msg Message) error
+}
+
+// LogMailer logs every message instead of sending it. Bodies carry sign-in links and codes, so
+// only the subject is logged, and the recipient is redacted; use a FileMailer to read them.
+type LogMailer struct{}
+
+// Send logs that the message was not sent
+func (LogMailer) Send(msg Message) error {
+	slog.Info("Mail not sent, no outbox configured", "email", msg.To, "subject", msg.Subject)
+	return nil
+}
+
+// FileMailer appends every message to a local outbox file, for development and tests
+type FileMailer struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileMailer creates a FileMailer writing to the given path, creating its directory if needed
+func NewFileMailer(path string) (*FileMailer, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("create outbox directory: %w", err)
+	}
+	return &FileMailer{Path: path}, nil
+}
+
+// Send appends the message to the outbox file
+func (m *FileMailer) Send(msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open outbox: %w", err)
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "Date: %s\nTo: %s\nSubject: %s\n\n%s\n\n----------\n",
+		time.Now().UTC().Format(time.RFC1123Z), msg.To, msg.Subject, msg.Body)
+	if err != nil {
+		return fmt.Errorf("write outbox: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"electric-car-sharing/api"
+	"electric-car-sharing/schema"
+	"electric-car-sharing/services/common/clock"
+	"electric-car-sharing/services/common/config"
+	"electric-car-sharing/services/common/database"
+	"electric-car-sharing/services/common/health"
+	"electric-car-sharing/services/common/httpserver"
+	"electric-car-sharing/services/common/idempotency"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/lifecycle"
+	"electric-car-sharing/services/common/logging"
+	"electric-car-sharing/services/common/metrics"
+	"electric-car-sharing/services/common/openapi"
+	"electric-car-sharing/services/common/tracing"
+	user_handlers "electric-car-sharing/services/user-service/handlers"
+	"electric-car-sharing/services/user-service/loginguard"
+	"electric-car-sharing/services/user-service/mailer"
+	"electric-car-sharing/services/user-service/repository"
+	"electric-car-sharing/services/user-service/tokens"
+)
+
+var db *sql.DB
+var cfg *config.Config
+
+// stopTracing flushes the spans not yet exported
+var stopTracing func() error
+var mail mailer.Mailer
+var signer *tokens.Signer
+var guard = loginguard.New(loginguard.DefaultPolicy, clock.System)
+
+// Clients for the services that own rentals and invoices
+var vehicles *internalapi.Vehicles
+var billing *internalapi.Billing
+
+// Initialize database connection. The user service owns the users, memberships, licence and
+// account security tables.
+func initDB() {
+	var err error
+	db, err = database.Open(cfg.Self().DSN)
+	if err != nil {
+		logging.Fatal("Database connection failed", err)
+	}
+	slog.Info("Database connected")
+}
+
+// Initialize the mailer and token signer used for verification and reset links
+func initMail() {
+	// MAIL_OUTBOX writes emails to a local file; without it they are dropped and only logged
+	if outbox := cfg.Users.MailOutbox; outbox != "" {
+		fileMailer, err := mailer.NewFileMailer(outbox)
+		if err != nil {
+			logging.Fatal("Mailer setup failed", err)
+		}
+		mail = fileMailer
+	} else {
+		mail = mailer.LogMailer{}
+	}
+
+	secret := []byte(cfg.Users.TokenSecret)
+	if len(secret) == 0 {
+		// Without a configured secret, tokens stop working when the process restarts
+		slog.Warn("TOKEN_SECRET not set, using a random secret")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			logging.Fatal("Token secret generation failed", err)
+		}
+	}
+	signer = tokens.NewSigner(secret, clock.System)
+
+	user_handlers.PublicURL = cfg.Users.PublicURL
+	user_handlers.Location = cfg.Rentals.Location
+}
+
+// Initialize operator access and licence storage
+func initAdmin() {
+	user_handlers.AdminToken = cfg.Users.AdminToken
+	if user_handlers.AdminToken == "" {
+		slog.Warn("ADMIN_TOKEN not set, admin routes are disabled")
+	}
+	user_handlers.LicenceUploadDir = cfg.Users.LicenceUploadDir
+}
+
+// Periodically remind users whose driver's licence is about to expire, until ctx is cancelled
+func licenceExpiryNotifier(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		notified, err := user_handlers.NotifyExpiringLicences(db, mail, 30*24*time.Hour)
+		if err != nil {
+			slog.Error("Licence expiry notifier failed", "err", err)
+		} else if notified > 0 {
+			slog.Info("Sent licence expiry notices", "count", notified)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Load and validate the configuration before anything else starts
+func initConfig() {
+	var err error
+	cfg, err = config.Load(config.UserService)
+	if err != nil {
+		logging.Fatal("Invalid configuration", err)
+	}
+	logging.Setup(config.UserService, cfg.Log)
+}
+
+// Trace requests, queries and calls to the other services, exporting spans as configured
+func initTracing() {
+	var err error
+	stopTracing, err = tracing.Setup(config.UserService, cfg.Tracing)
+	if err != nil {
+		logging.Fatal("Tracing setup failed", err)
+	}
+}
+
+func main() {
+	initConfig()
+	initTracing()
+	initDB()
+	initMail()
+	initAdmin()
+
+	internalToken := cfg.InternalToken
+	if internalToken == "" {
+		slog.Warn("INTERNAL_TOKEN not set, internal routes accept any caller")
+	}
+	vehicles = internalapi.NewVehicles(cfg.Services.Vehicle.URL, internalToken)
+	billing = internalapi.NewBilling(cfg.Services.Billing.URL, internalToken)
+
+	store := repository.NewMySQL(db)
+	router := httpserver.NewRouter()
+
+	// Request latency by route, the database pool and business counters are served on /metrics
+	metrics.Install(router, "user", db)
+
+	// The API description is served on /openapi.yaml and requests are checked against it
+	if err := openapi.Install(router, api.User); err != nil {
+		logging.Fatal("Loading the API description failed", err)
+	}
+
+	// Mutating requests retried with the same Idempotency-Key are answered with the first response
+	idempotencyKeys := idempotency.NewMySQL(db, "user_idempotency_keys")
+	idempotency.Install(router, idempotencyKeys, clock.System, idempotency.RequestUser)
+
+	// Probes: /healthz checks this service, /readyz also checks the services it calls
+	checker := health.New("user")
+	checker.AddLocal(health.Database(db))
+	checker.AddLocal(health.SchemaVersion(db, schema.Version))
+	checker.AddDownstream(health.Service("vehicle", cfg.Services.Vehicle.URL))
+	checker.AddDownstream(health.Service("billing", cfg.Services.Billing.URL))
+	router.HandleFunc("/healthz", checker.Healthz()).Methods("GET")
+	router.HandleFunc("/health", checker.Healthz()).Methods("GET")
+	router.HandleFunc("/readyz", checker.Readyz()).Methods("GET")
+
+	user_handlers.Register(router, user_handlers.Dependencies{
+		DB:            db,
+		Users:         store.Users(),
+		Memberships:   store.Memberships(),
+		Mailer:        mail,
+		Signer:        signer,
+		Guard:         guard,
+		Vehicles:      vehicles,
+		Billing:       billing,
+		InternalToken: internalToken,
+	})
+
+	// Stop on SIGINT or SIGTERM, letting in-flight requests finish before the database closes
+	ctx, stop := lifecycle.SignalContext()
+	defer stop()
+	app := lifecycle.New(cfg.ShutdownTimeout)
+	app.OnShutdown("tracing", stopTracing)
+	app.OnShutdown("database", db.Close)
+	app.AddServer("User service", httpserver.NewServer(cfg.Self().ListenAddr, router))
+	app.Go("idempotency key purge", idempotency.PurgeExpired(idempotencyKeys, clock.System))
+	app.Go("licence expiry notifier", licenceExpiryNotifier)
+	app.Go("login guard eviction", guard.Evict(10*time.Minute))
+	if err := app.Run(ctx); err != nil {
+		logging.Fatal("Service stopped with errors", err)
+	}
+	slog.Info("Service stopped")
+}
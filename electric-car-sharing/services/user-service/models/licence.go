@@ -0,0 +1,21 @@
+package models
+
+// Licence statuses
+const (
+	LicencePending  = "pending"
+	LicenceApproved = "approved"
+	LicenceRejected = "rejected"
+)
+
+// DriverLicence is a driver's licence submitted by a user for review
+type DriverLicence struct {
+	ID             int    `json:"id"`
+	UserID         int    `json:"user_id"`
+	LicenceNumber  string `json:"licence_number"`
+	IssuingCountry string `json:"issuing_country"`
+	ExpiryDate     string `json:"expiry_date"`
+	Status         string `json:"status"`
+	ReviewNote     string `json:"review_note,omitempty"`
+	SubmittedAt    string `json:"submitted_at"`
+	ReviewedAt     string `json:"reviewed_at,omitempty"`
+}
@@ -0,0 +1,12 @@
+package models
+
+// BasicMembership is the membership every new user starts on
+const BasicMembership = 1
+
+// Membership is a membership tier and the benefits that come with it
+type Membership struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	HourlyRateDiscount int    `json:"hourly_rate_discount"`
+	VIPAccess          bool   `json:"vip_access"`
+}
@@ -5,4 +5,25 @@ type User struct {
 	Name     string `json:"name"`
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	// MembershipID is never taken from a request; new users start on the basic membership
+	MembershipID int `json:"-"`
+
+	// The account state below is kept by the service and never taken from a request
+	EmailVerified bool `json:"-"`
+	// PendingEmail is the address an email change is waiting to be confirmed for, or empty
+	PendingEmail string `json:"-"`
+	Status       string `json:"-"`
+	StatusReason string `json:"-"`
+}
+
+// Details is a user's profile as stored in user_details. Empty fields are unset.
+type Details struct {
+	Address     string // the address on one line, formatted from the fields below
+	Street      string
+	Unit        string
+	PostalCode  string
+	PhoneNumber string
+	Gender      string
+	// Timezone is the IANA zone rental and invoice times are shown in; empty uses the service default
+	Timezone string
 }
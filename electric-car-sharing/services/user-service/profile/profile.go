@@ -0,0 +1,136 @@
+package profile
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Address is a structured Singapore address
+type Address struct {
+	Street     string `json:"street"`
+	Unit       string `json:"unit,omitempty"`
+	PostalCode string `json:"postal_code"`
+}
+
+// Format renders the address on one line, as stored in user_details.address
+func (a Address) Format() string {
+	parts := []string{a.Street}
+	if a.Unit != "" {
+		parts = append(parts, a.Unit)
+	}
+	parts = append(parts, "Singapore "+a.PostalCode)
+	return strings.Join(parts, ", ")
+}
+
+// FieldErrors maps a field name to the reason its value was rejected
+type FieldErrors map[string]string
+
+var (
+	postalCodePattern = regexp.MustCompile(`^\d{6}$`)
+	unitPattern       = regexp.MustCompile(`^#?(\d{1,3})-(\d{1,5}[A-Z]?)$`)
+	e164Pattern       = regexp.MustCompile(`^\+[1-9]\d{7,14}$`)
+	sgNumberPattern   = regexp.MustCompile(`^[3689]\d{7}$`)
+)
+
+const maxStreetLength = 120
+
+// Genders accepted by user_details.gender
+var Genders = []string{"Male", "Female", "Other"}
+
+// NormalisePhone converts a phone number to E.164. Numbers without a country code are taken to be
+// Singapore numbers, and Singapore numbers must be 8 digits starting with 3, 6, 8 or 9.
+func NormalisePhone(raw string) (string, error) {
+	var b strings.Builder
+	for i, r := range strings.TrimSpace(raw) {
+		switch {
+		case unicode.IsDigit(r):
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.':
+			// Common separators are dropped
+		default:
+			return "", errors.New("phone number may only contain digits, spaces, dashes, brackets and a leading +")
+		}
+	}
+	number := b.String()
+
+	switch {
+	case strings.HasPrefix(number, "00"):
+		number = "+" + number[2:]
+	case strings.HasPrefix(number, "+"):
+	case len(number) == 8:
+		number = "+65" + number
+	case len(number) == 10 && strings.HasPrefix(number, "65"):
+		number = "+" + number
+	default:
+		return "", errors.New("phone number must be an 8-digit Singapore number or include a country code")
+	}
+
+	if !e164Pattern.MatchString(number) {
+		return "", errors.New("phone number is not a valid international number")
+	}
+	if strings.HasPrefix(number, "+65") && !sgNumberPattern.MatchString(number[3:]) {
+		return "", errors.New("Singapore numbers must be 8 digits starting with 3, 6, 8 or 9")
+	}
+	return number, nil
+}
+
+// NormaliseAddress trims and validates an address, formatting the unit as #FF-UUUU. Errors are
+// keyed by the nested field name, e.g. "address.postal_code".
+func NormaliseAddress(a Address) (Address, FieldErrors) {
+	errs := FieldErrors{}
+	a.Street = strings.Join(strings.Fields(a.Street), " ")
+	a.Unit = strings.ToUpper(strings.ReplaceAll(a.Unit, " ", ""))
+	a.PostalCode = strings.TrimSpace(a.PostalCode)
+
+	if a.Street == "" {
+		errs["address.street"] = "street is required"
+	} else if len(a.Street) > maxStreetLength {
+		errs["address.street"] = "street must be at most 120 characters"
+	}
+
+	if a.Unit != "" {
+		match := unitPattern.FindStringSubmatch(a.Unit)
+		if match == nil {
+			errs["address.unit"] = "unit must look like #05-123"
+		} else {
+			floor := match[1]
+			if len(floor) == 1 {
+				floor = "0" + floor
+			}
+			a.Unit = "#" + floor + "-" + match[2]
+		}
+	}
+
+	if !postalCodePattern.MatchString(a.PostalCode) {
+		errs["address.postal_code"] = "postal code must be 6 digits"
+	}
+	return a, errs
+}
+
+// NormaliseGender matches a gender case-insensitively against Genders
+func NormaliseGender(raw string) (string, error) {
+	for _, gender := range Genders {
+		if strings.EqualFold(strings.TrimSpace(raw), gender) {
+			return gender, nil
+		}
+	}
+	return "", errors.New("gender must be Male, Female or Other")
+}
+
+// NormaliseTimezone checks that raw is an IANA time zone name such as Asia/Singapore
+func NormaliseTimezone(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if name == "" || name == "Local" {
+		return "", errors.New("timezone must be an IANA time zone name such as Asia/Singapore")
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return "", errors.New("timezone must be an IANA time zone name such as Asia/Singapore")
+	}
+	return location.String(), nil
+}
@@ -0,0 +1,133 @@
+package profile
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalisePhone(t *testing.T) {
+	for _, tc := range []struct {
+		raw  string
+		want string // empty when the number is refused
+	}{
+		{"9123 4567", "+6591234567"},
+		{"+65 9123-4567", "+6591234567"},
+		{"(65) 6123.4567", "+6561234567"},
+		{"0065 8123 4567", "+6581234567"},
+		{"  31234567 ", "+6531234567"},
+		{"+44 20 7946 0958", "+442079460958"},
+		{"+1 (415) 555-0100", "+14155550100"},
+		{"+123456789012345", "+123456789012345"},
+		{"71234567", ""},          // Singapore numbers start with 3, 6, 8 or 9
+		{"+65 9123 456", ""},      // too short for Singapore
+		{"+65 9123 45678", ""},    // too long for Singapore
+		{"1234567", ""},           // no country code and not 8 digits
+		{"+0123456789", ""},       // country codes do not start with 0
+		{"+1234567", ""},          // E.164 needs at least 8 digits
+		{"+1234567890123456", ""}, // and at most 15
+		{"9123+4567", ""},         // + only leads
+		{"9123 4567 ext 1", ""},
+		{"", ""},
+	} {
+		got, err := NormalisePhone(tc.raw)
+		if tc.want == "" {
+			if err == nil {
+				t.Errorf("%q: got %q, want it refused", tc.raw, got)
+			}
+		} else if err != nil || got != tc.want {
+			t.Errorf("%q: got %q, %v; want %q", tc.raw, got, err, tc.want)
+		}
+	}
+}
+
+func TestNormaliseAddress(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   Address
+		want Address
+		errs FieldErrors
+	}{
+		{"tidied",
+			Address{Street: "  1  Raffles   Place ", Unit: "#10-01", PostalCode: " 048616 "},
+			Address{Street: "1 Raffles Place", Unit: "#10-01", PostalCode: "048616"}, FieldErrors{}},
+		{"unit padded and upper-cased",
+			Address{Street: "10 Anson Road", Unit: "5 - 12a", PostalCode: "079903"},
+			Address{Street: "10 Anson Road", Unit: "#05-12A", PostalCode: "079903"}, FieldErrors{}},
+		{"no unit",
+			Address{Street: "1 Stadium Place", PostalCode: "397628"},
+			Address{Street: "1 Stadium Place", PostalCode: "397628"}, FieldErrors{}},
+		{"five digit postal code",
+			Address{Street: "1 Raffles Place", PostalCode: "48616"},
+			Address{Street: "1 Raffles Place", PostalCode: "48616"},
+			FieldErrors{"address.postal_code": "postal code must be 6 digits"}},
+		{"postal code with letters",
+			Address{Street: "1 Raffles Place", PostalCode: "S04861"},
+			Address{Street: "1 Raffles Place", PostalCode: "S04861"},
+			FieldErrors{"address.postal_code": "postal code must be 6 digits"}},
+		{"everything wrong",
+			Address{Unit: "basement"},
+			Address{Unit: "BASEMENT"},
+			FieldErrors{
+				"address.street":      "street is required",
+				"address.unit":        "unit must look like #05-123",
+				"address.postal_code": "postal code must be 6 digits",
+			}},
+	} {
+		got, errs := NormaliseAddress(tc.in)
+		if got != tc.want || !reflect.DeepEqual(errs, tc.errs) {
+			t.Errorf("%s: got %+v, %v; want %+v, %v", tc.name, got, errs, tc.want, tc.errs)
+		}
+	}
+}
+
+func TestAddressFormat(t *testing.T) {
+	for _, tc := range []struct {
+		in   Address
+		want string
+	}{
+		{Address{Street: "1 Raffles Place", Unit: "#10-01", PostalCode: "048616"}, "1 Raffles Place, #10-01, Singapore 048616"},
+		{Address{Street: "1 Stadium Place", PostalCode: "397628"}, "1 Stadium Place, Singapore 397628"},
+	} {
+		if got := tc.in.Format(); got != tc.want {
+			t.Errorf("got %q, want %q", got, tc.want)
+		}
+	}
+}
+
+func TestNormaliseGender(t *testing.T) {
+	for _, tc := range []struct {
+		raw  string
+		want string
+	}{
+		{"Male", "Male"},
+		{"female", "Female"},
+		{" OTHER ", "Other"},
+		{"", ""},
+		{"unknown", ""},
+	} {
+		got, err := NormaliseGender(tc.raw)
+		if got != tc.want || (err == nil) != (tc.want != "") {
+			t.Errorf("%q: got %q, %v; want %q", tc.raw, got, err, tc.want)
+		}
+	}
+}
+
+func TestNormaliseTimezone(t *testing.T) {
+	for _, tc := range []struct {
+		raw  string
+		want string
+	}{
+		{"Asia/Singapore", "Asia/Singapore"},
+		{" Europe/London ", "Europe/London"},
+		{"UTC", "UTC"},
+		{"Local", ""},
+		{"", ""},
+		{"Asia/Nowhere", ""},
+		{"+08:00", ""},
+	} {
+		got, err := NormaliseTimezone(tc.raw)
+		if got != tc.want || (err == nil) != (tc.want != "") {
+			t.Errorf("%q: got %q, %v; want %q", tc.raw, got, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"electric-car-sharing/services/user-service/accounts"
+	"electric-car-sharing/services/user-service/models"
+)
+
+// Memory keeps users, profiles and memberships in maps. It is safe for concurrent use and is
+// meant for tests and local development.
+type Memory struct {
+	mu          sync.Mutex
+	users       map[int]models.User
+	details     map[int]models.Details
+	memberships map[int]models.Membership
+	nextID      int
+}
+
+// NewMemory creates a store with no users and the same memberships the schema migrations create
+func NewMemory() *Memory {
+	return &Memory{
+		users:   map[int]models.User{},
+		details: map[int]models.Details{},
+		memberships: map[int]models.Membership{
+			1: {ID: 1, Name: "Basic", HourlyRateDiscount: 0, VIPAccess: false},
+			2: {ID: 2, Name: "Premium", HourlyRateDiscount: 10, VIPAccess: false},
+			3: {ID: 3, Name: "VIP", HourlyRateDiscount: 20, VIPAccess: true},
+		},
+		nextID: 1,
+	}
+}
+
+// Users returns the user store
+func (s *Memory) Users() Users {
+	return memoryUsers{s}
+}
+
+// Memberships returns the membership store
+func (s *Memory) Memberships() Memberships {
+	return memoryMemberships{s}
+}
+
+type memoryUsers struct {
+	*Memory
+}
+
+// Create adds the user and an empty profile. Emails are compared without case, as MySQL does.
+func (s memoryUsers) Create(ctx context.Context, user models.User) (models.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.users {
+		if strings.EqualFold(existing.Email, user.Email) {
+			return user, ErrEmailTaken
+		}
+	}
+	user.ID = s.nextID
+	s.nextID++
+	user.MembershipID = models.BasicMembership
+	user.Status = accounts.StatusActive
+	s.users[user.ID] = user
+	s.details[user.ID] = models.Details{}
+	return user, nil
+}
+
+// Get returns the user, or ErrNotFound
+func (s memoryUsers) Get(ctx context.Context, id int) (models.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	user, ok := s.users[id]
+	if !ok {
+		return user, ErrNotFound
+	}
+	return user, nil
+}
+
+// GetByEmail returns the user signing in with email, compared without case, or ErrNotFound
+func (s memoryUsers) GetByEmail(ctx context.Context, email string) (models.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, user := range s.users {
+		if strings.EqualFold(user.Email, email) {
+			return user, nil
+		}
+	}
+	return models.User{}, ErrNotFound
+}
+
+// SetPendingEmail holds email until the user confirms the change
+func (s memoryUsers) SetPendingEmail(ctx context.Context, id int, email string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if user, ok := s.users[id]; ok {
+		user.PendingEmail = email
+		s.users[id] = user
+	}
+	return nil
+}
+
+// ConfirmPendingEmail makes the pending email the verified sign-in address
+func (s memoryUsers) ConfirmPendingEmail(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	user, ok := s.users[id]
+	if !ok || user.PendingEmail == "" {
+		return nil
+	}
+	for _, other := range s.users {
+		if other.ID != id && strings.EqualFold(other.Email, user.PendingEmail) {
+			user.PendingEmail = ""
+			s.users[id] = user
+			return ErrEmailTaken
+		}
+	}
+	user.Email, user.PendingEmail, user.EmailVerified = user.PendingEmail, "", true
+	s.users[id] = user
+	return nil
+}
+
+// SetPassword replaces the user's password hash
+func (s memoryUsers) SetPassword(ctx context.Context, id int, hash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if user, ok := s.users[id]; ok {
+		user.Password = hash
+		s.users[id] = user
+	}
+	return nil
+}
+
+// SetMembership moves the user to another membership
+func (s memoryUsers) SetMembership(ctx context.Context, id, membershipID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	user, ok := s.users[id]
+	if !ok {
+		return ErrNotFound
+	}
+	if _, ok := s.memberships[membershipID]; !ok {
+		return ErrNotFound
+	}
+	user.MembershipID = membershipID
+	s.users[id] = user
+	return nil
+}
+
+// Details returns the user's profile, or ErrNotFound if there is no such user
+func (s memoryUsers) Details(ctx context.Context, id int) (models.Details, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.users[id]; !ok {
+		return models.Details{}, ErrNotFound
+	}
+	return s.details[id], nil
+}
+
+// UpdateDetails applies update to the user's profile under the store's lock
+func (s memoryUsers) UpdateDetails(ctx context.Context, id int, update func(*models.Details)) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.details[id]
+	if !ok {
+		return false, ErrNotFound
+	}
+	details := current
+	update(&details)
+	if details == current {
+		return false, nil
+	}
+	s.details[id] = details
+	return true, nil
+}
+
+type memoryMemberships struct {
+	*Memory
+}
+
+// Get returns the membership, or ErrNotFound
+func (s memoryMemberships) Get(ctx context.Context, id int) (models.Membership, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.memberships[id]
+	if !ok {
+		return m, ErrNotFound
+	}
+	return m, nil
+}
@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"electric-car-sharing/services/user-service/accounts"
+	"electric-car-sharing/services/user-service/models"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQL error numbers for constraint violations
+const (
+	mysqlDuplicateEntry  = 1062
+	mysqlNoReferencedRow = 1452
+)
+
+func isMySQLError(err error, number uint16) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == number
+}
+
+// MySQL stores users in the users and user_details tables and reads the memberships table
+type MySQL struct {
+	db *sql.DB
+}
+
+// NewMySQL creates a store backed by db
+func NewMySQL(db *sql.DB) *MySQL {
+	return &MySQL{db: db}
+}
+
+// Users returns the user store
+func (s *MySQL) Users() Users {
+	return mysqlUsers{db: s.db}
+}
+
+// Memberships returns the membership store
+func (s *MySQL) Memberships() Memberships {
+	return mysqlMemberships{db: s.db}
+}
+
+type mysqlUsers struct {
+	db *sql.DB
+}
+
+// Create inserts the user and their empty profile in one transaction
+func (s mysqlUsers) Create(ctx context.Context, user models.User) (models.User, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return user, err
+	}
+	defer tx.Rollback()
+
+	user.MembershipID = models.BasicMembership
+	user.Status = accounts.StatusActive
+	result, err := tx.ExecContext(ctx, "INSERT INTO users (name, email, password, membership_id) VALUES (?, ?, ?, ?)",
+		user.Name, user.Email, user.Password, user.MembershipID)
+	if isMySQLError(err, mysqlDuplicateEntry) {
+		return user, ErrEmailTaken
+	} else if err != nil {
+		return user, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return user, err
+	}
+	user.ID = int(id)
+
+	if _, err := tx.ExecContext(ctx, "INSERT INTO user_details (id, address, phone_number, gender) VALUES (?, NULL, NULL, NULL)", user.ID); err != nil {
+		return user, err
+	}
+	return user, tx.Commit()
+}
+
+const userColumns = "id, name, email, password, membership_id, email_verified, pending_email, status, status_reason"
+
+// Get returns the user, or ErrNotFound
+func (s mysqlUsers) Get(ctx context.Context, id int) (models.User, error) {
+	return scanUser(s.db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE id = ?", id))
+}
+
+// GetByEmail returns the user signing in with email, or ErrNotFound
+func (s mysqlUsers) GetByEmail(ctx context.Context, email string) (models.User, error) {
+	return scanUser(s.db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE email = ?", email))
+}
+
+func scanUser(row *sql.Row) (models.User, error) {
+	var user models.User
+	var membershipID sql.NullInt64
+	var pendingEmail, statusReason sql.NullString
+	err := row.Scan(&user.ID, &user.Name, &user.Email, &user.Password, &membershipID,
+		&user.EmailVerified, &pendingEmail, &user.Status, &statusReason)
+	if err == sql.ErrNoRows {
+		return user, ErrNotFound
+	}
+	user.MembershipID = int(membershipID.Int64)
+	user.PendingEmail = pendingEmail.String
+	user.StatusReason = statusReason.String
+	return user, err
+}
+
+// SetPendingEmail stores email in users.pending_email
+func (s mysqlUsers) SetPendingEmail(ctx context.Context, id int, email string) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE users SET pending_email = ? WHERE id = ?", nullable(email), id)
+	return err
+}
+
+// ConfirmPendingEmail moves pending_email to email. The UNIQUE index on email refuses an address
+// another account took since the change was requested.
+func (s mysqlUsers) ConfirmPendingEmail(ctx context.Context, id int) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE users SET email = pending_email, pending_email = NULL, email_verified = TRUE WHERE id = ? AND pending_email IS NOT NULL", id)
+	if isMySQLError(err, mysqlDuplicateEntry) {
+		if err := s.SetPendingEmail(ctx, id, ""); err != nil {
+			return err
+		}
+		return ErrEmailTaken
+	}
+	return err
+}
+
+// SetPassword replaces the user's password hash
+func (s mysqlUsers) SetPassword(ctx context.Context, id int, hash string) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE users SET password = ? WHERE id = ?", hash, id)
+	return err
+}
+
+// SetMembership moves the user to another membership
+func (s mysqlUsers) SetMembership(ctx context.Context, id, membershipID int) error {
+	result, err := s.db.ExecContext(ctx, "UPDATE users SET membership_id = ? WHERE id = ?", membershipID, id)
+	if isMySQLError(err, mysqlNoReferencedRow) {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		// Nothing changed: either the user already has this membership or does not exist
+		if _, err := s.Get(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// detailsQuery reads a user's profile, with no row if there is no such user
+const detailsQuery = `
+	SELECT user_details.address, user_details.address_street, user_details.address_unit,
+		user_details.address_postal_code, user_details.phone_number, user_details.gender,
+		user_details.timezone
+	FROM users
+	LEFT JOIN user_details ON users.id = user_details.id
+	WHERE users.id = ?`
+
+// scanDetails reads a row of detailsQuery
+func scanDetails(row *sql.Row) (models.Details, error) {
+	var details models.Details
+	var address, street, unit, postalCode, phoneNumber, gender, timezone sql.NullString
+	err := row.Scan(&address, &street, &unit, &postalCode, &phoneNumber, &gender, &timezone)
+	if err == sql.ErrNoRows {
+		return details, ErrNotFound
+	} else if err != nil {
+		return details, err
+	}
+	details.Address = address.String
+	details.Street = street.String
+	details.Unit = unit.String
+	details.PostalCode = postalCode.String
+	details.PhoneNumber = phoneNumber.String
+	details.Gender = gender.String
+	details.Timezone = timezone.String
+	return details, nil
+}
+
+// Details returns the user's profile, or ErrNotFound if there is no such user
+func (s mysqlUsers) Details(ctx context.Context, id int) (models.Details, error) {
+	return scanDetails(s.db.QueryRowContext(ctx, detailsQuery, id))
+}
+
+// UpdateDetails reads the profile with SELECT ... FOR UPDATE and saves it in the same
+// transaction, storing empty fields as NULL
+func (s mysqlUsers) UpdateDetails(ctx context.Context, id int, update func(*models.Details)) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	current, err := scanDetails(tx.QueryRowContext(ctx, detailsQuery+" FOR UPDATE", id))
+	if err != nil {
+		return false, err
+	}
+	details := current
+	update(&details)
+	if details == current {
+		return false, nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE user_details SET address = ?, address_street = ?, address_unit = ?, address_postal_code = ?,
+			phone_number = ?, gender = ?, timezone = ?
+		WHERE id = ?`,
+		nullable(details.Address), nullable(details.Street), nullable(details.Unit), nullable(details.PostalCode),
+		nullable(details.PhoneNumber), nullable(details.Gender), nullable(details.Timezone), id)
+	if err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// nullable stores an empty string as NULL
+func nullable(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+type mysqlMemberships struct {
+	db *sql.DB
+}
+
+// Get returns the membership, or ErrNotFound
+func (s mysqlMemberships) Get(ctx context.Context, id int) (models.Membership, error) {
+	var m models.Membership
+	err := s.db.QueryRowContext(ctx, "SELECT id, name, hourly_rate_discount, vip_access FROM memberships WHERE id = ?", id).
+		Scan(&m.ID, &m.Name, &m.HourlyRateDiscount, &m.VIPAccess)
+	if err == sql.ErrNoRows {
+		return m, ErrNotFound
+	}
+	return m, err
+}
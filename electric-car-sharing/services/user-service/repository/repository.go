@@ -0,0 +1,53 @@
+// Package repository stores the user service's users, their profiles and the membership tiers.
+// Handlers that depend on these interfaces rather than on *sql.DB run against the MySQL store in
+// production and the in-memory store in tests. Tokens, two-factor secrets, licences and the
+// audit tables are still read and written with SQL directly, as is the anonymisation of a
+// deleted account, which has to happen in one transaction with them.
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"electric-car-sharing/services/user-service/models"
+)
+
+var (
+	// ErrNotFound is returned when the user or membership does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrEmailTaken is returned by Create when another account uses the email address
+	ErrEmailTaken = errors.New("email is already in use")
+)
+
+// Users stores user accounts and their profiles
+type Users interface {
+	// Create adds the user on the basic membership with an empty profile and returns it with its
+	// ID. user.Password must already be hashed.
+	Create(ctx context.Context, user models.User) (models.User, error)
+	// Get returns the user, or ErrNotFound
+	Get(ctx context.Context, id int) (models.User, error)
+	// GetByEmail returns the user signing in with email, or ErrNotFound
+	GetByEmail(ctx context.Context, email string) (models.User, error)
+	// SetPassword replaces the user's password hash
+	SetPassword(ctx context.Context, id int, hash string) error
+	// SetPendingEmail holds email until the user confirms the change; an empty email cancels it
+	SetPendingEmail(ctx context.Context, id int, email string) error
+	// ConfirmPendingEmail makes the pending email the verified sign-in address. If another account
+	// took the address in the meantime the change is cancelled and ErrEmailTaken returned.
+	ConfirmPendingEmail(ctx context.Context, id int) error
+	// SetMembership moves the user to another membership, returning ErrNotFound if either the
+	// user or the membership does not exist
+	SetMembership(ctx context.Context, id, membershipID int) error
+	// Details returns the user's profile, or ErrNotFound if there is no such user
+	Details(ctx context.Context, id int) (models.Details, error)
+	// UpdateDetails applies update to the user's profile and saves it, reporting whether anything
+	// changed. The profile is locked from being read until it is saved, so concurrent updates of
+	// different fields do not undo each other. It returns ErrNotFound if there is no such user.
+	UpdateDetails(ctx context.Context, id int, update func(*models.Details)) (bool, error)
+}
+
+// Memberships reads the membership tiers
+type Memberships interface {
+	// Get returns the membership, or ErrNotFound
+	Get(ctx context.Context, id int) (models.Membership, error)
+}
@@ -0,0 +1,136 @@
+package tokens
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"electric-car-sharing/services/common/clock"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Purpose restricts what a token can be used for
+type Purpose string
+
+const (
+	PurposeVerifyEmail   Purpose = "verify_email"
+	PurposeResetPassword Purpose = "reset_password"
+	PurposeUnlockAccount Purpose = "unlock_account"
+	// PurposeLoginChallenge links the password step of a two-factor login to the code step
+	PurposeLoginChallenge Purpose = "login_challenge"
+	// PurposeChangeEmail confirms the address held in users.pending_email
+	PurposeChangeEmail Purpose = "change_email"
+)
+
+var (
+	// ErrInvalid is returned for tokens that are malformed, tampered with or issued for another purpose
+	ErrInvalid = errors.New("invalid token")
+	// ErrExpired is returned for tokens past their expiry time
+	ErrExpired = errors.New("token expired")
+	// ErrUsed is returned for tokens that were already consumed or revoked
+	ErrUsed = errors.New("token already used")
+)
+
+// Signer issues and verifies HMAC-signed, single-use tokens backed by the user_tokens table.
+// A token has the form base64(id.user_id.purpose.expiry).base64(signature).
+type Signer struct {
+	secret []byte
+	clock  clock.Clock
+}
+
+// NewSigner creates a Signer using the given HMAC secret, reading expiry and use times from clk
+func NewSigner(secret []byte, clk clock.Clock) *Signer {
+	return &Signer{secret: secret, clock: clk}
+}
+
+// Issue creates a new token for the user and revokes any outstanding tokens with the same purpose
+func (s *Signer) Issue(db *sql.DB, userID int, purpose Purpose, ttl time.Duration) (string, error) {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generate token id: %w", err)
+	}
+	id := base64.RawURLEncoding.EncodeToString(raw)
+	now := s.clock.Now().UTC()
+	expiresAt := now.Add(ttl)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	revokeQuery := "UPDATE user_tokens SET used_at = ? WHERE user_id = ? AND purpose = ? AND used_at IS NULL"
+	if _, err := tx.Exec(revokeQuery, now, userID, string(purpose)); err != nil {
+		return "", fmt.Errorf("revoke previous tokens: %w", err)
+	}
+
+	insertQuery := "INSERT INTO user_tokens (id, user_id, purpose, expires_at) VALUES (?, ?, ?, ?)"
+	if _, err := tx.Exec(insertQuery, id, userID, string(purpose), expiresAt); err != nil {
+		return "", fmt.Errorf("store token: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	payload := strings.Join([]string{id, strconv.Itoa(userID), string(purpose), strconv.FormatInt(expiresAt.Unix(), 10)}, ".")
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + s.sign(payload), nil
+}
+
+// Consume verifies the token and marks it as used, returning the user it was issued to
+func (s *Signer) Consume(db *sql.DB, token string, purpose Purpose) (int, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return 0, ErrInvalid
+	}
+	rawPayload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, ErrInvalid
+	}
+	payload := string(rawPayload)
+	if !hmac.Equal([]byte(signature), []byte(s.sign(payload))) {
+		return 0, ErrInvalid
+	}
+
+	parts := strings.Split(payload, ".")
+	if len(parts) != 4 || Purpose(parts[2]) != purpose {
+		return 0, ErrInvalid
+	}
+	userID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, ErrInvalid
+	}
+	expiry, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return 0, ErrInvalid
+	}
+	now := s.clock.Now().UTC()
+	if now.Unix() > expiry {
+		return 0, ErrExpired
+	}
+
+	// Only the first caller to flip used_at gets to use the token
+	query := "UPDATE user_tokens SET used_at = ? WHERE id = ? AND user_id = ? AND purpose = ? AND used_at IS NULL"
+	res, err := db.Exec(query, now, parts[0], userID, string(purpose))
+	if err != nil {
+		return 0, fmt.Errorf("consume token: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("consume token: %w", err)
+	}
+	if rowsAffected == 0 {
+		return 0, ErrUsed
+	}
+	return userID, nil
+}
+
+func (s *Signer) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,149 @@
+package tokens
+
+import (
+	"database/sql"
+	"electric-car-sharing/services/common/clock"
+	"electric-car-sharing/services/common/database"
+	"encoding/base64"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/server"
+	gmssql "github.com/dolthub/go-mysql-server/sql"
+)
+
+const userID = 7
+
+// openDB starts an in-process MySQL server holding just the user_tokens table and connects to it
+func openDB(t *testing.T) *sql.DB {
+	t.Helper()
+	provider := memory.NewDBProvider(memory.NewDatabase("tokens"))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv, err := server.NewServer(server.Config{Protocol: "tcp", Listener: listener}, sqle.NewDefault(provider),
+		gmssql.NewContext, memory.NewSessionBuilder(provider), nil)
+	if err != nil {
+		t.Fatalf("start database: %v", err)
+	}
+	go srv.Start()
+	t.Cleanup(func() { srv.Close() })
+
+	db, err := database.Open("root:@tcp(" + listener.Addr().String() + ")/tokens")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	_, err = db.Exec(`CREATE TABLE user_tokens (
+		id VARCHAR(32) PRIMARY KEY,
+		user_id INT NOT NULL,
+		purpose VARCHAR(32) NOT NULL,
+		expires_at DATETIME NOT NULL,
+		used_at DATETIME DEFAULT NULL
+	)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// harness issues and consumes tokens for userID
+type harness struct {
+	t      *testing.T
+	db     *sql.DB
+	clock  *clock.Fake
+	signer *Signer
+}
+
+func newHarness(t *testing.T) *harness {
+	clk := clock.NewFake(time.Date(2030, time.March, 4, 9, 0, 0, 0, time.UTC))
+	return &harness{t: t, db: openDB(t), clock: clk, signer: NewSigner([]byte("test secret"), clk)}
+}
+
+func (h *harness) issue(purpose Purpose, ttl time.Duration) string {
+	h.t.Helper()
+	token, err := h.signer.Issue(h.db, userID, purpose, ttl)
+	if err != nil {
+		h.t.Fatalf("issue %s: %v", purpose, err)
+	}
+	return token
+}
+
+func (h *harness) consume(what, token string, purpose Purpose, want error) {
+	h.t.Helper()
+	got, err := h.signer.Consume(h.db, token, purpose)
+	if !errors.Is(err, want) || (want == nil && got != userID) {
+		h.t.Fatalf("%s: got user %d, %v; want %v", what, got, err, want)
+	}
+}
+
+func TestSingleUse(t *testing.T) {
+	h := newHarness(t)
+
+	// A token works once, and only for its purpose
+	token := h.issue(PurposeResetPassword, time.Hour)
+	h.consume("wrong purpose", token, PurposeVerifyEmail, ErrInvalid)
+	h.consume("first use", token, PurposeResetPassword, nil)
+	h.consume("second use", token, PurposeResetPassword, ErrUsed)
+
+	// Issuing a token revokes the one issued before it for the same purpose, but not for others
+	first := h.issue(PurposeVerifyEmail, time.Hour)
+	other := h.issue(PurposeChangeEmail, time.Hour)
+	h.issue(PurposeVerifyEmail, time.Hour)
+	h.consume("revoked", first, PurposeVerifyEmail, ErrUsed)
+	h.consume("other purpose", other, PurposeChangeEmail, nil)
+}
+
+func TestExpiry(t *testing.T) {
+	h := newHarness(t)
+	token := h.issue(PurposeUnlockAccount, time.Hour)
+	late := h.issue(PurposeChangeEmail, time.Hour)
+
+	var expiresAt string
+	if err := h.db.QueryRow("SELECT expires_at FROM user_tokens WHERE purpose = ?", PurposeUnlockAccount).Scan(&expiresAt); err != nil {
+		t.Fatal(err)
+	}
+	if expiresAt != "2030-03-04 10:00:00" {
+		t.Errorf("expires_at %s, want an hour after the clock", expiresAt)
+	}
+
+	// The token is good up to its expiry time and no later
+	h.clock.Advance(time.Hour)
+	h.consume("at expiry", token, PurposeUnlockAccount, nil)
+	h.clock.Advance(time.Second)
+	h.consume("after expiry", late, PurposeChangeEmail, ErrExpired)
+
+	var usedAt string
+	if err := h.db.QueryRow("SELECT used_at FROM user_tokens WHERE purpose = ?", PurposeUnlockAccount).Scan(&usedAt); err != nil {
+		t.Fatal(err)
+	}
+	if usedAt != "2030-03-04 10:00:00" {
+		t.Errorf("used_at %s, want the clock's time", usedAt)
+	}
+}
+
+func TestTampering(t *testing.T) {
+	h := newHarness(t)
+	token := h.issue(PurposeChangeEmail, time.Hour)
+
+	// Changing the payload, the signature or the secret invalidates the token
+	encoded, signature, _ := strings.Cut(token, ".")
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherUser := strings.Replace(string(payload), ".7.", ".8.", 1)
+	h.consume("other user", base64.RawURLEncoding.EncodeToString([]byte(otherUser))+"."+signature, PurposeChangeEmail, ErrInvalid)
+	h.consume("bad signature", encoded+"."+strings.Repeat("A", len(signature)), PurposeChangeEmail, ErrInvalid)
+	h.consume("malformed", "not-a-token", PurposeChangeEmail, ErrInvalid)
+	if _, err := NewSigner([]byte("another secret"), h.clock).Consume(h.db, token, PurposeChangeEmail); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("token signed with another secret: got %v, want %v", err, ErrInvalid)
+	}
+	h.consume("untouched", token, PurposeChangeEmail, nil)
+}
@@ -0,0 +1,69 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 seed of the RFC 6238 appendix B test vectors
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeMatchesRFC6238(t *testing.T) {
+	// The RFC lists eight digit codes; these are their last Digits digits
+	for _, tc := range []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	} {
+		code, err := Code(rfcSecret, Step(time.Unix(tc.unix, 0)))
+		if err != nil {
+			t.Fatalf("code at %d: %v", tc.unix, err)
+		}
+		if code != tc.want {
+			t.Errorf("code at %d: got %s, want %s", tc.unix, code, tc.want)
+		}
+	}
+}
+
+func TestValidateAllowsOneStepOfDrift(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := Step(now)
+	for _, tc := range []struct {
+		step int64
+		ok   bool
+	}{
+		{current - 2, false},
+		{current - 1, true},
+		{current, true},
+		{current + 1, true},
+		{current + 2, false},
+	} {
+		code, err := Code(rfcSecret, tc.step)
+		if err != nil {
+			t.Fatal(err)
+		}
+		step, ok := Validate(rfcSecret, code, now)
+		if ok != tc.ok || (ok && step != tc.step) {
+			t.Errorf("code of step %+d: got step %d, %v; want %v", tc.step-current, step-current, ok, tc.ok)
+		}
+	}
+}
+
+func TestValidateRefusesMalformedCodes(t *testing.T) {
+	now := time.Unix(59, 0)
+	for _, code := range []string{"", "28708", "2870820", "94287082"} {
+		if _, ok := Validate(rfcSecret, code, now); ok {
+			t.Errorf("code %q was accepted", code)
+		}
+	}
+	if _, ok := Validate(rfcSecret, " 287082 ", now); !ok {
+		t.Error("code with surrounding spaces was refused")
+	}
+}
@@ -1,592 +1,447 @@
 package handlers
 
 import (
-	"database/sql"
+	"context"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/clock"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/paging"
 	"electric-car-sharing/services/vehicle-service/models"
+	"electric-car-sharing/services/vehicle-service/repository"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strconv"
 	"time"
 )
 
-// FetchAvailableVehicles fetches all available vehicles for a given user
-func FetchAvailableVehicles(db *sql.DB) http.HandlerFunc {
+// Location is the time zone rental times are compared in, and shown in for users who have not
+// chosen their own
+var Location = time.UTC
+
+// CancellationWindow is how long after the start a rental can still be cancelled
+var CancellationWindow = time.Hour
+
+// formatTime renders a time for a response, in the given zone with its UTC offset
+func formatTime(t time.Time, location *time.Location) string {
+	return t.In(location).Format(time.RFC3339)
+}
+
+// userLocation returns the zone the user wants times shown in. If the user service cannot be
+// reached the default Location is used rather than failing the request.
+func userLocation(ctx context.Context, users *internalapi.Users, userID int) *time.Location {
+	profile, err := users.RentalProfile(ctx, userID)
+	if err != nil {
+		slog.WarnContext(ctx, "Error fetching time zone, using the default", "user_id", userID, "err", err)
+		return Location
+	}
+	return profile.Location(Location)
+}
+
+// describeWindow renders the cancellation window for messages, e.g. "1 hour" or "30 minutes"
+func describeWindow(window time.Duration) string {
+	if window%time.Hour == 0 {
+		if window == time.Hour {
+			return "1 hour"
+		}
+		return fmt.Sprintf("%d hours", window/time.Hour)
+	}
+	if window == time.Minute {
+		return "1 minute"
+	}
+	return fmt.Sprintf("%d minutes", window/time.Minute)
+}
+
+// FetchAvailableVehicles lists a page of the available vehicles a given user may rent, filtered
+// and sorted by the query parameters
+func FetchAvailableVehicles(vehicles repository.Vehicles, users *internalapi.Users) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Parse user_id from query params
-		userID := r.URL.Query().Get("user_id")
-		if userID == "" {
-			http.Error(w, "User ID is required", http.StatusBadRequest)
+		userIDParam := r.URL.Query().Get("user_id")
+		if userIDParam == "" {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "User ID is required")
+			return
+		}
+		userID, err := strconv.Atoi(userIDParam)
+		if err != nil || userID <= 0 {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "User ID must be a positive integer")
 			return
 		}
 
-		// Check user's membership level
-		var vipAccess bool
-		query := "SELECT m.vip_access FROM memberships m JOIN users u ON u.membership_id = m.id WHERE u.id = ?"
-		err := db.QueryRow(query, userID).Scan(&vipAccess)
-		if err == sql.ErrNoRows {
-			http.Error(w, "User not found or membership not set", http.StatusNotFound)
+		// Check user's membership level with the user service
+		profile, err := users.RentalProfile(r.Context(), userID)
+		if internalapi.IsNotFound(err) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found or membership not set")
 			return
 		} else if err != nil {
-			http.Error(w, "Failed to fetch membership details", http.StatusInternalServerError)
+			internalapi.WriteUnavailable(w, r, "user", err)
 			return
 		}
 
-		// Fetch vehicles based on user's access level
-		var vehicleQuery string
-		if vipAccess {
-			vehicleQuery = "SELECT id, make, model, year, available, vip_access, cost_per_hour FROM vehicles WHERE available = TRUE"
-		} else {
-			vehicleQuery = "SELECT id, make, model, year, available, vip_access, cost_per_hour FROM vehicles WHERE available = TRUE AND vip_access = FALSE"
-		}
-
-		rows, err := db.Query(vehicleQuery)
+		// Fetch the page of vehicles the user may see
+		page, err := paging.Parse(r.URL.Query(), repository.VehicleSorts, paging.Sort{Field: "id"})
 		if err != nil {
-			http.Error(w, "Failed to fetch vehicles", http.StatusInternalServerError)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
 			return
 		}
-		defer rows.Close()
-
-		var vehicles []models.Vehicle
-		for rows.Next() {
-			var v models.Vehicle
-			if err := rows.Scan(&v.ID, &v.Make, &v.Model, &v.Year, &v.Available, &v.VIPAccess, &v.CostPerHour); err != nil {
-				http.Error(w, "Failed to parse vehicles", http.StatusInternalServerError)
-				return
-			}
-			vehicles = append(vehicles, v)
+		filters := paging.NewFilters(r.URL.Query())
+		filter := repository.VehicleFilter{
+			IncludeVIP: profile.Membership.VIPAccess,
+			VIP:        filters.Bool("vip"),
+			Make:       filters.String("make"),
+			MinYear:    filters.Int("min_year"),
+			MaxYear:    filters.Int("max_year"),
+			MinCost:    filters.Float("min_cost"),
+			MaxCost:    filters.Float("max_cost"),
+		}
+		if err := filters.Err(); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+			return
+		}
+		available, next, err := vehicles.ListAvailable(r.Context(), filter, page)
+		if errors.Is(err, paging.ErrInvalidCursor) {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Query parameter 'cursor' is invalid")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching available vehicles", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch vehicles")
+			return
 		}
 
-		// Respond with available vehicles
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(vehicles)
+		// Respond with the page of vehicles
+		if available == nil {
+			available = []models.Vehicle{}
+		}
+		paging.Write(w, available, page.Page(next))
 	}
 }
 
-// CreateRental creates a new rental and sets the vehicle to unavailable
-func CreateRental(db *sql.DB) http.HandlerFunc {
+// errVIPRequired refuses a VIP-only vehicle to a member without VIP access
+var errVIPRequired = errors.New("vehicle requires VIP access")
+
+// CreateRental creates a new rental and sets the vehicle to unavailable. The user's account
+// status, licence and membership come from the user service.
+func CreateRental(rentals repository.Rentals, users *internalapi.Users, clk clock.Clock) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Define the request body structure
 		type CreateRentalRequest struct {
+			UserID    int `json:"user_id"`
 			VehicleID int `json:"vehicle_id"`
 			Hours     int `json:"hours"`
 		}
 
-		// Parse the query parameter for user ID
-		userIDParam := r.URL.Query().Get("user_id")
-		if userIDParam == "" {
-			http.Error(w, "Query parameter 'user_id' is required", http.StatusBadRequest)
-			return
-		}
-
-		// Convert user ID to an integer
-		userID, err := strconv.Atoi(userIDParam)
-		if err != nil || userID <= 0 {
-			http.Error(w, "Query parameter 'user_id' must be a positive integer", http.StatusBadRequest)
-			return
-		}
-
 		// Parse the request body
 		var reqBody CreateRentalRequest
 		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid request body")
 			return
 		}
 
 		// Validate the input
+		if reqBody.UserID <= 0 {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "User ID must be a positive integer")
+			return
+		}
 		if reqBody.VehicleID <= 0 || reqBody.Hours <= 0 {
-			http.Error(w, "Vehicle ID and Hours must be positive integers", http.StatusBadRequest)
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Vehicle ID and Hours must be positive integers")
 			return
 		}
+		userID := reqBody.UserID
 
-		// Start a transaction
-		tx, err := db.Begin()
-		if err != nil {
-			http.Error(w, "Failed to begin transaction", http.StatusInternalServerError)
+		profile, err := users.RentalProfile(r.Context(), userID)
+		if internalapi.IsNotFound(err) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "User not found")
+			return
+		} else if err != nil {
+			internalapi.WriteUnavailable(w, r, "user", err)
 			return
 		}
 
-		// Check if the user already has an ongoing rental
-		var activeRentalExists bool
-		activeRentalQuery := "SELECT EXISTS (SELECT 1 FROM rentals WHERE user_id = ? AND status = 'active')"
-		err = tx.QueryRow(activeRentalQuery, userID).Scan(&activeRentalExists)
-		if err != nil {
-			http.Error(w, "Failed to check for existing rentals", http.StatusInternalServerError)
-			tx.Rollback()
+		// Suspended, banned and closed accounts cannot rent
+		if profile.Status != internalapi.StatusActive {
+			apierror.Write(w, http.StatusForbidden, apierror.CodeAccountInactive, "Account is "+profile.Status+" and cannot rent vehicles")
+			return
+		}
+
+		// Only users with an approved licence that stays valid for the whole rental may rent
+		now := clk.Now()
+		endTime := now.Add(time.Duration(reqBody.Hours) * time.Hour)
+		if profile.LicenceExpiry == "" || profile.LicenceExpiry < endTime.Format("2006-01-02") {
+			apierror.Write(w, http.StatusForbidden, apierror.CodeLicenceRequired, "A valid, approved driver's licence is required to rent a vehicle")
 			return
 		}
 
-		if activeRentalExists {
-			http.Error(w, "User already has an ongoing rental", http.StatusConflict)
-			tx.Rollback()
+		// The store checks for an ongoing rental and the vehicle's availability in the same step
+		// that takes the vehicle, and asks us whether the user may have it
+		rental, err := rentals.Start(r.Context(), models.Rental{
+			UserID:    userID,
+			VehicleID: reqBody.VehicleID,
+			StartDate: now,
+			EndDate:   endTime,
+		}, func(vehicle models.Vehicle) error {
+			if vehicle.VIPAccess && !profile.Membership.VIPAccess {
+				return errVIPRequired
+			}
+			return nil
+		})
+		switch {
+		case errors.Is(err, repository.ErrRentalInProgress):
+			apierror.Write(w, http.StatusConflict, apierror.CodeRentalInProgress, "User already has an ongoing rental")
+			return
+		case errors.Is(err, repository.ErrNotFound):
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "Vehicle not found")
+			return
+		case errors.Is(err, repository.ErrVehicleUnavailable):
+			apierror.Write(w, http.StatusConflict, apierror.CodeVehicleUnavailable, "Vehicle is not available")
+			return
+		case errors.Is(err, errVIPRequired):
+			apierror.Write(w, http.StatusForbidden, apierror.CodeVIPRequired, "Vehicle requires VIP access, but user is not a VIP")
+			return
+		case err != nil:
+			slog.ErrorContext(r.Context(), "Error creating rental", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to create rental")
 			return
 		}
+		rentalEvents.WithLabelValues(eventCreated).Inc()
+
+		// Respond with the new rental
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Location", "/api/v2/rentals/"+strconv.Itoa(rental.ID))
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message":        "Rental created successfully",
+			"id":             rental.ID,
+			"user_id":        rental.UserID,
+			"vehicle_id":     rental.VehicleID,
+			"start_date":     formatTime(rental.StartDate, profile.Location(Location)),
+			"end_date":       formatTime(rental.EndDate, profile.Location(Location)),
+			"status":         rental.Status,
+			"overtime_hours": rental.OvertimeHours,
+		})
+	}
+}
+
+// activeRental loads the rental named by the {id} path variable, responding with an error if it
+// does not exist or is no longer active
+func activeRental(w http.ResponseWriter, r *http.Request, rentals repository.Rentals) (models.Rental, bool) {
+	rentalID, ok := pathID(w, r)
+	if !ok {
+		return models.Rental{}, false
+	}
+	rental, err := rentals.Rental(r.Context(), rentalID)
+	if errors.Is(err, repository.ErrNotFound) {
+		apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "Rental not found")
+		return rental, false
+	} else if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to fetch rental", "rental_id", rentalID, "err", err)
+		apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch rental")
+		return rental, false
+	}
+	if rental.Status != models.RentalActive {
+		apierror.Write(w, http.StatusConflict, apierror.CodeNoActiveRental, "Rental is not active")
+		return rental, false
+	}
+	return rental, true
+}
 
+// ViewRental shows a rental, with times in its user's time zone
+func ViewRental(rentals repository.Rentals, users *internalapi.Users) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rentalID, ok := pathID(w, r)
+		if !ok {
+			return
+		}
 
-		// Check if the vehicle is available and if it requires VIP access
-		var available bool
-		var vipOnly bool
-		query := "SELECT available, vip_access FROM vehicles WHERE id = ?"
-		err = tx.QueryRow(query, reqBody.VehicleID).Scan(&available, &vipOnly)
-		if err == sql.ErrNoRows {
-			http.Error(w, "Vehicle not found", http.StatusNotFound)
-			tx.Rollback()
+		rental, err := rentals.Rental(r.Context(), rentalID)
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "Rental not found")
 			return
 		} else if err != nil {
-			http.Error(w, "Failed to fetch vehicle details", http.StatusInternalServerError)
-			tx.Rollback()
-			return
-		}
-
-		if !available {
-			http.Error(w, "Vehicle is not available", http.StatusConflict)
-			tx.Rollback()
-			return
-		}
-
-		// If the vehicle requires VIP access, verify user's membership
-		if vipOnly {
-			var vipAccess bool
-			vipQuery := `
-				SELECT m.vip_access 
-				FROM memberships m 
-				JOIN users u ON u.membership_id = m.id 
-				WHERE u.id = ?`
-			err := tx.QueryRow(vipQuery, userID).Scan(&vipAccess)
-			if err == sql.ErrNoRows {
-				http.Error(w, "User not found or membership not set", http.StatusNotFound)
-				tx.Rollback()
-				return
-			} else if err != nil {
-				http.Error(w, "Failed to fetch membership details", http.StatusInternalServerError)
-				tx.Rollback()
-				return
-			}
-
-			if !vipAccess {
-				http.Error(w, "Vehicle requires VIP access, but user is not a VIP", http.StatusForbidden)
-				tx.Rollback()
-				return
-			}
+			slog.ErrorContext(r.Context(), "Failed to fetch rental", "rental_id", rentalID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch rental")
+			return
 		}
 
-		// Create the rental
-		now := time.Now()
-		endTime := now.Add(time.Duration(reqBody.Hours) * time.Hour)
-		createRentalQuery := `
-			INSERT INTO rentals (user_id, vehicle_id, start_date, end_date, status, overtime_hours)
-			VALUES (?, ?, ?, ?, 'active', 0)
-			`
-		_, err = tx.Exec(createRentalQuery, userID, reqBody.VehicleID, now, endTime)
-		if err != nil {
-			http.Error(w, "Failed to create rental", http.StatusInternalServerError)
-			tx.Rollback()
+		location := userLocation(r.Context(), users, rental.UserID)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":             rental.ID,
+			"user_id":        rental.UserID,
+			"vehicle_id":     rental.VehicleID,
+			"start_date":     formatTime(rental.StartDate, location),
+			"end_date":       formatTime(rental.EndDate, location),
+			"status":         rental.Status,
+			"overtime_hours": rental.OvertimeHours,
+		})
+	}
+}
+
+// CancelRental cancels the active rental if it started within the cancellation window
+func CancelRental(rentals repository.Rentals, clk clock.Clock) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rental, ok := activeRental(w, r, rentals)
+		if !ok {
 			return
 		}
 
-		// Set the vehicle to unavailable
-		updateVehicleQuery := "UPDATE vehicles SET available = FALSE WHERE id = ?"
-		_, err = tx.Exec(updateVehicleQuery, reqBody.VehicleID)
-		if err != nil {
-			http.Error(w, "Failed to update vehicle availability", http.StatusInternalServerError)
-			tx.Rollback()
+		// Compare the start with the current time in the rental time zone
+		startDate := rental.StartDate.In(Location)
+		currentTime := clk.Now().In(Location)
+		if currentTime.Sub(startDate) > CancellationWindow {
+			message := fmt.Sprintf("Cancellation is only allowed within %s of the rental start time", describeWindow(CancellationWindow))
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeCancellationExpired, message)
 			return
 		}
 
-		// Commit the transaction
-		if err := tx.Commit(); err != nil {
-			http.Error(w, "Failed to commit transaction", http.StatusInternalServerError)
+		// Cancel the rental and free the vehicle
+		err := rentals.Cancel(r.Context(), rental.ID)
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Write(w, http.StatusConflict, apierror.CodeNoActiveRental, "Rental is not active")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Failed to cancel rental", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to cancel rental")
 			return
 		}
+		rentalEvents.WithLabelValues(eventCancelled).Inc()
 
 		// Respond with success
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(map[string]interface{}{
-			"message":       "Rental created successfully",
-			"user_id":       userID,
-			"vehicle_id":    reqBody.VehicleID,
-			"start_date":    now.Format(time.RFC3339),
-			"end_date":      endTime.Format(time.RFC3339),
-			"status":        "active",
-			"overtime_hours": 0,
+			"message":    "Rental cancelled successfully",
+			"rental_id":  rental.ID,
+			"vehicle_id": rental.VehicleID,
 		})
-		
 	}
 }
-func CancelRental(db *sql.DB) http.HandlerFunc {
+
+// CompleteRental sets the status of an active rental to 'completed' and updates the vehicle's availability to true
+// and has the billing service generate an invoice
+func CompleteRental(rentals repository.Rentals, vehicles repository.Vehicles, users *internalapi.Users, billing *internalapi.Billing, clk clock.Clock) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Get user ID from query parameters
-		userIDParam := r.URL.Query().Get("user_id")
-		if userIDParam == "" {
-			http.Error(w, "Query parameter 'user_id' is required", http.StatusBadRequest)
+		rental, ok := activeRental(w, r, rentals)
+		if !ok {
 			return
 		}
+		userID := rental.UserID
 
-		// Parse user ID
-		var userID int
-		_, err := fmt.Sscanf(userIDParam, "%d", &userID)
-		if err != nil || userID <= 0 {
-			http.Error(w, "Invalid 'user_id' query parameter", http.StatusBadRequest)
-			return
+		// Calculate rental hours and overtime in the rental time zone
+		startDate := rental.StartDate.In(Location)
+		endDate := rental.EndDate.In(Location)
+		currentTime := clk.Now().In(Location)
+
+		rentalHours := int(endDate.Sub(startDate).Hours())
+		if rentalHours < 0 {
+			rentalHours = 0
 		}
 
-		// Fetch active rental for the user
-		var rentalID, vehicleID int
-		var startDate string // Changed from time.Time to string temporarily for parsing
-		query := `
-			SELECT id, vehicle_id, start_date
-			FROM rentals
-			WHERE user_id = ? AND status = 'active'
-		`
-		err = db.QueryRow(query, userID).Scan(&rentalID, &vehicleID, &startDate)
-		
+		overtimeHours := 0
+		if currentTime.After(endDate) {
+			overtimeHours = int(currentTime.Sub(endDate).Hours())
+		}
 
-		// Debugging log to check if query is executed correctly
-		if err == sql.ErrNoRows {
-			http.Error(w, "No active rentals found for the user", http.StatusNotFound)
-			return
-		} else if err != nil {
-			http.Error(w, "Failed to fetch active rental: "+err.Error(), http.StatusInternalServerError)
+		vehicle, err := vehicles.Get(r.Context(), rental.VehicleID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Failed to fetch vehicle rate", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch vehicle rate")
 			return
 		}
-		// Parse the start_date string into time.Time
-		parsedStartDate, err := time.Parse("2006-01-02 15:04:05", startDate)
+
+		// Invoicing is idempotent per rental, so if completing below fails a retry gets the same invoice
+		invoice, err := billing.InvoiceRental(r.Context(), internalapi.RentalCharge{
+			UserID:       userID,
+			RentalID:     rental.ID,
+			Hours:        rentalHours,
+			HoursOverdue: overtimeHours,
+			CostPerHour:  vehicle.CostPerHour,
+		})
 		if err != nil {
-			http.Error(w, "Failed to parse rental start date", http.StatusInternalServerError)
+			internalapi.WriteUnavailable(w, r, "billing", err)
 			return
 		}
-		// Convert start date to Singapore time (UTC +8)
-		location, err := time.LoadLocation("Asia/Singapore")
-		if err != nil {
-			fmt.Println("Failed to load location:", err)
+
+		// Update rental status and vehicle availability
+		err = rentals.Complete(r.Context(), rental.ID)
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Write(w, http.StatusConflict, apierror.CodeNoActiveRental, "Rental is not active")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Failed to complete rental", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to complete rental")
 			return
 		}
-		parsedStartDate = parsedStartDate.In(location)
+		rentalEvents.WithLabelValues(eventCompleted).Inc()
+		rentalOvertimeHours.Add(float64(overtimeHours))
 
-		// Get current time in Singapore time zone
-		currentTime := time.Now().In(location)
+		// The invoice comes back in UTC; show it in the user's zone
+		invoice.CreatedAt = invoice.CreatedAt.In(userLocation(r.Context(), users, userID))
 
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message":    "Rental completed successfully",
+			"rental_id":  rental.ID,
+			"vehicle_id": rental.VehicleID,
+			"invoice":    invoice, // Include the invoice directly in the response body
+		})
+	}
+}
 
-		timeDiff := currentTime.Sub(parsedStartDate)
-        // Check if the time difference is greater than 1 hour
-        if timeDiff > time.Hour {
-            fmt.Println("Cancellation is only allowed within 1 hour of the rental start time")
-            http.Error(w, "Cancellation is only allowed within 1 hour of the rental start time", http.StatusBadRequest)
-            return
-        }
-		
-		
-		fmt.Println("Rental start date in Singapore time:", parsedStartDate)
-		fmt.Println("Current time in Singapore time:", currentTime)
+// ExtendRental extends the active rental's end date by the number of hours provided in the request
+func ExtendRental(rentals repository.Rentals, users *internalapi.Users) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Parse the number of hours from the request body
+		var requestData struct {
+			Hours int `json:"hours"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&requestData)
+		if err != nil || requestData.Hours <= 0 {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Invalid or missing 'hours' in request body")
+			return
+		}
 
+		rental, ok := activeRental(w, r, rentals)
+		if !ok {
+			return
+		}
 
-		//continue the cancellationg if the current time is within an hour
-		// Start a transaction
-		tx, err := db.Begin()
+		profile, err := users.RentalProfile(r.Context(), rental.UserID)
 		if err != nil {
-			http.Error(w, "Failed to begin transaction", http.StatusInternalServerError)
+			internalapi.WriteUnavailable(w, r, "user", err)
 			return
 		}
 
-		// Update rental status to 'cancelled'
-		cancelRentalQuery := "UPDATE rentals SET status = 'cancelled' WHERE id = ?"
-		_, err = tx.Exec(cancelRentalQuery, rentalID)
-		if err != nil {
-			http.Error(w, "Failed to cancel rental: "+err.Error(), http.StatusInternalServerError)
-			tx.Rollback()
+		// The same rules as for renting: an active account, and a licence valid until the new end
+		if profile.Status != internalapi.StatusActive {
+			apierror.Write(w, http.StatusForbidden, apierror.CodeAccountInactive, "Account is "+profile.Status+" and cannot extend rentals")
 			return
 		}
 
-		// Set vehicle to available
-		updateVehicleQuery := "UPDATE vehicles SET available = TRUE WHERE id = ?"
-		_, err = tx.Exec(updateVehicleQuery, vehicleID)
-		if err != nil {
-			http.Error(w, "Failed to update vehicle availability", http.StatusInternalServerError)
-			tx.Rollback()
+		// Add the specified number of hours to the end date
+		newEndDate := rental.EndDate.Add(time.Duration(requestData.Hours) * time.Hour)
+		if profile.LicenceExpiry == "" || profile.LicenceExpiry < newEndDate.Format("2006-01-02") {
+			apierror.Write(w, http.StatusForbidden, apierror.CodeLicenceRequired, "Your driver's licence expires before the extended rental would end")
 			return
 		}
 
-		// Commit the transaction
-		if err := tx.Commit(); err != nil {
-			http.Error(w, "Failed to commit transaction", http.StatusInternalServerError)
+		err = rentals.Extend(r.Context(), rental.ID, newEndDate)
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Write(w, http.StatusConflict, apierror.CodeNoActiveRental, "Rental is not active")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Failed to update end date", "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to update end date")
 			return
 		}
 
 		// Respond with success
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(map[string]interface{}{
-			"message":   "Rental cancelled successfully",
-			"rental_id": rentalID,
-			"vehicle_id": vehicleID,
+			"message":      "Rental extended successfully",
+			"rental_id":    rental.ID,
+			"vehicle_id":   rental.VehicleID,
+			"new_end_date": formatTime(newEndDate, userLocation(r.Context(), users, rental.UserID)),
 		})
 	}
 }
-
-// CompleteRental sets the status of a user's active rental to 'completed' and updates the vehicle's availability to true
-// and generates an invoice
-func CompleteRental(db *sql.DB) http.HandlerFunc {
-    return func(w http.ResponseWriter, r *http.Request) {
-        userIDParam := r.URL.Query().Get("user_id")
-        if userIDParam == "" {
-            http.Error(w, "Query parameter 'user_id' is required", http.StatusBadRequest)
-            return
-        }
-
-        userID, err := strconv.Atoi(userIDParam)
-        if err != nil || userID <= 0 {
-            http.Error(w, "Query parameter 'user_id' must be a positive integer", http.StatusBadRequest)
-            return
-        }
-
-        tx, err := db.Begin()
-        if err != nil {
-            http.Error(w, "Failed to begin transaction", http.StatusInternalServerError)
-            return
-        }
-
-        var rentalID, vehicleID int
-        var startDate, endDate string
-        query := `
-        SELECT id, vehicle_id, start_date, end_date
-        FROM rentals
-        WHERE user_id = ? AND status = 'active'
-        `
-        err = tx.QueryRow(query, userID).Scan(&rentalID, &vehicleID, &startDate, &endDate)
-        if err == sql.ErrNoRows {
-            http.Error(w, "No active rentals found for the user", http.StatusNotFound)
-            tx.Rollback()
-            return
-        } else if err != nil {
-            http.Error(w, "Failed to fetch active rental: "+err.Error(), http.StatusInternalServerError)
-            tx.Rollback()
-            return
-        }
-
-        // Load Singapore timezone
-        location, err := time.LoadLocation("Asia/Singapore")
-        if err != nil {
-            http.Error(w, "Failed to load location", http.StatusInternalServerError)
-            tx.Rollback()
-            return
-        }
-
-        // Parse start and end dates in UTC and convert to Singapore local time
-        parsedStartDate, err := time.Parse("2006-01-02 15:04:05", startDate)
-        if err != nil {
-            http.Error(w, "Failed to parse rental start date", http.StatusInternalServerError)
-            tx.Rollback()
-            return
-        }
-        parsedStartDateLocal := parsedStartDate.In(location)
-
-        parsedEndDate, err := time.Parse("2006-01-02 15:04:05", endDate)
-        if err != nil {
-            http.Error(w, "Failed to parse rental end date", http.StatusInternalServerError)
-            tx.Rollback()
-            return
-        }
-        parsedEndDateLocal := parsedEndDate.In(location)
-
-        // Get current local time in Singapore
-        currentTimeLocal := time.Now().In(location)
-
-        // Calculate rental hours and overtime (based on local times)
-        rentalHours := int(parsedEndDateLocal.Sub(parsedStartDateLocal).Hours())
-        if rentalHours < 0 {
-            rentalHours = 0
-        }
-
-        overtimeHours := 0
-        if currentTimeLocal.After(parsedEndDateLocal) {
-            overtimeDuration := currentTimeLocal.Sub(parsedEndDateLocal)
-            overtimeHours = int(overtimeDuration.Hours())
-        }
-
-        var costPerHour float64
-        fetchCostQuery := `SELECT cost_per_hour FROM vehicles WHERE id = ?`
-        err = tx.QueryRow(fetchCostQuery, vehicleID).Scan(&costPerHour)
-        if err != nil {
-            http.Error(w, "Failed to fetch vehicle rate: "+err.Error(), http.StatusInternalServerError)
-            tx.Rollback()
-            return
-        }
-		fmt.Printf("Debug: Vehicle ID %d has an hourly rental rate of %.2f.\n", vehicleID, costPerHour)
-
-
-        var hourlyRateDiscount float64
-        fetchDiscountQuery := `
-        SELECT m.hourly_rate_discount
-        FROM memberships m
-        INNER JOIN users u ON u.membership_id = m.id
-        WHERE u.id = ?
-        `
-        err = tx.QueryRow(fetchDiscountQuery, userID).Scan(&hourlyRateDiscount)
-        if err != nil {
-            http.Error(w, "Failed to fetch membership discount: "+err.Error(), http.StatusInternalServerError)
-            tx.Rollback()
-            return
-        }
-        fmt.Printf("Debug: User ID %d has an hourly rate discount of %.2f%%\n", userID, hourlyRateDiscount)
-		fmt.Printf("Debug: Rentals hours are: %d \n", rentalHours)
-		fmt.Printf("Debug: Overtime hours are: %d \n", overtimeHours)
-
-
-		// Convert the discount percentage to a decimal
-		discountDecimal := hourlyRateDiscount / 100.0
-
-		// Calculate final cost
-		overtimeRate := costPerHour * 1.5
-		finalCost := (float64(rentalHours) * costPerHour * (1 - discountDecimal)) +
-			(float64(overtimeHours) * overtimeRate)
-
-        // Get the current time in UTC for invoice creation
-        invoiceTimeUTC := time.Now().UTC()
-
-        // Insert invoice record with UTC time for creation
-        invoiceQuery := `
-        INSERT INTO invoices (user_id, rental_id, hours, hours_overdue, final_cost, paid_status, created_at)
-        VALUES (?, ?, ?, ?, ?, ?, ?)
-        `
-        _, err = tx.Exec(invoiceQuery, userID, rentalID, rentalHours, overtimeHours, finalCost, false, invoiceTimeUTC)
-        if err != nil {
-            http.Error(w, "Failed to create invoice: "+err.Error(), http.StatusInternalServerError)
-            tx.Rollback()
-            return
-        }
-
-		// Calculate invoice data directly
-		invoice := map[string]interface{}{
-			"user_id":        userID,
-			"rental_id":      rentalID,
-			"hours":          rentalHours,
-			"hours_overdue":  overtimeHours,
-			"final_cost":     finalCost,
-			"paid_status":    false, // Can be updated based on payment status
-			"created_at":     time.Now().UTC().Format(time.RFC3339), // Use UTC format for timestamp
-		}
-
-
-        // Update rental status and vehicle availability
-        completeRentalQuery := "UPDATE rentals SET status = 'completed' WHERE id = ?"
-        _, err = tx.Exec(completeRentalQuery, rentalID)
-        if err != nil {
-            http.Error(w, "Failed to complete rental: "+err.Error(), http.StatusInternalServerError)
-            tx.Rollback()
-            return
-        }
-
-        updateVehicleQuery := "UPDATE vehicles SET available = TRUE WHERE id = ?"
-        _, err = tx.Exec(updateVehicleQuery, vehicleID)
-        if err != nil {
-            http.Error(w, "Failed to update vehicle availability", http.StatusInternalServerError)
-            tx.Rollback()
-            return
-        }
-
-        if err := tx.Commit(); err != nil {
-            http.Error(w, "Failed to commit transaction", http.StatusInternalServerError)
-            return
-        }
-
-        w.Header().Set("Content-Type", "application/json")
-        json.NewEncoder(w).Encode(map[string]interface{}{
-            "message":    "Rental completed successfully",
-            "rental_id":  rentalID,
-            "vehicle_id": vehicleID,
-			"invoice":    invoice, // Include the invoice directly in the response body
-
-        })
-    }
-}
-
-// ExtendRental extends the rental end date by the number of hours provided in the request
-func ExtendRental(db *sql.DB) http.HandlerFunc {
-    return func(w http.ResponseWriter, r *http.Request) {
-        // Parse user ID from query parameters
-        userIDParam := r.URL.Query().Get("user_id")
-        if userIDParam == "" {
-            http.Error(w, "Query parameter 'user_id' is required", http.StatusBadRequest)
-            return
-        }
-        
-        // Log to check the received user_id
-        fmt.Println("Received user_id:", userIDParam)
-        
-        // Convert user ID to an integer
-        userID, err := strconv.Atoi(userIDParam)
-        if err != nil || userID <= 0 {
-            http.Error(w, "Query parameter 'user_id' must be a positive integer", http.StatusBadRequest)
-            return
-        }
-
-        // Parse the number of hours from the request body
-        var requestData struct {
-            Hours int `json:"hours"`
-        }
-        err = json.NewDecoder(r.Body).Decode(&requestData)
-        if err != nil || requestData.Hours <= 0 {
-            http.Error(w, "Invalid or missing 'hours' in request body", http.StatusBadRequest)
-            return
-        }
-
-        // Start a transaction
-        tx, err := db.Begin()
-        if err != nil {
-            http.Error(w, "Failed to begin transaction", http.StatusInternalServerError)
-            return
-        }
-
-        // Fetch the active rental for the user
-        var rentalID int
-        var vehicleID int
-        var endDateStr string
-
-        query := `
-            SELECT id, vehicle_id, end_date
-            FROM rentals
-            WHERE user_id = ? AND status = 'active'
-        `
-		err = tx.QueryRow(query, userID).Scan(&rentalID, &vehicleID, &endDateStr)
-        if err == sql.ErrNoRows {
-            http.Error(w, "No active rentals found for the user", http.StatusNotFound)
-            tx.Rollback()
-            return
-        } else if err != nil {
-            http.Error(w, "Failed to fetch active rental: "+err.Error(), http.StatusInternalServerError)
-            tx.Rollback()
-            return
-        }
-		// Parse the end date string into time.Time
-		parsedEndDate, err := time.Parse("2006-01-02 15:04:05", endDateStr)
-		if err != nil {
-			http.Error(w, "Failed to parse rental end date", http.StatusInternalServerError)
-			tx.Rollback()
-			return
-		}
-		// Add the specified number of hours to the end date
-		newEndDate := parsedEndDate.Add(time.Duration(requestData.Hours) * time.Hour)
-	
-        // Update the rental's end date in the database
-        updateEndDateQuery := "UPDATE rentals SET end_date = ? WHERE id = ?"
-        _, err = tx.Exec(updateEndDateQuery, newEndDate.Format("2006-01-02 15:04:05"), rentalID)
-        if err != nil {
-            http.Error(w, "Failed to update end date: "+err.Error(), http.StatusInternalServerError)
-            tx.Rollback()
-            return
-        }
-        // Commit the transaction
-        if err := tx.Commit(); err != nil {
-            http.Error(w, "Failed to commit transaction", http.StatusInternalServerError)
-            return
-        }
-
-        // Respond with success
-        w.Header().Set("Content-Type", "application/json")
-        json.NewEncoder(w).Encode(map[string]interface{}{
-            "message":    "Rental extended successfully",
-            "rental_id":  rentalID,
-            "vehicle_id": vehicleID,
-            "new_end_date": newEndDate.Format("2006-01-02 15:04:05"),
-        })
-    }
-}
@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"context"
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/clock"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/paging"
+	"electric-car-sharing/services/vehicle-service/models"
+	"electric-car-sharing/services/vehicle-service/repository"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+const testToken = "test-token"
+
+// service is the vehicle service running against the in-memory store, with the user and billing
+// services it calls faked by profiles and invoiced
+type service struct {
+	t        *testing.T
+	router   *mux.Router
+	store    *repository.Memory
+	clock    *clock.Fake
+	profiles map[int]internalapi.RentalProfile
+	invoiced []internalapi.RentalCharge
+}
+
+func newService(t *testing.T) *service {
+	s := &service{
+		t:        t,
+		router:   mux.NewRouter(),
+		store:    repository.NewMemory(),
+		clock:    clock.NewFake(time.Date(2030, time.March, 4, 9, 0, 0, 0, time.UTC)),
+		profiles: map[int]internalapi.RentalProfile{},
+	}
+
+	fakes := mux.NewRouter()
+	fakes.HandleFunc("/internal/users/{id:[0-9]+}/rental-profile", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(mux.Vars(r)["id"])
+		profile, ok := s.profiles[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":"not_found","message":"User not found"}}`))
+			return
+		}
+		json.NewEncoder(w).Encode(profile)
+	})
+	fakes.HandleFunc("/internal/invoices", func(w http.ResponseWriter, r *http.Request) {
+		var charge internalapi.RentalCharge
+		json.NewDecoder(r.Body).Decode(&charge)
+		s.invoiced = append(s.invoiced, charge)
+		json.NewEncoder(w).Encode(internalapi.Invoice{
+			ID:        len(s.invoiced),
+			UserID:    charge.UserID,
+			RentalID:  charge.RentalID,
+			Hours:     charge.Hours,
+			FinalCost: float64(charge.Hours) * charge.CostPerHour,
+		})
+	})
+	fake := httptest.NewServer(fakes)
+	t.Cleanup(fake.Close)
+
+	Register(s.router, s.store, s.store, internalapi.NewUsers(fake.URL, testToken),
+		internalapi.NewBilling(fake.URL, testToken), s.clock, testToken)
+	return s
+}
+
+// renter adds an active user with a licence valid for a year
+func (s *service) renter(id int, vip bool) {
+	s.profiles[id] = internalapi.RentalProfile{
+		UserID:        id,
+		Status:        internalapi.StatusActive,
+		Membership:    internalapi.Membership{ID: 1, Name: "Basic", VIPAccess: vip},
+		LicenceExpiry: s.clock.Now().AddDate(1, 0, 0).Format("2006-01-02"),
+	}
+}
+
+// do sends the request and checks the status, decoding the response into a map
+func (s *service) do(method, path, body string, status int) map[string]interface{} {
+	s.t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	if rec.Code != status {
+		s.t.Fatalf("%s %s: got %d, want %d: %s", method, path, rec.Code, status, rec.Body)
+	}
+	var resp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &resp)
+	return resp
+}
+
+// errorCode returns the code of an error response
+func errorCode(resp map[string]interface{}) string {
+	e, _ := resp["error"].(map[string]interface{})
+	code, _ := e["code"].(string)
+	return code
+}
+
+func TestRentalLifecycle(t *testing.T) {
+	s := newService(t)
+	s.renter(1, false)
+	car := s.store.AddVehicle(models.Vehicle{Make: "Tesla", Model: "Model 3", Year: 2029, Available: true, CostPerHour: 12})
+
+	rental := s.do("POST", "/api/v2/rentals", `{"user_id":1,"vehicle_id":1,"hours":3}`, http.StatusCreated)
+	if rental["status"] != models.RentalActive {
+		t.Fatalf("new rental: %v", rental)
+	}
+	id := strconv.Itoa(int(rental["id"].(float64)))
+	if v, _ := s.store.Get(context.Background(), car.ID); v.Available {
+		t.Fatal("rented vehicle is still available")
+	}
+
+	resp := s.do("POST", "/api/v2/rentals", `{"user_id":1,"vehicle_id":1,"hours":1}`, http.StatusConflict)
+	if errorCode(resp) != apierror.CodeRentalInProgress {
+		t.Errorf("second rental: %v", resp)
+	}
+
+	resp = s.do("POST", "/api/v2/rentals/"+id+"/extension", `{"hours":2}`, http.StatusOK)
+	if resp["new_end_date"] != "2030-03-04T14:00:00Z" {
+		t.Errorf("extended end: %v", resp["new_end_date"])
+	}
+
+	// Two hours into a five hour rental it can no longer be cancelled, but can be completed
+	s.clock.Advance(2 * time.Hour)
+	resp = s.do("POST", "/api/v2/rentals/"+id+"/cancellation", "", http.StatusBadRequest)
+	if errorCode(resp) != apierror.CodeCancellationExpired {
+		t.Errorf("late cancellation: %v", resp)
+	}
+	s.do("POST", "/api/v2/rentals/"+id+"/completion", "", http.StatusOK)
+	if len(s.invoiced) != 1 || s.invoiced[0].Hours != 5 || s.invoiced[0].CostPerHour != 12 {
+		t.Errorf("invoiced %+v, want one charge for 5 hours at 12", s.invoiced)
+	}
+	if v, _ := s.store.Get(context.Background(), car.ID); !v.Available {
+		t.Error("vehicle was not freed by completion")
+	}
+
+	resp = s.do("POST", "/api/v2/rentals/"+id+"/completion", "", http.StatusConflict)
+	if errorCode(resp) != apierror.CodeNoActiveRental {
+		t.Errorf("completing twice: %v", resp)
+	}
+}
+
+func TestCancelWithinWindow(t *testing.T) {
+	s := newService(t)
+	s.renter(1, false)
+	s.store.AddVehicle(models.Vehicle{Make: "Nissan", Model: "Leaf", Year: 2028, Available: true, CostPerHour: 8})
+
+	rental := s.do("POST", "/api/v2/rentals", `{"user_id":1,"vehicle_id":1,"hours":2}`, http.StatusCreated)
+	s.clock.Advance(30 * time.Minute)
+	s.do("POST", "/api/v2/rentals/"+strconv.Itoa(int(rental["id"].(float64)))+"/cancellation", "", http.StatusOK)
+
+	// The vehicle is free again, so another user can take it
+	s.renter(2, false)
+	s.do("POST", "/api/v2/rentals", `{"user_id":2,"vehicle_id":1,"hours":1}`, http.StatusCreated)
+}
+
+func TestCreateRentalRefusals(t *testing.T) {
+	s := newService(t)
+	s.renter(1, false)
+	s.profiles[2] = internalapi.RentalProfile{UserID: 2, Status: "suspended", LicenceExpiry: "2040-01-01"}
+	s.profiles[3] = internalapi.RentalProfile{UserID: 3, Status: internalapi.StatusActive, LicenceExpiry: "2030-03-04"}
+	s.store.AddVehicle(models.Vehicle{Make: "Tesla", Model: "Model S", Year: 2029, Available: true, VIPAccess: true, CostPerHour: 30})
+	s.store.AddVehicle(models.Vehicle{Make: "Nissan", Model: "Leaf", Year: 2028, Available: false, CostPerHour: 8})
+
+	for _, tc := range []struct {
+		name   string
+		body   string
+		status int
+		code   string
+	}{
+		{"unknown user", `{"user_id":9,"vehicle_id":1,"hours":1}`, http.StatusNotFound, apierror.CodeNotFound},
+		{"suspended", `{"user_id":2,"vehicle_id":1,"hours":1}`, http.StatusForbidden, apierror.CodeAccountInactive},
+		{"licence expires during the rental", `{"user_id":3,"vehicle_id":2,"hours":24}`, http.StatusForbidden, apierror.CodeLicenceRequired},
+		{"unknown vehicle", `{"user_id":1,"vehicle_id":9,"hours":1}`, http.StatusNotFound, apierror.CodeNotFound},
+		{"unavailable", `{"user_id":1,"vehicle_id":2,"hours":1}`, http.StatusConflict, apierror.CodeVehicleUnavailable},
+		{"VIP only", `{"user_id":1,"vehicle_id":1,"hours":1}`, http.StatusForbidden, apierror.CodeVIPRequired},
+		{"no hours", `{"user_id":1,"vehicle_id":1}`, http.StatusBadRequest, apierror.CodeInvalidRequest},
+	} {
+		resp := s.do("POST", "/api/v2/rentals", tc.body, tc.status)
+		if errorCode(resp) != tc.code {
+			t.Errorf("%s: got %v, want %s", tc.name, resp, tc.code)
+		}
+	}
+}
+
+func TestAvailableVehicles(t *testing.T) {
+	s := newService(t)
+	s.renter(1, false)
+	s.renter(2, true)
+	s.store.AddVehicle(models.Vehicle{Make: "Tesla", Model: "Model S", Year: 2029, Available: true, VIPAccess: true, CostPerHour: 30})
+	s.store.AddVehicle(models.Vehicle{Make: "Nissan", Model: "Leaf", Year: 2028, Available: true, CostPerHour: 8})
+	s.store.AddVehicle(models.Vehicle{Make: "BYD", Model: "Atto 3", Year: 2027, Available: false, CostPerHour: 9})
+
+	names := func(resp map[string]interface{}) []string {
+		var got []string
+		for _, v := range resp["data"].([]interface{}) {
+			got = append(got, v.(map[string]interface{})["model"].(string))
+		}
+		return got
+	}
+	if got := names(s.do("GET", "/api/v2/vehicles?user_id=1", "", http.StatusOK)); strings.Join(got, ",") != "Leaf" {
+		t.Errorf("basic member sees %v", got)
+	}
+	if got := names(s.do("GET", "/api/v2/vehicles?user_id=2&sort=-cost_per_hour", "", http.StatusOK)); strings.Join(got, ",") != "Model S,Leaf" {
+		t.Errorf("VIP member sees %v", got)
+	}
+}
+
+func TestV1AvailableVehiclesUnpaged(t *testing.T) {
+	s := newService(t)
+	s.renter(1, false)
+	for i := 0; i < 2*paging.MaxLimit+5; i++ {
+		s.store.AddVehicle(models.Vehicle{Make: "Nissan", Model: "Leaf", Year: 2028, Available: true, CostPerHour: 8})
+	}
+
+	// v1 never paged the list, so it reads every page of the v2 one
+	req := httptest.NewRequest("GET", "/vehicles/available?user_id=1", nil)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	var vehicles []models.Vehicle
+	if err := json.Unmarshal(rec.Body.Bytes(), &vehicles); rec.Code != http.StatusOK || err != nil {
+		t.Fatalf("got %d, %v: %s", rec.Code, err, rec.Body)
+	}
+	if len(vehicles) != 2*paging.MaxLimit+5 {
+		t.Fatalf("got %d vehicles, want %d", len(vehicles), 2*paging.MaxLimit+5)
+	}
+	for i, v := range vehicles {
+		if v.ID != i+1 {
+			t.Fatalf("vehicle %d has ID %d", i, v.ID)
+		}
+	}
+}
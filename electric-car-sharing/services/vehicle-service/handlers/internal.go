@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/paging"
+	"electric-car-sharing/services/vehicle-service/models"
+	"electric-car-sharing/services/vehicle-service/repository"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// pathID reads a positive integer ID from the route, responding with an error if it is invalid
+func pathID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || id <= 0 {
+		apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "ID must be a positive integer")
+		return 0, false
+	}
+	return id, true
+}
+
+// Vehicle is the internal endpoint the billing service uses to look up a vehicle's rate
+func Vehicle(vehicles repository.Vehicles) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vehicleID, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+
+		v, err := vehicles.Get(r.Context(), vehicleID)
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Write(w, http.StatusNotFound, apierror.CodeNotFound, "Vehicle not found")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching vehicle", "vehicle_id", vehicleID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch vehicle")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(internalapi.Vehicle{
+			ID:          v.ID,
+			Make:        v.Make,
+			Model:       v.Model,
+			Year:        v.Year,
+			Available:   v.Available,
+			VIPAccess:   v.VIPAccess,
+			CostPerHour: v.CostPerHour,
+		})
+	}
+}
+
+// UserRentals is the internal endpoint the user service uses to list a user's rentals. It takes
+// the same paging, sort and filter parameters as the user service's rental list, which passes
+// them on.
+func UserRentals(rentals repository.Rentals) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+
+		page, err := paging.Parse(r.URL.Query(), repository.RentalSorts, paging.Sort{Field: "id"})
+		if err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+			return
+		}
+		filters := paging.NewFilters(r.URL.Query())
+		filter := repository.RentalFilter{
+			Status: filters.OneOf("status", models.RentalActive, models.RentalCompleted, models.RentalCancelled),
+			From:   filters.Time("from"),
+			To:     filters.Time("to"),
+		}
+		if err := filters.Err(); err != nil {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+			return
+		}
+
+		userRentals, next, err := rentals.ListByUser(r.Context(), userID, filter, page)
+		if errors.Is(err, paging.ErrInvalidCursor) {
+			apierror.Write(w, http.StatusBadRequest, apierror.CodeInvalidRequest, "Query parameter 'cursor' is invalid")
+			return
+		} else if err != nil {
+			slog.ErrorContext(r.Context(), "Error fetching rentals", "user_id", userID, "err", err)
+			apierror.Write(w, http.StatusInternalServerError, apierror.CodeInternal, "Failed to fetch rentals")
+			return
+		}
+
+		result := []internalapi.Rental{}
+		for _, rental := range userRentals {
+			result = append(result, internalapi.Rental{
+				ID:            rental.ID,
+				VehicleID:     rental.VehicleID,
+				StartDate:     rental.StartDate,
+				EndDate:       rental.EndDate,
+				Status:        rental.Status,
+				OvertimeHours: rental.OvertimeHours,
+			})
+		}
+		paging.Write(w, result, page.Page(next))
+	}
+}
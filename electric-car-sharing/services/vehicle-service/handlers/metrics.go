@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"electric-car-sharing/services/common/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Rental events counted by rentalEvents
+const (
+	eventCreated   = "created"
+	eventCancelled = "cancelled"
+	eventCompleted = "completed"
+)
+
+var (
+	rentalEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "rentals_total",
+		Help:      "Rentals created, cancelled and completed.",
+	}, []string{"event"})
+
+	rentalOvertimeHours = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Name:      "rental_overtime_hours_total",
+		Help:      "Hours past their end that completed rentals were returned.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(rentalEvents, rentalOvertimeHours)
+	for _, event := range []string{eventCreated, eventCancelled, eventCompleted} {
+		rentalEvents.WithLabelValues(event)
+	}
+}
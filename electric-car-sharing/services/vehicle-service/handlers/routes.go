@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"electric-car-sharing/services/common/apierror"
+	"electric-car-sharing/services/common/clock"
+	"electric-car-sharing/services/common/compat"
+	"electric-car-sharing/services/common/idempotency"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/paging"
+	"electric-car-sharing/services/vehicle-service/repository"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// Register adds the vehicle service's routes to router: the resource routes under /api/v2, the
+// v1 routes they replace and the /internal/ routes. Rental rules read the time from clk.
+// Internal routes only accept callers presenting internalToken. The probes are left to main.
+func Register(router *mux.Router, vehicles repository.Vehicles, rentals repository.Rentals,
+	users *internalapi.Users, billing *internalapi.Billing, clk clock.Clock, internalToken string) {
+	availableVehicles := FetchAvailableVehicles(vehicles, users)
+	createRental := CreateRental(rentals, users, clk)
+	cancelRental := CancelRental(rentals, clk)
+	completeRental := CompleteRental(rentals, vehicles, users, billing, clk)
+	extendRental := ExtendRental(rentals, users)
+
+	v2 := router.PathPrefix("/api/v2").Subrouter()
+	v2.HandleFunc("/vehicles", availableVehicles).Methods("GET")
+	v2.HandleFunc("/rentals", createRental).Methods("POST")
+	v2.HandleFunc("/rentals/{id:[0-9]+}", ViewRental(rentals, users)).Methods("GET")
+	v2.HandleFunc("/rentals/{id:[0-9]+}/cancellation", cancelRental).Methods("POST")
+	v2.HandleFunc("/rentals/{id:[0-9]+}/completion", completeRental).Methods("POST")
+	v2.HandleFunc("/rentals/{id:[0-9]+}/extension", extendRental).Methods("POST")
+
+	// The deprecated v1 routes, answered by their v2 handlers. They act on the user's active
+	// rental rather than naming one. The vehicle list, which v1 did not page, reads every
+	// page in the largest size.
+	router.HandleFunc("/vehicles/available", compat.Handler(compat.Route{
+		Successor: "/api/v2/vehicles",
+		Rewrites:  []compat.Rewrite{compat.SetQuery("limit", strconv.Itoa(paging.MaxLimit))},
+		Body:      compat.Unwrap("data"),
+		AllPages:  true,
+	}, availableVehicles)).Methods("GET")
+	router.HandleFunc("/vehicles/create-rental", compat.Handler(compat.Route{
+		Successor: "/api/v2/rentals",
+		Rewrites:  []compat.Rewrite{compat.QueryToBody("user_id", "user_id")},
+		Status:    map[int]int{http.StatusCreated: http.StatusOK},
+	}, createRental)).Methods("POST")
+	byActiveRental := []compat.Rewrite{userActiveRental(rentals)}
+	router.HandleFunc("/vehicles/cancel-rental", compat.Handler(compat.Route{
+		Successor: "/api/v2/rentals/{id}/cancellation",
+		Rewrites:  byActiveRental,
+	}, cancelRental)).Methods("POST")
+	router.HandleFunc("/vehicles/complete-rental", compat.Handler(compat.Route{
+		Successor: "/api/v2/rentals/{id}/completion",
+		Rewrites:  byActiveRental,
+	}, completeRental)).Methods("POST")
+	router.HandleFunc("/vehicles/extend-rental", compat.Handler(compat.Route{
+		Successor: "/api/v2/rentals/{id}/extension",
+		Rewrites:  byActiveRental,
+	}, extendRental)).Methods("POST")
+
+	// Routes for the user and billing services, protected by the X-Internal-Token header
+	internal := router.PathPrefix("/internal").Subrouter()
+	internal.Use(internalapi.RequireToken(internalToken))
+	internal.HandleFunc("/vehicles/{id:[0-9]+}", Vehicle(vehicles)).Methods("GET")
+	internal.HandleFunc("/users/{id:[0-9]+}/rentals", UserRentals(rentals)).Methods("GET")
+}
+
+// userActiveRental puts the ID of the active rental of the user in the user_id query parameter
+// into the path, for the v1 routes
+func userActiveRental(rentals repository.Rentals) compat.Rewrite {
+	return func(r *http.Request, vars map[string]string) error {
+		userID, err := compat.QueryID(r, "user_id")
+		if err != nil {
+			return err
+		}
+		rental, err := rentals.Active(r.Context(), userID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return &compat.Refusal{Status: http.StatusNotFound, Code: apierror.CodeNoActiveRental, Message: "No active rentals found for the user"}
+		} else if err != nil {
+			return fmt.Errorf("fetching active rental: %w", err)
+		}
+		vars["id"] = strconv.Itoa(rental.ID)
+		return nil
+	}
+}
+
+// IdempotencyUser scopes Idempotency-Keys to the user a request names or, on the routes naming a
+// rental, to its renter
+func IdempotencyUser(rentals repository.Rentals) idempotency.UserFunc {
+	return func(r *http.Request, body []byte) (int, error) {
+		id, err := strconv.Atoi(mux.Vars(r)["id"])
+		if err != nil {
+			return idempotency.RequestUser(r, body)
+		}
+		rental, err := rentals.Rental(r.Context(), id)
+		if errors.Is(err, repository.ErrNotFound) {
+			// Left for the handler to refuse
+			return 0, nil
+		}
+		return rental.UserID, err
+	}
+}
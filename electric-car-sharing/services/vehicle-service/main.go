@@ -0,0 +1,122 @@
+package main
+
+import (
+	"database/sql"
+	"log/slog"
+
+	"electric-car-sharing/api"
+	"electric-car-sharing/schema"
+	"electric-car-sharing/services/common/clock"
+	"electric-car-sharing/services/common/config"
+	"electric-car-sharing/services/common/database"
+	"electric-car-sharing/services/common/health"
+	"electric-car-sharing/services/common/httpserver"
+	"electric-car-sharing/services/common/idempotency"
+	"electric-car-sharing/services/common/internalapi"
+	"electric-car-sharing/services/common/lifecycle"
+	"electric-car-sharing/services/common/logging"
+	"electric-car-sharing/services/common/metrics"
+	"electric-car-sharing/services/common/openapi"
+	"electric-car-sharing/services/common/timetravel"
+	"electric-car-sharing/services/common/tracing"
+	vehicle_handlers "electric-car-sharing/services/vehicle-service/handlers"
+	"electric-car-sharing/services/vehicle-service/repository"
+)
+
+var db *sql.DB
+var cfg *config.Config
+
+// stopTracing flushes the spans not yet exported
+var stopTracing func() error
+
+// Clients for the services that own users and invoices
+var users *internalapi.Users
+var billing *internalapi.Billing
+
+// Initialize database connection. The vehicle service owns the vehicles and rentals tables.
+// Times are stored and read as UTC.
+func initDB() {
+	var err error
+	db, err = database.OpenUTC(cfg.Self().DSN)
+	if err != nil {
+		logging.Fatal("Database connection failed", err)
+	}
+	slog.Info("Database connected")
+}
+
+// Load and validate the configuration before anything else starts
+func initConfig() {
+	var err error
+	cfg, err = config.Load(config.VehicleService)
+	if err != nil {
+		logging.Fatal("Invalid configuration", err)
+	}
+	logging.Setup(config.VehicleService, cfg.Log)
+}
+
+// Trace requests, queries and calls to the other services, exporting spans as configured
+func initTracing() {
+	var err error
+	stopTracing, err = tracing.Setup(config.VehicleService, cfg.Tracing)
+	if err != nil {
+		logging.Fatal("Tracing setup failed", err)
+	}
+}
+
+func main() {
+	initConfig()
+	initTracing()
+	initDB()
+
+	vehicle_handlers.Location = cfg.Rentals.Location
+	vehicle_handlers.CancellationWindow = cfg.Rentals.CancellationWindow
+
+	internalToken := cfg.InternalToken
+	if internalToken == "" {
+		slog.Warn("INTERNAL_TOKEN not set, internal routes accept any caller")
+	}
+	users = internalapi.NewUsers(cfg.Services.User.URL, internalToken)
+	billing = internalapi.NewBilling(cfg.Services.Billing.URL, internalToken)
+
+	store := repository.NewMySQL(db)
+	router := httpserver.NewRouter()
+
+	// Request latency by route, the database pool and business counters are served on /metrics
+	metrics.Install(router, "vehicle", db)
+
+	// The API description is served on /openapi.yaml and requests are checked against it
+	if err := openapi.Install(router, api.Vehicle); err != nil {
+		logging.Fatal("Loading the API description failed", err)
+	}
+
+	// Mutating requests retried with the same Idempotency-Key are answered with the first response
+	idempotencyKeys := idempotency.NewMySQL(db, "vehicle_idempotency_keys")
+	idempotency.Install(router, idempotencyKeys, clock.System, vehicle_handlers.IdempotencyUser(store))
+
+	// Probes: /healthz checks this service, /readyz also checks the services it calls
+	checker := health.New("vehicle")
+	checker.AddLocal(health.Database(db))
+	checker.AddLocal(health.SchemaVersion(db, schema.Version))
+	checker.AddDownstream(health.Service("user", cfg.Services.User.URL))
+	checker.AddDownstream(health.Service("billing", cfg.Services.Billing.URL))
+	router.HandleFunc("/healthz", checker.Healthz()).Methods("GET")
+	router.HandleFunc("/health", checker.Healthz()).Methods("GET")
+	router.HandleFunc("/readyz", checker.Readyz()).Methods("GET")
+
+	// Test builds let operators move the clock through /admin/clock; normal builds use the real one
+	clk := timetravel.Install(router, "vehicle", cfg.Users.AdminToken)
+	vehicle_handlers.Register(router, store, store, users, billing, clk, internalToken)
+
+	// Stop on SIGINT or SIGTERM, letting in-flight requests finish before the database closes
+	ctx, stop := lifecycle.SignalContext()
+	defer stop()
+	app := lifecycle.New(cfg.ShutdownTimeout)
+	app.OnShutdown("tracing", stopTracing)
+	app.OnShutdown("database", db.Close)
+	app.AddServer("Vehicle service", httpserver.NewServer(cfg.Self().ListenAddr, router))
+	app.Go("idempotency key purge", idempotency.PurgeExpired(idempotencyKeys, clock.System))
+	if err := app.Run(ctx); err != nil {
+		logging.Fatal("Service stopped with errors", err)
+	}
+	slog.Info("Service stopped")
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"electric-car-sharing/services/common/internalapi"
+	"time"
+)
+
+// Rental statuses
+const (
+	RentalActive    = internalapi.RentalActive
+	RentalCompleted = "completed"
+	RentalCancelled = "cancelled"
+)
+
+// Rental is a user's booking of a vehicle. StartDate and EndDate are in UTC.
+type Rental struct {
+	ID            int       `json:"id"`
+	UserID        int       `json:"user_id"`
+	VehicleID     int       `json:"vehicle_id"`
+	StartDate     time.Time `json:"start_date"`
+	EndDate       time.Time `json:"end_date"`
+	Status        string    `json:"status"`
+	OvertimeHours int       `json:"overtime_hours"`
+}
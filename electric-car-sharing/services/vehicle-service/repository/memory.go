@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"electric-car-sharing/services/common/paging"
+	"electric-car-sharing/services/vehicle-service/models"
+)
+
+// Memory keeps vehicles and rentals in maps. It is safe for concurrent use and is meant for
+// tests and local development.
+type Memory struct {
+	mu            sync.Mutex
+	vehicles      map[int]models.Vehicle
+	rentals       map[int]models.Rental
+	nextVehicleID int
+	nextRentalID  int
+}
+
+var (
+	_ Vehicles = (*Memory)(nil)
+	_ Rentals  = (*Memory)(nil)
+)
+
+// NewMemory creates an empty store
+func NewMemory() *Memory {
+	return &Memory{
+		vehicles:      map[int]models.Vehicle{},
+		rentals:       map[int]models.Rental{},
+		nextVehicleID: 1,
+		nextRentalID:  1,
+	}
+}
+
+// AddVehicle adds a vehicle to the fleet, assigning the next ID if v.ID is zero
+func (s *Memory) AddVehicle(v models.Vehicle) models.Vehicle {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v.ID == 0 {
+		v.ID = s.nextVehicleID
+	}
+	if v.ID >= s.nextVehicleID {
+		s.nextVehicleID = v.ID + 1
+	}
+	s.vehicles[v.ID] = v
+	return v
+}
+
+// Get returns the vehicle, or ErrNotFound
+func (s *Memory) Get(ctx context.Context, id int) (models.Vehicle, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.vehicles[id]
+	if !ok {
+		return v, ErrNotFound
+	}
+	return v, nil
+}
+
+// ListAvailable returns a page of the vehicles not currently rented that match filter
+func (s *Memory) ListAvailable(ctx context.Context, filter VehicleFilter, page paging.Request) ([]models.Vehicle, string, error) {
+	zero := vehicleKey(models.Vehicle{}, page.Sort.Field)
+	if zero == nil {
+		return nil, "", errSort(page.Sort.Field)
+	}
+	after, err := page.AfterKey(zero)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var vehicles []models.Vehicle
+	for _, v := range s.vehicles {
+		key := vehicleKey(v, page.Sort.Field)
+		if v.Available && filter.match(v) && (page.After == nil || page.Less(after, page.After.ID, key, v.ID)) {
+			vehicles = append(vehicles, v)
+		}
+	}
+	sort.Slice(vehicles, func(i, j int) bool {
+		return page.Less(vehicleKey(vehicles[i], page.Sort.Field), vehicles[i].ID, vehicleKey(vehicles[j], page.Sort.Field), vehicles[j].ID)
+	})
+	vehicles, next := pageVehicles(vehicles, page)
+	return vehicles, next, nil
+}
+
+// Rental returns the rental whatever its status, or ErrNotFound
+func (s *Memory) Rental(ctx context.Context, id int) (models.Rental, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rental, ok := s.rentals[id]
+	if !ok {
+		return rental, ErrNotFound
+	}
+	return rental, nil
+}
+
+// Active returns the user's active rental, or ErrNotFound
+func (s *Memory) Active(ctx context.Context, userID int) (models.Rental, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.active(userID)
+}
+
+func (s *Memory) active(userID int) (models.Rental, error) {
+	for _, rental := range s.rentals {
+		if rental.UserID == userID && rental.Status == models.RentalActive {
+			return rental, nil
+		}
+	}
+	return models.Rental{}, ErrNotFound
+}
+
+// ListByUser returns a page of the user's rentals that match filter
+func (s *Memory) ListByUser(ctx context.Context, userID int, filter RentalFilter, page paging.Request) ([]models.Rental, string, error) {
+	zero := rentalKey(models.Rental{}, page.Sort.Field)
+	if zero == nil {
+		return nil, "", errSort(page.Sort.Field)
+	}
+	after, err := page.AfterKey(zero)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var rentals []models.Rental
+	for _, rental := range s.rentals {
+		key := rentalKey(rental, page.Sort.Field)
+		if rental.UserID == userID && filter.match(rental) && (page.After == nil || page.Less(after, page.After.ID, key, rental.ID)) {
+			rentals = append(rentals, rental)
+		}
+	}
+	sort.Slice(rentals, func(i, j int) bool {
+		return page.Less(rentalKey(rentals[i], page.Sort.Field), rentals[i].ID, rentalKey(rentals[j], page.Sort.Field), rentals[j].ID)
+	})
+	rentals, next := pageRentals(rentals, page)
+	return rentals, next, nil
+}
+
+// Start records the rental and marks its vehicle unavailable
+func (s *Memory) Start(ctx context.Context, rental models.Rental, allow func(models.Vehicle) error) (models.Rental, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.active(rental.UserID); err == nil {
+		return rental, ErrRentalInProgress
+	}
+	vehicle, ok := s.vehicles[rental.VehicleID]
+	if !ok {
+		return rental, ErrNotFound
+	}
+	if !vehicle.Available {
+		return rental, ErrVehicleUnavailable
+	}
+	if allow != nil {
+		if err := allow(vehicle); err != nil {
+			return rental, err
+		}
+	}
+
+	rental.ID = s.nextRentalID
+	s.nextRentalID++
+	rental.StartDate = truncate(rental.StartDate)
+	rental.EndDate = truncate(rental.EndDate)
+	rental.Status = models.RentalActive
+	rental.OvertimeHours = 0
+	s.rentals[rental.ID] = rental
+	vehicle.Available = false
+	s.vehicles[vehicle.ID] = vehicle
+	return rental, nil
+}
+
+// Cancel marks the active rental cancelled and frees its vehicle
+func (s *Memory) Cancel(ctx context.Context, rentalID int) error {
+	return s.finish(rentalID, models.RentalCancelled)
+}
+
+// Complete marks the active rental completed and frees its vehicle
+func (s *Memory) Complete(ctx context.Context, rentalID int) error {
+	return s.finish(rentalID, models.RentalCompleted)
+}
+
+func (s *Memory) finish(rentalID int, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rental, ok := s.rentals[rentalID]
+	if !ok || rental.Status != models.RentalActive {
+		return ErrNotFound
+	}
+	rental.Status = status
+	s.rentals[rentalID] = rental
+	if vehicle, ok := s.vehicles[rental.VehicleID]; ok {
+		vehicle.Available = true
+		s.vehicles[vehicle.ID] = vehicle
+	}
+	return nil
+}
+
+// Extend moves the end of the active rental to end
+func (s *Memory) Extend(ctx context.Context, rentalID int, end time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rental, ok := s.rentals[rentalID]
+	if !ok || rental.Status != models.RentalActive {
+		return ErrNotFound
+	}
+	rental.EndDate = truncate(end)
+	s.rentals[rentalID] = rental
+	return nil
+}
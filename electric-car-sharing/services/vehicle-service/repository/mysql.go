@@ -0,0 +1,315 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"electric-car-sharing/services/common/paging"
+	"electric-car-sharing/services/vehicle-service/models"
+)
+
+// MySQL stores vehicles and rentals in the vehicles and rentals tables. Its connection must be
+// opened with database.OpenUTC so rental times are stored and read as UTC.
+type MySQL struct {
+	db *sql.DB
+}
+
+var (
+	_ Vehicles = (*MySQL)(nil)
+	_ Rentals  = (*MySQL)(nil)
+)
+
+// NewMySQL creates a store backed by db
+func NewMySQL(db *sql.DB) *MySQL {
+	return &MySQL{db: db}
+}
+
+const vehicleColumns = "id, make, model, year, available, vip_access, cost_per_hour"
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanVehicle(row scanner) (models.Vehicle, error) {
+	var v models.Vehicle
+	err := row.Scan(&v.ID, &v.Make, &v.Model, &v.Year, &v.Available, &v.VIPAccess, &v.CostPerHour)
+	return v, err
+}
+
+// Get returns the vehicle, or ErrNotFound
+func (s *MySQL) Get(ctx context.Context, id int) (models.Vehicle, error) {
+	v, err := scanVehicle(s.db.QueryRowContext(ctx, "SELECT "+vehicleColumns+" FROM vehicles WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return v, ErrNotFound
+	}
+	return v, err
+}
+
+// ListAvailable returns a page of the vehicles not currently rented that match filter
+func (s *MySQL) ListAvailable(ctx context.Context, filter VehicleFilter, page paging.Request) ([]models.Vehicle, string, error) {
+	zero := vehicleKey(models.Vehicle{}, page.Sort.Field)
+	if zero == nil {
+		return nil, "", errSort(page.Sort.Field)
+	}
+	after, err := page.AfterKey(zero)
+	if err != nil {
+		return nil, "", err
+	}
+
+	conditions := []string{"available = TRUE"}
+	var args []interface{}
+	if !filter.IncludeVIP {
+		conditions = append(conditions, "vip_access = FALSE")
+	}
+	if filter.VIP != nil {
+		conditions = append(conditions, "vip_access = ?")
+		args = append(args, *filter.VIP)
+	}
+	if filter.Make != "" {
+		conditions = append(conditions, "LOWER(make) = LOWER(?)")
+		args = append(args, filter.Make)
+	}
+	if filter.MinYear != 0 {
+		conditions = append(conditions, "year >= ?")
+		args = append(args, filter.MinYear)
+	}
+	if filter.MaxYear != 0 {
+		conditions = append(conditions, "year <= ?")
+		args = append(args, filter.MaxYear)
+	}
+	if filter.MinCost != 0 {
+		conditions = append(conditions, "cost_per_hour >= ?")
+		args = append(args, filter.MinCost)
+	}
+	if filter.MaxCost != 0 {
+		conditions = append(conditions, "cost_per_hour <= ?")
+		args = append(args, filter.MaxCost)
+	}
+	// The sort field was checked by vehicleKey, so it is safe to use as a column
+	where, pageArgs, order := page.SQL(page.Sort.Field, after)
+	if where != "" {
+		conditions = append(conditions, where)
+		args = append(args, pageArgs...)
+	}
+
+	query := "SELECT " + vehicleColumns + " FROM vehicles WHERE " + strings.Join(conditions, " AND ") + order
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var vehicles []models.Vehicle
+	for rows.Next() {
+		v, err := scanVehicle(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		vehicles = append(vehicles, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	vehicles, next := pageVehicles(vehicles, page)
+	return vehicles, next, nil
+}
+
+const rentalColumns = "id, user_id, vehicle_id, start_date, end_date, status, overtime_hours"
+
+func scanRental(row scanner) (models.Rental, error) {
+	var rental models.Rental
+	err := row.Scan(&rental.ID, &rental.UserID, &rental.VehicleID, &rental.StartDate, &rental.EndDate, &rental.Status, &rental.OvertimeHours)
+	return rental, err
+}
+
+// Rental returns the rental whatever its status, or ErrNotFound
+func (s *MySQL) Rental(ctx context.Context, id int) (models.Rental, error) {
+	rental, err := scanRental(s.db.QueryRowContext(ctx, "SELECT "+rentalColumns+" FROM rentals WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return rental, ErrNotFound
+	}
+	return rental, err
+}
+
+// Active returns the user's active rental, or ErrNotFound
+func (s *MySQL) Active(ctx context.Context, userID int) (models.Rental, error) {
+	query := "SELECT " + rentalColumns + " FROM rentals WHERE user_id = ? AND status = ?"
+	rental, err := scanRental(s.db.QueryRowContext(ctx, query, userID, models.RentalActive))
+	if err == sql.ErrNoRows {
+		return rental, ErrNotFound
+	}
+	return rental, err
+}
+
+// ListByUser returns a page of the user's rentals that match filter
+func (s *MySQL) ListByUser(ctx context.Context, userID int, filter RentalFilter, page paging.Request) ([]models.Rental, string, error) {
+	zero := rentalKey(models.Rental{}, page.Sort.Field)
+	if zero == nil {
+		return nil, "", errSort(page.Sort.Field)
+	}
+	after, err := page.AfterKey(zero)
+	if err != nil {
+		return nil, "", err
+	}
+
+	conditions := []string{"user_id = ?"}
+	args := []interface{}{userID}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+	if !filter.From.IsZero() {
+		conditions = append(conditions, "start_date >= ?")
+		args = append(args, filter.From.UTC())
+	}
+	if !filter.To.IsZero() {
+		conditions = append(conditions, "start_date < ?")
+		args = append(args, filter.To.UTC())
+	}
+	// The sort field was checked by rentalKey, so it is safe to use as a column
+	where, pageArgs, order := page.SQL(page.Sort.Field, after)
+	if where != "" {
+		conditions = append(conditions, where)
+		args = append(args, pageArgs...)
+	}
+
+	query := "SELECT " + rentalColumns + " FROM rentals WHERE " + strings.Join(conditions, " AND ") + order
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var rentals []models.Rental
+	for rows.Next() {
+		rental, err := scanRental(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		rentals = append(rentals, rental)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	rentals, next := pageRentals(rentals, page)
+	return rentals, next, nil
+}
+
+// Start records the rental and marks its vehicle unavailable in one transaction. The vehicle row
+// is locked so two users cannot take the same vehicle.
+func (s *MySQL) Start(ctx context.Context, rental models.Rental, allow func(models.Vehicle) error) (models.Rental, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return rental, err
+	}
+	defer tx.Rollback()
+
+	var active bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM rentals WHERE user_id = ? AND status = ?)", rental.UserID, models.RentalActive).Scan(&active)
+	if err != nil {
+		return rental, err
+	}
+	if active {
+		return rental, ErrRentalInProgress
+	}
+
+	vehicle, err := scanVehicle(tx.QueryRowContext(ctx, "SELECT "+vehicleColumns+" FROM vehicles WHERE id = ? FOR UPDATE", rental.VehicleID))
+	if err == sql.ErrNoRows {
+		return rental, ErrNotFound
+	} else if err != nil {
+		return rental, err
+	}
+	if !vehicle.Available {
+		return rental, ErrVehicleUnavailable
+	}
+	if allow != nil {
+		if err := allow(vehicle); err != nil {
+			return rental, err
+		}
+	}
+
+	rental.StartDate = truncate(rental.StartDate)
+	rental.EndDate = truncate(rental.EndDate)
+	rental.Status = models.RentalActive
+	rental.OvertimeHours = 0
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO rentals (user_id, vehicle_id, start_date, end_date, status, overtime_hours)
+		VALUES (?, ?, ?, ?, ?, 0)`,
+		rental.UserID, rental.VehicleID, rental.StartDate, rental.EndDate, rental.Status)
+	if err != nil {
+		return rental, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return rental, err
+	}
+	rental.ID = int(id)
+
+	if _, err := tx.ExecContext(ctx, "UPDATE vehicles SET available = FALSE WHERE id = ?", rental.VehicleID); err != nil {
+		return rental, err
+	}
+	return rental, tx.Commit()
+}
+
+// Cancel marks the active rental cancelled and frees its vehicle
+func (s *MySQL) Cancel(ctx context.Context, rentalID int) error {
+	return s.finish(ctx, rentalID, models.RentalCancelled)
+}
+
+// Complete marks the active rental completed and frees its vehicle
+func (s *MySQL) Complete(ctx context.Context, rentalID int) error {
+	return s.finish(ctx, rentalID, models.RentalCompleted)
+}
+
+// finish moves an active rental to status and makes its vehicle available again
+func (s *MySQL) finish(ctx context.Context, rentalID int, status string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var vehicleID int
+	err = tx.QueryRowContext(ctx, "SELECT vehicle_id FROM rentals WHERE id = ? AND status = ? FOR UPDATE", rentalID, models.RentalActive).Scan(&vehicleID)
+	if err == sql.ErrNoRows {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE rentals SET status = ? WHERE id = ?", status, rentalID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE vehicles SET available = TRUE WHERE id = ?", vehicleID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Extend moves the end of the active rental to end
+func (s *MySQL) Extend(ctx context.Context, rentalID int, end time.Time) error {
+	result, err := s.db.ExecContext(ctx, "UPDATE rentals SET end_date = ? WHERE id = ? AND status = ?",
+		truncate(end), rentalID, models.RentalActive)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		// Either the rental is not active or end is unchanged; only the first is an error
+		if _, err := s.activeByID(ctx, rentalID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MySQL) activeByID(ctx context.Context, rentalID int) (models.Rental, error) {
+	query := "SELECT " + rentalColumns + " FROM rentals WHERE id = ? AND status = ?"
+	rental, err := scanRental(s.db.QueryRowContext(ctx, query, rentalID, models.RentalActive))
+	if err == sql.ErrNoRows {
+		return rental, ErrNotFound
+	}
+	return rental, err
+}
@@ -0,0 +1,156 @@
+// Package repository stores the vehicle service's vehicles and rentals. Handlers depend on the
+// interfaces here rather than on *sql.DB, so they can run against the MySQL store in production
+// and the in-memory store in tests.
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"electric-car-sharing/services/common/paging"
+	"electric-car-sharing/services/vehicle-service/models"
+)
+
+var (
+	// ErrNotFound is returned when the vehicle or rental does not exist, or a rental is no
+	// longer active
+	ErrNotFound = errors.New("not found")
+	// ErrRentalInProgress is returned by Start when the user already has an active rental
+	ErrRentalInProgress = errors.New("user already has an active rental")
+	// ErrVehicleUnavailable is returned by Start when the vehicle is already rented
+	ErrVehicleUnavailable = errors.New("vehicle is not available")
+)
+
+// Vehicles reads the fleet
+type Vehicles interface {
+	// Get returns the vehicle, or ErrNotFound
+	Get(ctx context.Context, id int) (models.Vehicle, error)
+	// ListAvailable returns a page of the vehicles not currently rented that match filter, and
+	// the cursor of the next page, or "" on the last one
+	ListAvailable(ctx context.Context, filter VehicleFilter, page paging.Request) ([]models.Vehicle, string, error)
+}
+
+// Rentals stores rentals and keeps vehicle availability in step with them
+type Rentals interface {
+	// Rental returns the rental whatever its status, or ErrNotFound
+	Rental(ctx context.Context, id int) (models.Rental, error)
+	// Active returns the user's active rental, or ErrNotFound
+	Active(ctx context.Context, userID int) (models.Rental, error)
+	// ListByUser returns a page of the user's rentals that match filter, and the cursor of the
+	// next page, or "" on the last one
+	ListByUser(ctx context.Context, userID int, filter RentalFilter, page paging.Request) ([]models.Rental, string, error)
+	// Start records rental as active and marks its vehicle unavailable in one step. It fails with
+	// ErrRentalInProgress, ErrNotFound or ErrVehicleUnavailable, or with the error from allow,
+	// which is given the vehicle so the caller can refuse it (e.g. VIP-only vehicles).
+	Start(ctx context.Context, rental models.Rental, allow func(models.Vehicle) error) (models.Rental, error)
+	// Cancel marks the active rental cancelled and frees its vehicle
+	Cancel(ctx context.Context, rentalID int) error
+	// Complete marks the active rental completed and frees its vehicle
+	Complete(ctx context.Context, rentalID int) error
+	// Extend moves the end of the active rental to end
+	Extend(ctx context.Context, rentalID int, end time.Time) error
+}
+
+// VehicleFilter narrows a list of vehicles. Zero fields match every vehicle.
+type VehicleFilter struct {
+	// IncludeVIP lists VIP-only vehicles, which are left out for members without VIP access
+	IncludeVIP bool
+	// VIP, when set, keeps only the VIP-only vehicles, or only the standard ones
+	VIP *bool
+	// Make matches the make, ignoring case
+	Make             string
+	MinYear, MaxYear int
+	MinCost, MaxCost float64
+}
+
+func (f VehicleFilter) match(v models.Vehicle) bool {
+	return (f.IncludeVIP || !v.VIPAccess) &&
+		(f.VIP == nil || v.VIPAccess == *f.VIP) &&
+		(f.Make == "" || strings.EqualFold(v.Make, f.Make)) &&
+		(f.MinYear == 0 || v.Year >= f.MinYear) &&
+		(f.MaxYear == 0 || v.Year <= f.MaxYear) &&
+		(f.MinCost == 0 || v.CostPerHour >= f.MinCost) &&
+		(f.MaxCost == 0 || v.CostPerHour <= f.MaxCost)
+}
+
+// VehicleSorts are the fields vehicles can be sorted by
+var VehicleSorts = []string{"id", "make", "year", "cost_per_hour"}
+
+// vehicleKey is v's value of the sort field, or nil if vehicles cannot be sorted by it
+func vehicleKey(v models.Vehicle, field string) interface{} {
+	switch field {
+	case "id":
+		return v.ID
+	case "make":
+		return v.Make
+	case "year":
+		return v.Year
+	case "cost_per_hour":
+		return v.CostPerHour
+	}
+	return nil
+}
+
+// pageVehicles cuts the vehicles fetched for page down to it and returns the next page's cursor
+func pageVehicles(vehicles []models.Vehicle, page paging.Request) ([]models.Vehicle, string) {
+	n, more := page.Cut(len(vehicles))
+	vehicles = vehicles[:n]
+	if !more {
+		return vehicles, ""
+	}
+	last := vehicles[n-1]
+	return vehicles, page.Next(vehicleKey(last, page.Sort.Field), last.ID)
+}
+
+// RentalFilter narrows a list of rentals. Zero fields match every rental.
+type RentalFilter struct {
+	Status string
+	// From and To keep the rentals that started at or after From and before To
+	From, To time.Time
+}
+
+func (f RentalFilter) match(rental models.Rental) bool {
+	return (f.Status == "" || rental.Status == f.Status) &&
+		(f.From.IsZero() || !rental.StartDate.Before(f.From)) &&
+		(f.To.IsZero() || rental.StartDate.Before(f.To))
+}
+
+// RentalSorts are the fields rentals can be sorted by
+var RentalSorts = []string{"id", "start_date", "end_date"}
+
+// rentalKey is the rental's value of the sort field, or nil if rentals cannot be sorted by it
+func rentalKey(rental models.Rental, field string) interface{} {
+	switch field {
+	case "id":
+		return rental.ID
+	case "start_date":
+		return rental.StartDate
+	case "end_date":
+		return rental.EndDate
+	}
+	return nil
+}
+
+// pageRentals cuts the rentals fetched for page down to it and returns the next page's cursor
+func pageRentals(rentals []models.Rental, page paging.Request) ([]models.Rental, string) {
+	n, more := page.Cut(len(rentals))
+	rentals = rentals[:n]
+	if !more {
+		return rentals, ""
+	}
+	last := rentals[n-1]
+	return rentals, page.Next(rentalKey(last, page.Sort.Field), last.ID)
+}
+
+// errSort refuses a sort field the handlers should not have let through
+func errSort(field string) error {
+	return fmt.Errorf("unknown sort field %q", field)
+}
+
+// truncate drops what a DATETIME column would not keep, so both stores return the same times
+func truncate(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Second)
+}